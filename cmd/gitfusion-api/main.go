@@ -37,7 +37,7 @@ func main() {
 	r.Use(middleware.RequestID)
 	r.Use(httplog.RequestLogger(logger))
 	r.Use(middleware.Recoverer)
-	r.Use(middleware.Timeout(60 * time.Second))
+	r.Use(skipForStreams(middleware.Timeout(60 * time.Second)))
 	r.Use(middleware.Heartbeat("/healthz"))
 
 	handler, err := api.BuildHandler(config)
@@ -90,6 +90,30 @@ func main() {
 	<-serverCtx.Done()
 }
 
+// streamPaths are long-lived Server-Sent Events and download endpoints that must outlive the
+// request timeout.
+var streamPaths = map[string]bool{
+	"/api/v1/pipeline-job-trace/stream":   true,
+	"/api/v1/pipeline-artifacts/download": true,
+}
+
+// skipForStreams applies mw to every request except those to streamPaths.
+func skipForStreams(mw func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		wrapped := mw(next)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if streamPaths[r.URL.Path] {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			wrapped.ServeHTTP(w, r)
+		})
+	}
+}
+
 func initLogger(config api.Config) *httplog.Logger {
 	l := httplog.NewLogger("gitfusion-api", httplog.Options{
 		JSON:            true,
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+// artifactDownloadResponse streams an artifact to the client. The generated octet-stream
+// response is not used: it cannot pass on the provider's content type, and when the copy fails
+// after the headers are out the strict handler appends an error body to the partial artifact.
+type artifactDownloadResponse struct {
+	download *models.ArtifactDownload
+}
+
+// VisitDownloadPipelineArtifactResponse implements DownloadPipelineArtifactResponseObject. A copy
+// that fails midway aborts the connection, so the client sees an incomplete download rather than
+// a truncated file that looks complete.
+func (r artifactDownloadResponse) VisitDownloadPipelineArtifactResponse(w http.ResponseWriter) error {
+	defer func() { _ = r.download.Body.Close() }()
+
+	contentType := r.download.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": r.download.Name,
+	}))
+
+	if r.download.Size >= 0 {
+		w.Header().Set("Content-Length", strconv.FormatInt(r.download.Size, 10))
+	}
+
+	w.WriteHeader(http.StatusOK)
+
+	if _, err := io.Copy(w, r.download.Body); err != nil {
+		panic(http.ErrAbortHandler)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+// commitStatusService abstracts the commit status capability
+// so the handler can be tested without a real service.
+type commitStatusService interface {
+	ListCommitStatuses(
+		ctx context.Context,
+		gitServerName, project, sha string,
+	) (*models.CommitStatusesResponse, error)
+
+	CreateCommitStatus(
+		ctx context.Context,
+		gitServerName, project, sha string,
+		opts models.CommitStatusOptions,
+	) (*models.CommitStatus, error)
+}
+
+// CommitStatusHandler handles requests related to commit statuses (GitHub, GitLab and Bitbucket).
+type CommitStatusHandler struct {
+	commitStatusesService commitStatusService
+}
+
+// NewCommitStatusHandler creates a new CommitStatusHandler.
+func NewCommitStatusHandler(commitStatusesService commitStatusService) *CommitStatusHandler {
+	return &CommitStatusHandler{
+		commitStatusesService: commitStatusesService,
+	}
+}
+
+// ListCommitStatuses implements api.StrictServerInterface.
+func (h *CommitStatusHandler) ListCommitStatuses(
+	ctx context.Context,
+	request ListCommitStatusesRequestObject,
+) (ListCommitStatusesResponseObject, error) {
+	if request.Params.Sha == "" {
+		return ListCommitStatuses400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "sha parameter is required",
+		}, nil
+	}
+
+	resp, err := h.commitStatusesService.ListCommitStatuses(
+		ctx,
+		request.Params.GitServer,
+		request.Params.Project,
+		request.Params.Sha,
+	)
+	if err != nil {
+		return h.listErrResponse(err), nil
+	}
+
+	return ListCommitStatuses200JSONResponse(*resp), nil
+}
+
+// CreateCommitStatus implements api.StrictServerInterface.
+func (h *CommitStatusHandler) CreateCommitStatus(
+	ctx context.Context,
+	request CreateCommitStatusRequestObject,
+) (CreateCommitStatusResponseObject, error) {
+	params := request.Params
+
+	if params.Sha == "" || params.Context == "" {
+		return CreateCommitStatus400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "sha and context parameters are required",
+		}, nil
+	}
+
+	switch params.State {
+	case models.CreateCommitStatusStatePending,
+		models.CreateCommitStatusStateRunning,
+		models.CreateCommitStatusStateSuccess,
+		models.CreateCommitStatusStateFailed,
+		models.CreateCommitStatusStateCancelled:
+	default:
+		return CreateCommitStatus400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "state must be one of pending, running, success, failed or cancelled",
+		}, nil
+	}
+
+	opts := models.CommitStatusOptions{
+		State:       models.CommitState(params.State),
+		Context:     params.Context,
+		Description: params.Description,
+		TargetURL:   params.TargetUrl,
+	}
+
+	status, err := h.commitStatusesService.CreateCommitStatus(ctx, params.GitServer, params.Project, params.Sha, opts)
+	if err != nil {
+		return h.createErrResponse(err), nil
+	}
+
+	return CreateCommitStatus201JSONResponse(*status), nil
+}
+
+// listErrResponse maps errors to response objects for ListCommitStatuses.
+func (h *CommitStatusHandler) listErrResponse(err error) ListCommitStatusesResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return ListCommitStatuses401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return ListCommitStatuses400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return ListCommitStatuses404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return ListCommitStatuses500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// createErrResponse maps errors to response objects for CreateCommitStatus.
+func (h *CommitStatusHandler) createErrResponse(err error) CreateCommitStatusResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return CreateCommitStatus401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return CreateCommitStatus400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return CreateCommitStatus404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return CreateCommitStatus500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
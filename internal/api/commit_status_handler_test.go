@@ -0,0 +1,230 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+)
+
+// stubCommitStatusService captures the arguments passed to the commit status service
+// and returns a preconfigured response.
+type stubCommitStatusService struct {
+	gotListGitServer string
+	gotListProject   string
+	gotListSha       string
+
+	gotCreateProject string
+	gotCreateSha     string
+	gotCreateOpts    models.CommitStatusOptions
+
+	listResp   *models.CommitStatusesResponse
+	createResp *models.CommitStatus
+	err        error
+}
+
+func (s *stubCommitStatusService) ListCommitStatuses(
+	_ context.Context,
+	gitServerName, project, sha string,
+) (*models.CommitStatusesResponse, error) {
+	s.gotListGitServer = gitServerName
+	s.gotListProject = project
+	s.gotListSha = sha
+
+	return s.listResp, s.err
+}
+
+func (s *stubCommitStatusService) CreateCommitStatus(
+	_ context.Context,
+	_, project, sha string,
+	opts models.CommitStatusOptions,
+) (*models.CommitStatus, error) {
+	s.gotCreateProject = project
+	s.gotCreateSha = sha
+	s.gotCreateOpts = opts
+
+	return s.createResp, s.err
+}
+
+func TestCommitStatusHandlerListCommitStatuses(t *testing.T) {
+	stub := &stubCommitStatusService{
+		listResp: &models.CommitStatusesResponse{
+			Sha:   "abc123",
+			State: models.CommitStateFailed,
+			Statuses: []models.CommitStatus{
+				{Kind: models.CommitStatusKindCheckRun, Context: "build", State: models.CommitStateFailed},
+			},
+		},
+	}
+	handler := NewCommitStatusHandler(stub)
+
+	resp, err := handler.ListCommitStatuses(context.Background(), ListCommitStatusesRequestObject{
+		Params: models.ListCommitStatusesParams{GitServer: "my-server", Project: "owner/repo", Sha: "abc123"},
+	})
+
+	require.NoError(t, err)
+
+	okResp, ok := resp.(ListCommitStatuses200JSONResponse)
+	require.True(t, ok, "expected ListCommitStatuses200JSONResponse")
+	assert.Equal(t, models.CommitStateFailed, okResp.State)
+	assert.Len(t, okResp.Statuses, 1)
+	assert.Equal(t, "my-server", stub.gotListGitServer)
+	assert.Equal(t, "owner/repo", stub.gotListProject)
+	assert.Equal(t, "abc123", stub.gotListSha)
+}
+
+func TestCommitStatusHandlerListCommitStatusesRequiresSha(t *testing.T) {
+	stub := &stubCommitStatusService{}
+	handler := NewCommitStatusHandler(stub)
+
+	resp, err := handler.ListCommitStatuses(context.Background(), ListCommitStatusesRequestObject{
+		Params: models.ListCommitStatusesParams{GitServer: "my-server", Project: "owner/repo"},
+	})
+
+	require.NoError(t, err)
+
+	errResp, ok := resp.(ListCommitStatuses400JSONResponse)
+	require.True(t, ok, "expected ListCommitStatuses400JSONResponse")
+	assert.Equal(t, "sha parameter is required", errResp.Message)
+	assert.Empty(t, stub.gotListProject, "service must not be called without a sha")
+}
+
+func TestCommitStatusHandlerListErrResponse(t *testing.T) {
+	handler := NewCommitStatusHandler(&stubCommitStatusService{})
+
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+	}{
+		{name: "unauthorized", err: fmt.Errorf("token: %w", gferrors.ErrUnauthorized), wantCode: http.StatusUnauthorized},
+		{name: "bad request", err: fmt.Errorf("provider: %w", gferrors.ErrBadRequest), wantCode: http.StatusBadRequest},
+		{name: "not found", err: fmt.Errorf("commit: %w", gferrors.ErrNotFound), wantCode: http.StatusNotFound},
+		{name: "generic", err: errors.New("boom"), wantCode: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var code string
+
+			switch r := handler.listErrResponse(tt.err).(type) {
+			case ListCommitStatuses401JSONResponse:
+				code = r.Code
+			case ListCommitStatuses400JSONResponse:
+				code = r.Code
+			case ListCommitStatuses404JSONResponse:
+				code = r.Code
+			case ListCommitStatuses500JSONResponse:
+				code = r.Code
+			}
+
+			assert.Equal(t, fmt.Sprintf("%d", tt.wantCode), code)
+		})
+	}
+}
+
+func TestCommitStatusHandlerCreateCommitStatus(t *testing.T) {
+	stub := &stubCommitStatusService{
+		createResp: &models.CommitStatus{
+			Kind:    models.CommitStatusKindStatus,
+			Context: "tekton/build",
+			State:   models.CommitStateRunning,
+		},
+	}
+	handler := NewCommitStatusHandler(stub)
+
+	resp, err := handler.CreateCommitStatus(context.Background(), CreateCommitStatusRequestObject{
+		Params: models.CreateCommitStatusParams{
+			GitServer:   "my-server",
+			Project:     "owner/repo",
+			Sha:         "abc123",
+			State:       models.CreateCommitStatusStateRunning,
+			Context:     "tekton/build",
+			Description: pointer.To("Build started"),
+			TargetUrl:   pointer.To("https://tekton.example.com/runs/1"),
+		},
+	})
+
+	require.NoError(t, err)
+
+	created, ok := resp.(CreateCommitStatus201JSONResponse)
+	require.True(t, ok, "expected CreateCommitStatus201JSONResponse")
+	assert.Equal(t, "tekton/build", created.Context)
+	assert.Equal(t, "owner/repo", stub.gotCreateProject)
+	assert.Equal(t, "abc123", stub.gotCreateSha)
+	assert.Equal(t, models.CommitStateRunning, stub.gotCreateOpts.State)
+	assert.Equal(t, "tekton/build", stub.gotCreateOpts.Context)
+	assert.Equal(t, "Build started", *stub.gotCreateOpts.Description)
+	assert.Equal(t, "https://tekton.example.com/runs/1", *stub.gotCreateOpts.TargetURL)
+}
+
+func TestCommitStatusHandlerCreateCommitStatusValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  models.CreateCommitStatusParams
+		wantMsg string
+	}{
+		{
+			name:    "missing context",
+			params:  models.CreateCommitStatusParams{Sha: "abc123", State: models.CreateCommitStatusStateSuccess},
+			wantMsg: "sha and context parameters are required",
+		},
+		{
+			name:    "missing sha",
+			params:  models.CreateCommitStatusParams{Context: "ci", State: models.CreateCommitStatusStateSuccess},
+			wantMsg: "sha and context parameters are required",
+		},
+		{
+			name:    "unknown state",
+			params:  models.CreateCommitStatusParams{Sha: "abc123", Context: "ci", State: "skipped"},
+			wantMsg: "state must be one of pending, running, success, failed or cancelled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubCommitStatusService{}
+			handler := NewCommitStatusHandler(stub)
+
+			resp, err := handler.CreateCommitStatus(context.Background(), CreateCommitStatusRequestObject{Params: tt.params})
+
+			require.NoError(t, err)
+
+			errResp, ok := resp.(CreateCommitStatus400JSONResponse)
+			require.True(t, ok, "expected CreateCommitStatus400JSONResponse")
+			assert.Equal(t, tt.wantMsg, errResp.Message)
+			assert.Empty(t, stub.gotCreateSha, "service must not be called for invalid parameters")
+		})
+	}
+}
+
+func TestCommitStatusHandlerCreateCommitStatusUnsupportedProvider(t *testing.T) {
+	stub := &stubCommitStatusService{
+		err: fmt.Errorf("provider gitea does not support writing commit statuses: %w", gferrors.ErrBadRequest),
+	}
+	handler := NewCommitStatusHandler(stub)
+
+	resp, err := handler.CreateCommitStatus(context.Background(), CreateCommitStatusRequestObject{
+		Params: models.CreateCommitStatusParams{
+			GitServer: "gitea-server",
+			Project:   "owner/repo",
+			Sha:       "abc123",
+			State:     models.CreateCommitStatusStateSuccess,
+			Context:   "ci",
+		},
+	})
+
+	require.NoError(t, err)
+
+	errResp, ok := resp.(CreateCommitStatus400JSONResponse)
+	require.True(t, ok, "expected CreateCommitStatus400JSONResponse")
+	assert.Contains(t, errResp.Message, "does not support writing commit statuses")
+}
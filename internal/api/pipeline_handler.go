@@ -17,24 +17,134 @@ import (
 type pipelineService interface {
 	TriggerPipeline(
 		ctx context.Context,
-		gitServerName, project, ref string,
-		variables []models.PipelineVariable,
+		gitServerName, project string,
+		opts models.PipelineTriggerOptions,
 	) (*models.PipelineResponse, error)
 	ListPipelines(
 		ctx context.Context,
 		gitServerName, project string,
 		opts models.PipelineListOptions,
 	) (*models.PipelinesResponse, error)
+	ListPullRequestPipelines(
+		ctx context.Context,
+		gitServerName, project string,
+		pullRequest int,
+		opts models.PipelineListOptions,
+	) (*models.PipelinesResponse, error)
+	GetPipeline(
+		ctx context.Context,
+		gitServerName, project string,
+		pipelineID string,
+	) (*models.Pipeline, error)
+	GetPipelineGraph(
+		ctx context.Context,
+		gitServerName, project string,
+		pipelineID string,
+		depth int,
+	) (*models.PipelineGraphNode, error)
+	GetPipelineTestReport(
+		ctx context.Context,
+		gitServerName, project string,
+		pipelineID string,
+	) (*models.PipelineTestReport, error)
+	ListPipelineArtifacts(
+		ctx context.Context,
+		gitServerName, project string,
+		pipelineID string,
+		opts models.PipelineArtifactListOptions,
+	) ([]models.PipelineArtifact, error)
+	DownloadArtifact(
+		ctx context.Context,
+		gitServerName, project string,
+		artifactID string,
+	) (*models.ArtifactDownload, error)
+	ListPipelineSchedules(
+		ctx context.Context,
+		gitServerName, project string,
+	) ([]models.PipelineSchedule, error)
+	GetPipelineSchedule(
+		ctx context.Context,
+		gitServerName, project string,
+		scheduleID string,
+	) (*models.PipelineSchedule, error)
+	CreatePipelineSchedule(
+		ctx context.Context,
+		gitServerName, project string,
+		opts models.PipelineScheduleOptions,
+	) (*models.PipelineSchedule, error)
+	UpdatePipelineSchedule(
+		ctx context.Context,
+		gitServerName, project string,
+		scheduleID string,
+		opts models.PipelineScheduleOptions,
+	) (*models.PipelineSchedule, error)
+	DeletePipelineSchedule(
+		ctx context.Context,
+		gitServerName, project string,
+		scheduleID string,
+	) error
+	RunPipelineSchedule(
+		ctx context.Context,
+		gitServerName, project string,
+		scheduleID string,
+	) error
 	ListPipelineJobs(
 		ctx context.Context,
 		gitServerName, project string,
-		pipelineID int,
+		pipelineID string,
 	) ([]models.PipelineJob, error)
 	GetJobTrace(
 		ctx context.Context,
 		gitServerName, project string,
-		jobID int,
-	) (content string, truncated bool, err error)
+		jobID string,
+		w models.JobTraceWindow,
+	) (*models.JobTrace, error)
+	GetStructuredJobTrace(
+		ctx context.Context,
+		gitServerName, project string,
+		jobID string,
+		w models.JobTraceWindow,
+		stripANSI bool,
+	) (*models.JobTrace, error)
+	SearchJobTraces(
+		ctx context.Context,
+		gitServerName, project string,
+		pipelineID string,
+		opts models.JobTraceSearchOptions,
+	) (*models.PipelineJobTraceSearchResponse, error)
+	StreamJobTrace(
+		ctx context.Context,
+		gitServerName, project string,
+		jobID string,
+		offset int64,
+	) (<-chan models.PipelineJobTraceEvent, error)
+	CancelPipeline(
+		ctx context.Context,
+		gitServerName, project string,
+		pipelineID string,
+	) (*models.PipelineActionResponse, error)
+	RetryPipeline(
+		ctx context.Context,
+		gitServerName, project string,
+		pipelineID string,
+		opts models.PipelineRetryOptions,
+	) (*models.PipelineActionResponse, error)
+	PlayJob(
+		ctx context.Context,
+		gitServerName, project string,
+		jobID string,
+		opts models.PipelineJobPlayOptions,
+	) (*models.PipelineJob, error)
+	RetryJob(
+		ctx context.Context,
+		gitServerName, project string,
+		jobID string,
+	) (*models.PipelineJob, error)
+	CancelJob(
+		ctx context.Context,
+		gitServerName, project string,
+		jobID string,
+	) (*models.PipelineJob, error)
 }
 
 // PipelineHandler handles requests related to CI/CD pipelines (all providers).
@@ -76,15 +186,12 @@ func (h *PipelineHandler) TriggerPipeline(
 		}, nil
 	}
 
-	// Parse variables
-	var variables []models.PipelineVariable
-	if request.Params.Variables != nil && *request.Params.Variables != "" {
-		if err := json.Unmarshal([]byte(*request.Params.Variables), &variables); err != nil {
-			return TriggerPipeline400JSONResponse{
-				Code:    fmt.Sprintf("%d", http.StatusBadRequest),
-				Message: fmt.Sprintf("invalid variables JSON format (expected array of {key, value, variableType}): %v", err),
-			}, nil
-		}
+	variables, err := parsePipelineVariables(request.Params.Variables)
+	if err != nil {
+		return TriggerPipeline400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}, nil
 	}
 
 	// Call service
@@ -92,13 +199,21 @@ func (h *PipelineHandler) TriggerPipeline(
 		ctx,
 		request.Params.GitServer,
 		request.Params.Project,
-		request.Params.Ref,
-		variables,
+		models.PipelineTriggerOptions{
+			Ref:       request.Params.Ref,
+			Pipeline:  request.Params.Pipeline,
+			Variables: variables,
+		},
 	)
 	if err != nil {
 		return h.triggerErrResponse(err), nil
 	}
 
+	// The provider accepted the trigger but could not tell which run it created.
+	if pipeline.Id == "" {
+		return TriggerPipeline202JSONResponse(*pipeline), nil
+	}
+
 	return TriggerPipeline201JSONResponse(*pipeline), nil
 }
 
@@ -109,11 +224,6 @@ func (h *PipelineHandler) ListPipelines(
 ) (ListPipelinesResponseObject, error) {
 	page, perPage := clampPagination(request.Params.Page, request.Params.PerPage)
 
-	var ref *string
-	if request.Params.Ref != nil && *request.Params.Ref != "" {
-		ref = request.Params.Ref
-	}
-
 	var status *string
 
 	if request.Params.Status != nil {
@@ -121,15 +231,36 @@ func (h *PipelineHandler) ListPipelines(
 		status = &s
 	}
 
+	var source *string
+
+	if request.Params.Source != nil {
+		s := string(*request.Params.Source)
+		source = &s
+	}
+
+	after, before := request.Params.UpdatedAfter, request.Params.UpdatedBefore
+	if after != nil && before != nil && after.After(*before) {
+		return ListPipelines400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "updatedAfter must not be later than updatedBefore",
+		}, nil
+	}
+
 	resp, err := h.pipelinesService.ListPipelines(
 		ctx,
 		request.Params.GitServer,
 		request.Params.Project,
 		models.PipelineListOptions{
-			Ref:     ref,
-			Status:  status,
-			Page:    page,
-			PerPage: perPage,
+			Ref:           nonEmpty(request.Params.Ref),
+			Status:        status,
+			Sha:           nonEmpty(request.Params.Sha),
+			Source:        source,
+			TriggeredBy:   nonEmpty(request.Params.TriggeredBy),
+			UpdatedAfter:  after,
+			UpdatedBefore: before,
+			Ascending:     request.Params.Sort != nil && *request.Params.Sort == models.ListPipelinesSortAsc,
+			Page:          page,
+			PerPage:       perPage,
 		},
 	)
 	if err != nil {
@@ -139,20 +270,50 @@ func (h *PipelineHandler) ListPipelines(
 	return ListPipelines200JSONResponse(*resp), nil
 }
 
+// ListPullRequestPipelines implements api.StrictServerInterface.
+func (h *PipelineHandler) ListPullRequestPipelines(
+	ctx context.Context,
+	request ListPullRequestPipelinesRequestObject,
+) (ListPullRequestPipelinesResponseObject, error) {
+	if request.Params.PullRequest < 1 {
+		return ListPullRequestPipelines400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: fmt.Sprintf("pullRequest must be a positive number, got %d", request.Params.PullRequest),
+		}, nil
+	}
+
+	page, perPage := clampPagination(request.Params.Page, request.Params.PerPage)
+
+	resp, err := h.pipelinesService.ListPullRequestPipelines(
+		ctx,
+		request.Params.GitServer,
+		request.Params.Project,
+		request.Params.PullRequest,
+		models.PipelineListOptions{Page: page, PerPage: perPage},
+	)
+	if err != nil {
+		return h.listPullRequestPipelinesErrResponse(err), nil
+	}
+
+	return ListPullRequestPipelines200JSONResponse(*resp), nil
+}
+
 // ListPipelineJobs implements api.StrictServerInterface.
 func (h *PipelineHandler) ListPipelineJobs(
 	ctx context.Context,
 	request ListPipelineJobsRequestObject,
 ) (ListPipelineJobsResponseObject, error) {
-	pipelineID, err := strconv.Atoi(request.Params.PipelineId)
-	if err != nil {
+	// IDs are opaque strings: numeric for GitLab/GitHub, UUIDs for Bitbucket. Providers validate the format.
+	if request.Params.PipelineId == "" {
 		return ListPipelineJobs400JSONResponse{
 			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
-			Message: "pipelineId must be a numeric value",
+			Message: "pipelineId parameter is required",
 		}, nil
 	}
 
-	jobs, err := h.pipelinesService.ListPipelineJobs(ctx, request.Params.GitServer, request.Params.Project, pipelineID)
+	jobs, err := h.pipelinesService.ListPipelineJobs(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.PipelineId,
+	)
 	if err != nil {
 		return h.jobsErrResponse(err), nil
 	}
@@ -160,116 +321,1292 @@ func (h *PipelineHandler) ListPipelineJobs(
 	return ListPipelineJobs200JSONResponse(models.PipelineJobsResponse{Data: jobs}), nil
 }
 
-// GetPipelineJobTrace implements api.StrictServerInterface.
-func (h *PipelineHandler) GetPipelineJobTrace(
+// GetPipeline implements api.StrictServerInterface.
+func (h *PipelineHandler) GetPipeline(
 	ctx context.Context,
-	request GetPipelineJobTraceRequestObject,
-) (GetPipelineJobTraceResponseObject, error) {
-	jobID, err := strconv.Atoi(request.Params.JobId)
+	request GetPipelineRequestObject,
+) (GetPipelineResponseObject, error) {
+	if request.Params.PipelineId == "" {
+		return GetPipeline400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "pipelineId parameter is required",
+		}, nil
+	}
+
+	pipeline, err := h.pipelinesService.GetPipeline(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.PipelineId,
+	)
 	if err != nil {
-		return GetPipelineJobTrace400JSONResponse{
+		return h.getPipelineErrResponse(err), nil
+	}
+
+	return GetPipeline200JSONResponse(*pipeline), nil
+}
+
+// defaultPipelineGraphDepth and maxPipelineGraphDepth are the default and largest number of
+// downstream levels a pipeline graph resolves.
+const (
+	defaultPipelineGraphDepth = 2
+	maxPipelineGraphDepth     = 5
+)
+
+// GetPipelineGraph implements api.StrictServerInterface.
+func (h *PipelineHandler) GetPipelineGraph(
+	ctx context.Context,
+	request GetPipelineGraphRequestObject,
+) (GetPipelineGraphResponseObject, error) {
+	params := request.Params
+
+	if params.PipelineId == "" {
+		return GetPipelineGraph400JSONResponse{
 			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
-			Message: "jobId must be a numeric value",
+			Message: "pipelineId parameter is required",
 		}, nil
 	}
 
-	trace, truncated, err := h.pipelinesService.GetJobTrace(ctx, request.Params.GitServer, request.Params.Project, jobID)
+	depth := defaultPipelineGraphDepth
+
+	if params.Depth != nil {
+		if *params.Depth < 0 || *params.Depth > maxPipelineGraphDepth {
+			return GetPipelineGraph400JSONResponse{
+				Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+				Message: fmt.Sprintf("depth must be between 0 and %d, got %d", maxPipelineGraphDepth, *params.Depth),
+			}, nil
+		}
+
+		depth = *params.Depth
+	}
+
+	graph, err := h.pipelinesService.GetPipelineGraph(ctx, params.GitServer, params.Project, params.PipelineId, depth)
 	if err != nil {
-		return h.traceErrResponse(err), nil
+		return h.graphErrResponse(err), nil
 	}
 
-	resp := models.PipelineJobTraceResponse{
-		JobId:     request.Params.JobId,
-		Content:   trace,
-		Truncated: &truncated,
+	return GetPipelineGraph200JSONResponse(*graph), nil
+}
+
+// GetPipelineTestReport implements api.StrictServerInterface.
+func (h *PipelineHandler) GetPipelineTestReport(
+	ctx context.Context,
+	request GetPipelineTestReportRequestObject,
+) (GetPipelineTestReportResponseObject, error) {
+	if request.Params.PipelineId == "" {
+		return GetPipelineTestReport400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "pipelineId parameter is required",
+		}, nil
 	}
 
-	return GetPipelineJobTrace200JSONResponse(resp), nil
+	report, err := h.pipelinesService.GetPipelineTestReport(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.PipelineId,
+	)
+	if err != nil {
+		return h.testReportErrResponse(err), nil
+	}
+
+	return GetPipelineTestReport200JSONResponse(*report), nil
 }
 
-// jobsErrResponse maps errors to response objects for ListPipelineJobs.
-func (h *PipelineHandler) jobsErrResponse(err error) ListPipelineJobsResponseObject {
-	if errors.Is(err, gferrors.ErrUnauthorized) {
-		return ListPipelineJobs401JSONResponse{
-			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
-			Message: err.Error(),
-		}
+// ListPipelineArtifacts implements api.StrictServerInterface.
+func (h *PipelineHandler) ListPipelineArtifacts(
+	ctx context.Context,
+	request ListPipelineArtifactsRequestObject,
+) (ListPipelineArtifactsResponseObject, error) {
+	if request.Params.PipelineId == "" {
+		return ListPipelineArtifacts400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "pipelineId parameter is required",
+		}, nil
 	}
 
-	if errors.Is(err, gferrors.ErrBadRequest) {
-		return ListPipelineJobs400JSONResponse{
+	artifacts, err := h.pipelinesService.ListPipelineArtifacts(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.PipelineId,
+		models.PipelineArtifactListOptions{JobID: request.Params.JobId},
+	)
+	if err != nil {
+		return h.listArtifactsErrResponse(err), nil
+	}
+
+	return ListPipelineArtifacts200JSONResponse{Data: artifacts}, nil
+}
+
+// DownloadPipelineArtifact implements api.StrictServerInterface.
+func (h *PipelineHandler) DownloadPipelineArtifact(
+	ctx context.Context,
+	request DownloadPipelineArtifactRequestObject,
+) (DownloadPipelineArtifactResponseObject, error) {
+	if request.Params.ArtifactId == "" {
+		return DownloadPipelineArtifact400JSONResponse{
 			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
-			Message: err.Error(),
-		}
+			Message: "artifactId parameter is required",
+		}, nil
 	}
 
-	if errors.Is(err, gferrors.ErrNotFound) {
-		return ListPipelineJobs404JSONResponse{
-			Code:    fmt.Sprintf("%d", http.StatusNotFound),
-			Message: err.Error(),
-		}
+	download, err := h.pipelinesService.DownloadArtifact(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.ArtifactId,
+	)
+	if err != nil {
+		return h.downloadArtifactErrResponse(err), nil
 	}
 
-	return ListPipelineJobs500JSONResponse{
-		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
-		Message: err.Error(),
+	return artifactDownloadResponse{download: download}, nil
+}
+
+// ListPipelineSchedules implements api.StrictServerInterface.
+func (h *PipelineHandler) ListPipelineSchedules(
+	ctx context.Context,
+	request ListPipelineSchedulesRequestObject,
+) (ListPipelineSchedulesResponseObject, error) {
+	schedules, err := h.pipelinesService.ListPipelineSchedules(ctx, request.Params.GitServer, request.Params.Project)
+	if err != nil {
+		return h.listSchedulesErrResponse(err), nil
 	}
+
+	return ListPipelineSchedules200JSONResponse{Data: schedules}, nil
 }
 
-// traceErrResponse maps errors to response objects for GetPipelineJobTrace.
-func (h *PipelineHandler) traceErrResponse(err error) GetPipelineJobTraceResponseObject {
-	if errors.Is(err, gferrors.ErrUnauthorized) {
-		return GetPipelineJobTrace401JSONResponse{
-			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
-			Message: err.Error(),
-		}
+// GetPipelineSchedule implements api.StrictServerInterface.
+func (h *PipelineHandler) GetPipelineSchedule(
+	ctx context.Context,
+	request GetPipelineScheduleRequestObject,
+) (GetPipelineScheduleResponseObject, error) {
+	if request.Params.ScheduleId == "" {
+		return GetPipelineSchedule400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "scheduleId parameter is required",
+		}, nil
 	}
 
-	if errors.Is(err, gferrors.ErrBadRequest) {
-		return GetPipelineJobTrace400JSONResponse{
+	schedule, err := h.pipelinesService.GetPipelineSchedule(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.ScheduleId,
+	)
+	if err != nil {
+		return h.getScheduleErrResponse(err), nil
+	}
+
+	return GetPipelineSchedule200JSONResponse(*schedule), nil
+}
+
+// CreatePipelineSchedule implements api.StrictServerInterface.
+func (h *PipelineHandler) CreatePipelineSchedule(
+	ctx context.Context,
+	request CreatePipelineScheduleRequestObject,
+) (CreatePipelineScheduleResponseObject, error) {
+	params := request.Params
+
+	if params.Description == "" || params.Ref == "" || params.Cron == "" {
+		return CreatePipelineSchedule400JSONResponse{
 			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
-			Message: err.Error(),
-		}
+			Message: "description, ref and cron parameters are required",
+		}, nil
 	}
 
-	if errors.Is(err, gferrors.ErrNotFound) {
-		return GetPipelineJobTrace404JSONResponse{
-			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+	variables, err := parsePipelineVariables(params.Variables)
+	if err != nil {
+		return CreatePipelineSchedule400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
 			Message: err.Error(),
-		}
+		}, nil
 	}
 
-	return GetPipelineJobTrace500JSONResponse{
-		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
-		Message: err.Error(),
+	opts := models.PipelineScheduleOptions{
+		Description:  &params.Description,
+		Ref:          &params.Ref,
+		Cron:         &params.Cron,
+		CronTimezone: params.CronTimezone,
+		Active:       params.Active,
+		Variables:    variables,
+	}
+
+	schedule, err := h.pipelinesService.CreatePipelineSchedule(ctx, params.GitServer, params.Project, opts)
+	if err != nil {
+		return h.createScheduleErrResponse(err), nil
 	}
+
+	return CreatePipelineSchedule201JSONResponse(*schedule), nil
 }
 
-// triggerErrResponse maps errors to appropriate HTTP response objects for TriggerPipeline.
-// This method must only be called when err is not nil.
-func (h *PipelineHandler) triggerErrResponse(err error) TriggerPipelineResponseObject {
-	if errors.Is(err, gferrors.ErrUnauthorized) {
-		return TriggerPipeline401JSONResponse{
-			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
-			Message: err.Error(),
+// UpdatePipelineSchedule implements api.StrictServerInterface.
+func (h *PipelineHandler) UpdatePipelineSchedule(
+	ctx context.Context,
+	request UpdatePipelineScheduleRequestObject,
+) (UpdatePipelineScheduleResponseObject, error) {
+	params := request.Params
+
+	if params.ScheduleId == "" {
+		return UpdatePipelineSchedule400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "scheduleId parameter is required",
+		}, nil
+	}
+
+	opts := models.PipelineScheduleOptions{
+		Description:  params.Description,
+		Ref:          params.Ref,
+		Cron:         params.Cron,
+		CronTimezone: params.CronTimezone,
+		Active:       params.Active,
+	}
+
+	// Unlike create, a present variables parameter replaces the schedule's variables,
+	// so "[]" must reach the provider as an empty, non-nil list.
+	if params.Variables != nil && *params.Variables != "" {
+		variables, err := parsePipelineVariables(params.Variables)
+		if err != nil {
+			return UpdatePipelineSchedule400JSONResponse{
+				Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+				Message: err.Error(),
+			}, nil
+		}
+
+		if variables == nil {
+			variables = []models.PipelineVariable{}
 		}
+
+		opts.Variables = variables
 	}
 
-	if errors.Is(err, gferrors.ErrBadRequest) {
-		return TriggerPipeline400JSONResponse{
+	schedule, err := h.pipelinesService.UpdatePipelineSchedule(
+		ctx, params.GitServer, params.Project, params.ScheduleId, opts,
+	)
+	if err != nil {
+		return h.updateScheduleErrResponse(err), nil
+	}
+
+	return UpdatePipelineSchedule200JSONResponse(*schedule), nil
+}
+
+// DeletePipelineSchedule implements api.StrictServerInterface.
+func (h *PipelineHandler) DeletePipelineSchedule(
+	ctx context.Context,
+	request DeletePipelineScheduleRequestObject,
+) (DeletePipelineScheduleResponseObject, error) {
+	if request.Params.ScheduleId == "" {
+		return DeletePipelineSchedule400JSONResponse{
 			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
-			Message: err.Error(),
-		}
+			Message: "scheduleId parameter is required",
+		}, nil
 	}
 
-	if errors.Is(err, gferrors.ErrNotFound) {
-		return TriggerPipeline404JSONResponse{
-			Code:    fmt.Sprintf("%d", http.StatusNotFound),
-			Message: err.Error(),
-		}
+	err := h.pipelinesService.DeletePipelineSchedule(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.ScheduleId,
+	)
+	if err != nil {
+		return h.deleteScheduleErrResponse(err), nil
 	}
 
-	return TriggerPipeline500JSONResponse{
+	return DeletePipelineSchedule204Response{}, nil
+}
+
+// RunPipelineSchedule implements api.StrictServerInterface.
+func (h *PipelineHandler) RunPipelineSchedule(
+	ctx context.Context,
+	request RunPipelineScheduleRequestObject,
+) (RunPipelineScheduleResponseObject, error) {
+	if request.Params.ScheduleId == "" {
+		return RunPipelineSchedule400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "scheduleId parameter is required",
+		}, nil
+	}
+
+	err := h.pipelinesService.RunPipelineSchedule(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.ScheduleId,
+	)
+	if err != nil {
+		return h.runScheduleErrResponse(err), nil
+	}
+
+	return RunPipelineSchedule202Response{}, nil
+}
+
+// GetPipelineJobTrace implements api.StrictServerInterface.
+func (h *PipelineHandler) GetPipelineJobTrace(
+	ctx context.Context,
+	request GetPipelineJobTraceRequestObject,
+) (GetPipelineJobTraceResponseObject, error) {
+	if request.Params.JobId == "" {
+		return GetPipelineJobTrace400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "jobId parameter is required",
+		}, nil
+	}
+
+	window, err := traceWindow(request.Params)
+	if err != nil {
+		return GetPipelineJobTrace400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}, nil
+	}
+
+	var trace *models.JobTrace
+
+	if request.Params.Format != nil && *request.Params.Format == models.PipelineJobTraceFormatStructured {
+		stripANSI := request.Params.Ansi != nil && *request.Params.Ansi == models.PipelineJobTraceAnsiStrip
+
+		trace, err = h.pipelinesService.GetStructuredJobTrace(
+			ctx, request.Params.GitServer, request.Params.Project, request.Params.JobId, window, stripANSI,
+		)
+	} else {
+		trace, err = h.pipelinesService.GetJobTrace(
+			ctx, request.Params.GitServer, request.Params.Project, request.Params.JobId, window,
+		)
+	}
+
+	if err != nil {
+		return h.traceErrResponse(err), nil
+	}
+
+	resp := models.PipelineJobTraceResponse{
+		JobId:      request.Params.JobId,
+		Content:    trace.Content,
+		Truncated:  &trace.Truncated,
+		Offset:     &trace.Offset,
+		TotalSize:  trace.TotalSize,
+		Structured: trace.Structure,
+	}
+
+	return GetPipelineJobTrace200JSONResponse(resp), nil
+}
+
+// traceWindow builds the trace window requested by the offset, limit and tail parameters.
+func traceWindow(params models.GetPipelineJobTraceParams) (models.JobTraceWindow, error) {
+	var w models.JobTraceWindow
+
+	if params.Tail != nil {
+		if params.Offset != nil || params.Limit != nil {
+			return w, errors.New("tail cannot be combined with offset or limit")
+		}
+
+		if *params.Tail < 1 {
+			return w, fmt.Errorf("tail must be positive, got %d", *params.Tail)
+		}
+
+		w.TailLines = *params.Tail
+
+		return w, nil
+	}
+
+	if params.Offset != nil {
+		if *params.Offset < 0 {
+			return w, fmt.Errorf("offset must not be negative, got %d", *params.Offset)
+		}
+
+		w.Offset = *params.Offset
+	}
+
+	if params.Limit != nil {
+		if *params.Limit < 1 {
+			return w, fmt.Errorf("limit must be positive, got %d", *params.Limit)
+		}
+
+		w.Limit = *params.Limit
+	}
+
+	return w, nil
+}
+
+// StreamPipelineJobTrace implements api.StrictServerInterface.
+func (h *PipelineHandler) StreamPipelineJobTrace(
+	ctx context.Context,
+	request StreamPipelineJobTraceRequestObject,
+) (StreamPipelineJobTraceResponseObject, error) {
+	if request.Params.JobId == "" {
+		return StreamPipelineJobTrace400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "jobId parameter is required",
+		}, nil
+	}
+
+	offset, err := traceStreamOffset(request.Params)
+	if err != nil {
+		return StreamPipelineJobTrace400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}, nil
+	}
+
+	events, err := h.pipelinesService.StreamJobTrace(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.JobId, offset,
+	)
+	if err != nil {
+		return h.traceStreamErrResponse(err), nil
+	}
+
+	return traceEventStream{events: events, keepAlive: traceStreamKeepAlive}, nil
+}
+
+// traceStreamOffset returns the offset to stream from: Last-Event-ID, sent by a reconnecting
+// EventSource, takes precedence over the offset parameter.
+func traceStreamOffset(params models.StreamPipelineJobTraceParams) (int64, error) {
+	offset := int64(0)
+	if params.Offset != nil {
+		offset = *params.Offset
+	}
+
+	if params.LastEventID != nil && *params.LastEventID != "" {
+		lastEventID, err := strconv.ParseInt(*params.LastEventID, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid Last-Event-ID %q: must be a byte offset", *params.LastEventID)
+		}
+
+		offset = lastEventID
+	}
+
+	if offset < 0 {
+		return 0, fmt.Errorf("offset must not be negative, got %d", offset)
+	}
+
+	return offset, nil
+}
+
+const (
+	// maxTraceSearchQueryLength caps the length of a trace search query.
+	maxTraceSearchQueryLength = 1000
+
+	// defaultTraceSearchContext and maxTraceSearchContext are the default and largest number of
+	// lines returned around a trace search match.
+	defaultTraceSearchContext = 2
+	maxTraceSearchContext     = 10
+)
+
+// SearchPipelineJobTraces implements api.StrictServerInterface.
+func (h *PipelineHandler) SearchPipelineJobTraces(
+	ctx context.Context,
+	request SearchPipelineJobTracesRequestObject,
+) (SearchPipelineJobTracesResponseObject, error) {
+	opts, err := traceSearchOptions(request.Params)
+	if err != nil {
+		return SearchPipelineJobTraces400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}, nil
+	}
+
+	resp, err := h.pipelinesService.SearchJobTraces(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.PipelineId, opts,
+	)
+	if err != nil {
+		return h.traceSearchErrResponse(err), nil
+	}
+
+	return SearchPipelineJobTraces200JSONResponse(*resp), nil
+}
+
+// traceSearchOptions validates the trace search parameters and builds the search options.
+func traceSearchOptions(params models.SearchPipelineJobTracesParams) (models.JobTraceSearchOptions, error) {
+	opts := models.JobTraceSearchOptions{
+		Query:      params.Query,
+		Regex:      params.Regex != nil && *params.Regex,
+		IgnoreCase: params.IgnoreCase != nil && *params.IgnoreCase,
+		Context:    defaultTraceSearchContext,
+	}
+
+	if params.PipelineId == "" {
+		return opts, errors.New("pipelineId parameter is required")
+	}
+
+	if params.Query == "" {
+		return opts, errors.New("query parameter is required")
+	}
+
+	if len(params.Query) > maxTraceSearchQueryLength {
+		return opts, fmt.Errorf("query must be at most %d characters", maxTraceSearchQueryLength)
+	}
+
+	if params.Context != nil {
+		if *params.Context < 0 || *params.Context > maxTraceSearchContext {
+			return opts, fmt.Errorf("context must be between 0 and %d, got %d", maxTraceSearchContext, *params.Context)
+		}
+
+		opts.Context = *params.Context
+	}
+
+	return opts, nil
+}
+
+// CancelPipeline implements api.StrictServerInterface.
+func (h *PipelineHandler) CancelPipeline(
+	ctx context.Context,
+	request CancelPipelineRequestObject,
+) (CancelPipelineResponseObject, error) {
+	if request.Params.PipelineId == "" {
+		return CancelPipeline400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "pipelineId parameter is required",
+		}, nil
+	}
+
+	resp, err := h.pipelinesService.CancelPipeline(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.PipelineId,
+	)
+	if err != nil {
+		return h.cancelErrResponse(err), nil
+	}
+
+	return CancelPipeline200JSONResponse(*resp), nil
+}
+
+// RetryPipeline implements api.StrictServerInterface.
+func (h *PipelineHandler) RetryPipeline(
+	ctx context.Context,
+	request RetryPipelineRequestObject,
+) (RetryPipelineResponseObject, error) {
+	if request.Params.PipelineId == "" {
+		return RetryPipeline400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "pipelineId parameter is required",
+		}, nil
+	}
+
+	opts := models.PipelineRetryOptions{
+		FailedOnly: request.Params.FailedOnly != nil && *request.Params.FailedOnly,
+	}
+
+	resp, err := h.pipelinesService.RetryPipeline(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.PipelineId, opts,
+	)
+	if err != nil {
+		return h.retryErrResponse(err), nil
+	}
+
+	return RetryPipeline200JSONResponse(*resp), nil
+}
+
+// PlayPipelineJob implements api.StrictServerInterface.
+func (h *PipelineHandler) PlayPipelineJob(
+	ctx context.Context,
+	request PlayPipelineJobRequestObject,
+) (PlayPipelineJobResponseObject, error) {
+	if request.Params.JobId == "" {
+		return PlayPipelineJob400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "jobId parameter is required",
+		}, nil
+	}
+
+	variables, err := parsePipelineVariables(request.Params.Variables)
+	if err != nil {
+		return PlayPipelineJob400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}, nil
+	}
+
+	job, err := h.pipelinesService.PlayJob(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.JobId,
+		models.PipelineJobPlayOptions{Variables: variables},
+	)
+	if err != nil {
+		return h.playJobErrResponse(err), nil
+	}
+
+	return PlayPipelineJob200JSONResponse(*job), nil
+}
+
+// RetryPipelineJob implements api.StrictServerInterface.
+func (h *PipelineHandler) RetryPipelineJob(
+	ctx context.Context,
+	request RetryPipelineJobRequestObject,
+) (RetryPipelineJobResponseObject, error) {
+	if request.Params.JobId == "" {
+		return RetryPipelineJob400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "jobId parameter is required",
+		}, nil
+	}
+
+	job, err := h.pipelinesService.RetryJob(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.JobId,
+	)
+	if err != nil {
+		return h.retryJobErrResponse(err), nil
+	}
+
+	return RetryPipelineJob200JSONResponse(*job), nil
+}
+
+// CancelPipelineJob implements api.StrictServerInterface.
+func (h *PipelineHandler) CancelPipelineJob(
+	ctx context.Context,
+	request CancelPipelineJobRequestObject,
+) (CancelPipelineJobResponseObject, error) {
+	if request.Params.JobId == "" {
+		return CancelPipelineJob400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: "jobId parameter is required",
+		}, nil
+	}
+
+	job, err := h.pipelinesService.CancelJob(
+		ctx, request.Params.GitServer, request.Params.Project, request.Params.JobId,
+	)
+	if err != nil {
+		return h.cancelJobErrResponse(err), nil
+	}
+
+	return CancelPipelineJob200JSONResponse(*job), nil
+}
+
+// nonEmpty returns an optional query parameter, treating an empty value as absent.
+func nonEmpty(param *string) *string {
+	if param == nil || *param == "" {
+		return nil
+	}
+
+	return param
+}
+
+// parsePipelineVariables decodes the JSON variables query parameter; a missing or empty
+// parameter yields no variables.
+func parsePipelineVariables(raw *string) ([]models.PipelineVariable, error) {
+	if raw == nil || *raw == "" {
+		return nil, nil
+	}
+
+	var variables []models.PipelineVariable
+	if err := json.Unmarshal([]byte(*raw), &variables); err != nil {
+		return nil, fmt.Errorf("invalid variables JSON format (expected array of {key, value, variableType}): %w", err)
+	}
+
+	return variables, nil
+}
+
+// playJobErrResponse maps errors to response objects for PlayPipelineJob.
+func (h *PipelineHandler) playJobErrResponse(err error) PlayPipelineJobResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return PlayPipelineJob401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return PlayPipelineJob400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return PlayPipelineJob404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return PlayPipelineJob500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// retryJobErrResponse maps errors to response objects for RetryPipelineJob.
+func (h *PipelineHandler) retryJobErrResponse(err error) RetryPipelineJobResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return RetryPipelineJob401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return RetryPipelineJob400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return RetryPipelineJob404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return RetryPipelineJob500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// cancelJobErrResponse maps errors to response objects for CancelPipelineJob.
+func (h *PipelineHandler) cancelJobErrResponse(err error) CancelPipelineJobResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return CancelPipelineJob401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return CancelPipelineJob400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return CancelPipelineJob404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return CancelPipelineJob500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// cancelErrResponse maps errors to response objects for CancelPipeline.
+func (h *PipelineHandler) cancelErrResponse(err error) CancelPipelineResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return CancelPipeline401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return CancelPipeline400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return CancelPipeline404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return CancelPipeline500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// retryErrResponse maps errors to response objects for RetryPipeline.
+func (h *PipelineHandler) retryErrResponse(err error) RetryPipelineResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return RetryPipeline401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return RetryPipeline400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return RetryPipeline404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return RetryPipeline500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// traceStreamErrResponse maps errors to response objects for StreamPipelineJobTrace.
+func (h *PipelineHandler) traceStreamErrResponse(err error) StreamPipelineJobTraceResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return StreamPipelineJobTrace401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return StreamPipelineJobTrace400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return StreamPipelineJobTrace404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return StreamPipelineJobTrace500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// getPipelineErrResponse maps errors to response objects for GetPipeline.
+func (h *PipelineHandler) getPipelineErrResponse(err error) GetPipelineResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return GetPipeline401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return GetPipeline400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return GetPipeline404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return GetPipeline500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// graphErrResponse maps errors to response objects for GetPipelineGraph.
+func (h *PipelineHandler) graphErrResponse(err error) GetPipelineGraphResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return GetPipelineGraph401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return GetPipelineGraph400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return GetPipelineGraph404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return GetPipelineGraph500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// listArtifactsErrResponse maps errors to response objects for ListPipelineArtifacts.
+func (h *PipelineHandler) listArtifactsErrResponse(err error) ListPipelineArtifactsResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return ListPipelineArtifacts401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return ListPipelineArtifacts400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return ListPipelineArtifacts404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return ListPipelineArtifacts500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// downloadArtifactErrResponse maps errors to response objects for DownloadPipelineArtifact.
+func (h *PipelineHandler) downloadArtifactErrResponse(err error) DownloadPipelineArtifactResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return DownloadPipelineArtifact401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return DownloadPipelineArtifact400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return DownloadPipelineArtifact404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return DownloadPipelineArtifact500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// listSchedulesErrResponse maps errors to response objects for ListPipelineSchedules.
+func (h *PipelineHandler) listSchedulesErrResponse(err error) ListPipelineSchedulesResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return ListPipelineSchedules401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return ListPipelineSchedules400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return ListPipelineSchedules404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return ListPipelineSchedules500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// getScheduleErrResponse maps errors to response objects for GetPipelineSchedule.
+func (h *PipelineHandler) getScheduleErrResponse(err error) GetPipelineScheduleResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return GetPipelineSchedule401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return GetPipelineSchedule400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return GetPipelineSchedule404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return GetPipelineSchedule500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// createScheduleErrResponse maps errors to response objects for CreatePipelineSchedule.
+func (h *PipelineHandler) createScheduleErrResponse(err error) CreatePipelineScheduleResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return CreatePipelineSchedule401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return CreatePipelineSchedule400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return CreatePipelineSchedule404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return CreatePipelineSchedule500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// updateScheduleErrResponse maps errors to response objects for UpdatePipelineSchedule.
+func (h *PipelineHandler) updateScheduleErrResponse(err error) UpdatePipelineScheduleResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return UpdatePipelineSchedule401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return UpdatePipelineSchedule400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return UpdatePipelineSchedule404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return UpdatePipelineSchedule500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// deleteScheduleErrResponse maps errors to response objects for DeletePipelineSchedule.
+func (h *PipelineHandler) deleteScheduleErrResponse(err error) DeletePipelineScheduleResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return DeletePipelineSchedule401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return DeletePipelineSchedule400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return DeletePipelineSchedule404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return DeletePipelineSchedule500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// runScheduleErrResponse maps errors to response objects for RunPipelineSchedule.
+func (h *PipelineHandler) runScheduleErrResponse(err error) RunPipelineScheduleResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return RunPipelineSchedule401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return RunPipelineSchedule400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return RunPipelineSchedule404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return RunPipelineSchedule500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// testReportErrResponse maps errors to response objects for GetPipelineTestReport.
+func (h *PipelineHandler) testReportErrResponse(err error) GetPipelineTestReportResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return GetPipelineTestReport401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return GetPipelineTestReport400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return GetPipelineTestReport404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return GetPipelineTestReport500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// jobsErrResponse maps errors to response objects for ListPipelineJobs.
+func (h *PipelineHandler) jobsErrResponse(err error) ListPipelineJobsResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return ListPipelineJobs401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return ListPipelineJobs400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return ListPipelineJobs404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return ListPipelineJobs500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// traceErrResponse maps errors to response objects for GetPipelineJobTrace.
+func (h *PipelineHandler) traceErrResponse(err error) GetPipelineJobTraceResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return GetPipelineJobTrace401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return GetPipelineJobTrace400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return GetPipelineJobTrace404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return GetPipelineJobTrace500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// traceSearchErrResponse maps errors to response objects for SearchPipelineJobTraces.
+func (h *PipelineHandler) traceSearchErrResponse(err error) SearchPipelineJobTracesResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return SearchPipelineJobTraces401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return SearchPipelineJobTraces400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return SearchPipelineJobTraces404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return SearchPipelineJobTraces500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// triggerErrResponse maps errors to appropriate HTTP response objects for TriggerPipeline.
+// This method must only be called when err is not nil.
+func (h *PipelineHandler) triggerErrResponse(err error) TriggerPipelineResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return TriggerPipeline401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return TriggerPipeline400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return TriggerPipeline404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return TriggerPipeline500JSONResponse{
+		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
+		Message: err.Error(),
+	}
+}
+
+// listPullRequestPipelinesErrResponse maps errors to response objects for ListPullRequestPipelines.
+func (h *PipelineHandler) listPullRequestPipelinesErrResponse(err error) ListPullRequestPipelinesResponseObject {
+	if errors.Is(err, gferrors.ErrUnauthorized) {
+		return ListPullRequestPipelines401JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusUnauthorized),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrBadRequest) {
+		return ListPullRequestPipelines400JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusBadRequest),
+			Message: err.Error(),
+		}
+	}
+
+	if errors.Is(err, gferrors.ErrNotFound) {
+		return ListPullRequestPipelines404JSONResponse{
+			Code:    fmt.Sprintf("%d", http.StatusNotFound),
+			Message: err.Error(),
+		}
+	}
+
+	return ListPullRequestPipelines500JSONResponse{
 		Code:    fmt.Sprintf("%d", http.StatusInternalServerError),
 		Message: err.Error(),
 	}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"testing/iotest"
 	"time"
 
 	"github.com/stretchr/testify/assert"
@@ -23,8 +26,7 @@ type stubPipelineService struct {
 	// TriggerPipeline captures
 	gotTriggerGitServer string
 	gotTriggerProject   string
-	gotTriggerRef       string
-	gotTriggerVars      []models.PipelineVariable
+	gotTriggerOpts      models.PipelineTriggerOptions
 	triggerResp         *models.PipelineResponse
 	triggerErr          error
 
@@ -35,31 +37,96 @@ type stubPipelineService struct {
 	listResp         *models.PipelinesResponse
 	listErr          error
 
+	// GetPipeline captures
+	gotGetPipelineID string
+	pipelineResp     *models.Pipeline
+	pipelineErr      error
+
+	// GetPipelineTestReport captures
+	gotTestReportPipelineID string
+	testReportResp          *models.PipelineTestReport
+	testReportErr           error
+
+	// ListPipelineArtifacts / DownloadArtifact captures
+	gotArtifactsPipelineID string
+	gotArtifactsOpts       models.PipelineArtifactListOptions
+	gotArtifactID          string
+	artifactsResp          []models.PipelineArtifact
+	artifactsErr           error
+	downloadResp           *models.ArtifactDownload
+	downloadErr            error
+
+	// ListPullRequestPipelines captures
+	gotPullRequest     int
+	gotPullRequestOpts models.PipelineListOptions
+	prPipelinesResp    *models.PipelinesResponse
+	prPipelinesErr     error
+
+	// GetPipelineGraph captures
+	gotGraphPipelineID string
+	gotGraphDepth      int
+	graphResp          *models.PipelineGraphNode
+	graphErr           error
+
+	// Pipeline schedule captures
+	gotScheduleID   string
+	gotScheduleOpts models.PipelineScheduleOptions
+	schedulesResp   []models.PipelineSchedule
+	scheduleResp    *models.PipelineSchedule
+	scheduleErr     error
+
 	// ListPipelineJobs captures
 	gotJobsGitServer  string
 	gotJobsProject    string
-	gotJobsPipelineID int
+	gotJobsPipelineID string
 	jobsResp          []models.PipelineJob
 	jobsErr           error
 
 	// GetJobTrace captures
 	gotTraceGitServer string
 	gotTraceProject   string
-	gotTraceJobID     int
-	traceResp         string
-	traceTruncated    bool
+	gotTraceJobID     string
+	gotTraceWindow    models.JobTraceWindow
+	gotTraceStripANSI bool
+	gotStructured     bool
+	traceResp         *models.JobTrace
 	traceErr          error
+
+	// SearchJobTraces captures
+	gotSearchPipelineID string
+	gotSearchOpts       models.JobTraceSearchOptions
+	searchResp          *models.PipelineJobTraceSearchResponse
+	searchErr           error
+
+	// StreamJobTrace captures
+	gotStreamJobID  string
+	gotStreamOffset int64
+	streamEvents    chan models.PipelineJobTraceEvent
+	streamErr       error
+
+	// CancelPipeline / RetryPipeline captures
+	gotActionGitServer  string
+	gotActionProject    string
+	gotActionPipelineID string
+	gotRetryOpts        models.PipelineRetryOptions
+	actionResp          *models.PipelineActionResponse
+	actionErr           error
+
+	// PlayJob / RetryJob / CancelJob captures
+	gotJobActionJobID string
+	gotPlayOpts       models.PipelineJobPlayOptions
+	jobActionResp     *models.PipelineJob
+	jobActionErr      error
 }
 
 func (s *stubPipelineService) TriggerPipeline(
 	_ context.Context,
-	gitServerName, project, ref string,
-	variables []models.PipelineVariable,
+	gitServerName, project string,
+	opts models.PipelineTriggerOptions,
 ) (*models.PipelineResponse, error) {
 	s.gotTriggerGitServer = gitServerName
 	s.gotTriggerProject = project
-	s.gotTriggerRef = ref
-	s.gotTriggerVars = variables
+	s.gotTriggerOpts = opts
 
 	return s.triggerResp, s.triggerErr
 }
@@ -76,10 +143,20 @@ func (s *stubPipelineService) ListPipelines(
 	return s.listResp, s.listErr
 }
 
+func (s *stubPipelineService) GetPipeline(
+	_ context.Context,
+	_, _ string,
+	pipelineID string,
+) (*models.Pipeline, error) {
+	s.gotGetPipelineID = pipelineID
+
+	return s.pipelineResp, s.pipelineErr
+}
+
 func (s *stubPipelineService) ListPipelineJobs(
 	_ context.Context,
 	gitServerName, project string,
-	pipelineID int,
+	pipelineID string,
 ) ([]models.PipelineJob, error) {
 	s.gotJobsGitServer = gitServerName
 	s.gotJobsProject = project
@@ -91,13 +168,231 @@ func (s *stubPipelineService) ListPipelineJobs(
 func (s *stubPipelineService) GetJobTrace(
 	_ context.Context,
 	gitServerName, project string,
-	jobID int,
-) (string, bool, error) {
+	jobID string,
+	w models.JobTraceWindow,
+) (*models.JobTrace, error) {
 	s.gotTraceGitServer = gitServerName
 	s.gotTraceProject = project
 	s.gotTraceJobID = jobID
+	s.gotTraceWindow = w
+
+	return s.traceResp, s.traceErr
+}
+
+func (s *stubPipelineService) GetStructuredJobTrace(
+	_ context.Context,
+	_, _ string,
+	jobID string,
+	w models.JobTraceWindow,
+	stripANSI bool,
+) (*models.JobTrace, error) {
+	s.gotTraceJobID = jobID
+	s.gotTraceWindow = w
+	s.gotTraceStripANSI = stripANSI
+	s.gotStructured = true
+
+	return s.traceResp, s.traceErr
+}
+
+func (s *stubPipelineService) GetPipelineTestReport(
+	_ context.Context,
+	_, _ string,
+	pipelineID string,
+) (*models.PipelineTestReport, error) {
+	s.gotTestReportPipelineID = pipelineID
+
+	return s.testReportResp, s.testReportErr
+}
+
+func (s *stubPipelineService) ListPipelineArtifacts(
+	_ context.Context,
+	_, _ string,
+	pipelineID string,
+	opts models.PipelineArtifactListOptions,
+) ([]models.PipelineArtifact, error) {
+	s.gotArtifactsPipelineID = pipelineID
+	s.gotArtifactsOpts = opts
+
+	return s.artifactsResp, s.artifactsErr
+}
+
+func (s *stubPipelineService) DownloadArtifact(
+	_ context.Context,
+	_, _ string,
+	artifactID string,
+) (*models.ArtifactDownload, error) {
+	s.gotArtifactID = artifactID
+
+	return s.downloadResp, s.downloadErr
+}
+
+func (s *stubPipelineService) ListPullRequestPipelines(
+	_ context.Context,
+	_, _ string,
+	pullRequest int,
+	opts models.PipelineListOptions,
+) (*models.PipelinesResponse, error) {
+	s.gotPullRequest = pullRequest
+	s.gotPullRequestOpts = opts
+
+	return s.prPipelinesResp, s.prPipelinesErr
+}
+
+func (s *stubPipelineService) GetPipelineGraph(
+	_ context.Context,
+	_, _ string,
+	pipelineID string,
+	depth int,
+) (*models.PipelineGraphNode, error) {
+	s.gotGraphPipelineID = pipelineID
+	s.gotGraphDepth = depth
+
+	return s.graphResp, s.graphErr
+}
+
+func (s *stubPipelineService) ListPipelineSchedules(
+	_ context.Context,
+	_, _ string,
+) ([]models.PipelineSchedule, error) {
+	return s.schedulesResp, s.scheduleErr
+}
+
+func (s *stubPipelineService) GetPipelineSchedule(
+	_ context.Context,
+	_, _ string,
+	scheduleID string,
+) (*models.PipelineSchedule, error) {
+	s.gotScheduleID = scheduleID
+
+	return s.scheduleResp, s.scheduleErr
+}
+
+func (s *stubPipelineService) CreatePipelineSchedule(
+	_ context.Context,
+	_, _ string,
+	opts models.PipelineScheduleOptions,
+) (*models.PipelineSchedule, error) {
+	s.gotScheduleOpts = opts
+
+	return s.scheduleResp, s.scheduleErr
+}
+
+func (s *stubPipelineService) UpdatePipelineSchedule(
+	_ context.Context,
+	_, _ string,
+	scheduleID string,
+	opts models.PipelineScheduleOptions,
+) (*models.PipelineSchedule, error) {
+	s.gotScheduleID = scheduleID
+	s.gotScheduleOpts = opts
+
+	return s.scheduleResp, s.scheduleErr
+}
+
+func (s *stubPipelineService) DeletePipelineSchedule(
+	_ context.Context,
+	_, _ string,
+	scheduleID string,
+) error {
+	s.gotScheduleID = scheduleID
+
+	return s.scheduleErr
+}
+
+func (s *stubPipelineService) RunPipelineSchedule(
+	_ context.Context,
+	_, _ string,
+	scheduleID string,
+) error {
+	s.gotScheduleID = scheduleID
+
+	return s.scheduleErr
+}
 
-	return s.traceResp, s.traceTruncated, s.traceErr
+func (s *stubPipelineService) SearchJobTraces(
+	_ context.Context,
+	_, _ string,
+	pipelineID string,
+	opts models.JobTraceSearchOptions,
+) (*models.PipelineJobTraceSearchResponse, error) {
+	s.gotSearchPipelineID = pipelineID
+	s.gotSearchOpts = opts
+
+	return s.searchResp, s.searchErr
+}
+
+func (s *stubPipelineService) StreamJobTrace(
+	_ context.Context,
+	_, _ string,
+	jobID string,
+	offset int64,
+) (<-chan models.PipelineJobTraceEvent, error) {
+	s.gotStreamJobID = jobID
+	s.gotStreamOffset = offset
+
+	if s.streamErr != nil {
+		return nil, s.streamErr
+	}
+
+	return s.streamEvents, nil
+}
+
+func (s *stubPipelineService) CancelPipeline(
+	_ context.Context,
+	gitServerName, project string,
+	pipelineID string,
+) (*models.PipelineActionResponse, error) {
+	s.gotActionGitServer = gitServerName
+	s.gotActionProject = project
+	s.gotActionPipelineID = pipelineID
+
+	return s.actionResp, s.actionErr
+}
+
+func (s *stubPipelineService) RetryPipeline(
+	_ context.Context,
+	gitServerName, project string,
+	pipelineID string,
+	opts models.PipelineRetryOptions,
+) (*models.PipelineActionResponse, error) {
+	s.gotActionGitServer = gitServerName
+	s.gotActionProject = project
+	s.gotActionPipelineID = pipelineID
+	s.gotRetryOpts = opts
+
+	return s.actionResp, s.actionErr
+}
+
+func (s *stubPipelineService) PlayJob(
+	_ context.Context,
+	_, _ string,
+	jobID string,
+	opts models.PipelineJobPlayOptions,
+) (*models.PipelineJob, error) {
+	s.gotJobActionJobID = jobID
+	s.gotPlayOpts = opts
+
+	return s.jobActionResp, s.jobActionErr
+}
+
+func (s *stubPipelineService) RetryJob(
+	_ context.Context,
+	_, _ string,
+	jobID string,
+) (*models.PipelineJob, error) {
+	s.gotJobActionJobID = jobID
+
+	return s.jobActionResp, s.jobActionErr
+}
+
+func (s *stubPipelineService) CancelJob(
+	_ context.Context,
+	_, _ string,
+	jobID string,
+) (*models.PipelineJob, error) {
+	s.gotJobActionJobID = jobID
+
+	return s.jobActionResp, s.jobActionErr
 }
 
 // --- TriggerPipeline tests ---
@@ -148,7 +443,7 @@ func TestPipelineHandlerTriggerPipelineValidation(t *testing.T) {
 func TestPipelineHandlerTriggerPipelineSuccess(t *testing.T) {
 	stub := &stubPipelineService{
 		triggerResp: &models.PipelineResponse{
-			Id:     1,
+			Id:     "1",
 			WebUrl: "https://gitlab.com/project/-/pipelines/1",
 			Status: "running",
 			Ref:    "main",
@@ -161,6 +456,8 @@ func TestPipelineHandlerTriggerPipelineSuccess(t *testing.T) {
 			GitServer: "my-server",
 			Project:   "my-project",
 			Ref:       "main",
+			Pipeline:  pointer.To("deploy"),
+			Variables: pointer.To(`[{"key":"TOKEN","value":"s3cr3t","secured":true}]`),
 		},
 	})
 
@@ -168,7 +465,32 @@ func TestPipelineHandlerTriggerPipelineSuccess(t *testing.T) {
 	assert.IsType(t, TriggerPipeline201JSONResponse{}, resp)
 	assert.Equal(t, "my-server", stub.gotTriggerGitServer)
 	assert.Equal(t, "my-project", stub.gotTriggerProject)
-	assert.Equal(t, "main", stub.gotTriggerRef)
+	assert.Equal(t, "main", stub.gotTriggerOpts.Ref)
+	assert.Equal(t, pointer.To("deploy"), stub.gotTriggerOpts.Pipeline)
+	require.Len(t, stub.gotTriggerOpts.Variables, 1)
+	assert.Equal(t, "TOKEN", stub.gotTriggerOpts.Variables[0].Key)
+	assert.Equal(t, pointer.To(true), stub.gotTriggerOpts.Variables[0].Secured)
+}
+
+func TestPipelineHandlerTriggerPipelineUnidentifiedRun(t *testing.T) {
+	stub := &stubPipelineService{
+		triggerResp: &models.PipelineResponse{
+			WebUrl: "https://github.com/owner/repo/actions/workflows/ci.yaml",
+			Status: string(models.PipelineStatusPending),
+			Ref:    "main",
+		},
+	}
+	handler := NewPipelineHandler(stub)
+
+	resp, err := handler.TriggerPipeline(context.Background(), TriggerPipelineRequestObject{
+		Params: models.TriggerPipelineParams{GitServer: "my-server", Project: "owner/repo", Ref: "main"},
+	})
+
+	require.NoError(t, err)
+	got, ok := resp.(TriggerPipeline202JSONResponse)
+	require.True(t, ok, "expected TriggerPipeline202JSONResponse, got %T", resp)
+	assert.Empty(t, got.Id)
+	assert.Equal(t, "pending", got.Status)
 }
 
 func TestPipelineHandlerTriggerErrResponse(t *testing.T) {
@@ -329,6 +651,59 @@ func TestPipelineHandlerListPipelinesWithFilters(t *testing.T) {
 	assert.Equal(t, "success", *stub.gotListOpts.Status)
 }
 
+func TestPipelineHandlerListPipelinesWithCommitUserAndDateFilters(t *testing.T) {
+	stub := &stubPipelineService{
+		listResp: &models.PipelinesResponse{},
+	}
+	handler := NewPipelineHandler(stub)
+
+	source := models.ListPipelinesSourceSchedule
+	sort := models.ListPipelinesSortAsc
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	resp, err := handler.ListPipelines(context.Background(), ListPipelinesRequestObject{
+		Params: models.ListPipelinesParams{
+			GitServer:    "my-server",
+			Project:      "my-project",
+			Sha:          pointer.To("abc123"),
+			Source:       &source,
+			TriggeredBy:  pointer.To(""),
+			UpdatedAfter: &after,
+			Sort:         &sort,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.IsType(t, ListPipelines200JSONResponse{}, resp)
+	assert.Equal(t, "abc123", *stub.gotListOpts.Sha)
+	assert.Equal(t, "schedule", *stub.gotListOpts.Source)
+	assert.Nil(t, stub.gotListOpts.TriggeredBy, "an empty user filter is ignored")
+	assert.Equal(t, after, *stub.gotListOpts.UpdatedAfter)
+	assert.Nil(t, stub.gotListOpts.UpdatedBefore)
+	assert.True(t, stub.gotListOpts.Ascending)
+}
+
+func TestPipelineHandlerListPipelinesRejectsInvertedDateRange(t *testing.T) {
+	stub := &stubPipelineService{}
+	handler := NewPipelineHandler(stub)
+
+	after := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	resp, err := handler.ListPipelines(context.Background(), ListPipelinesRequestObject{
+		Params: models.ListPipelinesParams{
+			GitServer:     "my-server",
+			Project:       "my-project",
+			UpdatedAfter:  &after,
+			UpdatedBefore: &before,
+		},
+	})
+
+	require.NoError(t, err)
+	assert.IsType(t, ListPipelines400JSONResponse{}, resp)
+	assert.Empty(t, stub.gotListProject, "the service should not be called")
+}
+
 func TestPipelineHandlerListErrResponse(t *testing.T) {
 	handler := &PipelineHandler{}
 
@@ -472,14 +847,501 @@ func TestPipelineHandlerImplementsExpectedSignature(t *testing.T) {
 	assert.NotNil(t, listFn)
 }
 
+// --- GetPipeline tests ---
+
+func TestPipelineHandlerGetPipeline(t *testing.T) {
+	t.Run("empty pipelineId returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).GetPipeline(context.Background(),
+			GetPipelineRequestObject{Params: models.GetPipelineParams{GitServer: "gl", Project: "p"}})
+		require.NoError(t, err)
+		assert.IsType(t, GetPipeline400JSONResponse{}, resp)
+	})
+
+	t.Run("success returns the pipeline", func(t *testing.T) {
+		stub := &stubPipelineService{pipelineResp: &models.Pipeline{
+			Id:     "5",
+			Status: models.PipelineStatusRunning,
+			Stages: &[]models.PipelineStage{{Name: "build", Status: "success", Jobs: 1}},
+		}}
+
+		resp, err := NewPipelineHandler(stub).GetPipeline(context.Background(), GetPipelineRequestObject{
+			Params: models.GetPipelineParams{GitServer: "gl", Project: "krci/app", PipelineId: "5"},
+		})
+
+		require.NoError(t, err)
+
+		pipelineResp, ok := resp.(GetPipeline200JSONResponse)
+		require.True(t, ok, "expected GetPipeline200JSONResponse")
+		assert.Equal(t, "5", stub.gotGetPipelineID)
+		assert.Equal(t, "5", pipelineResp.Id)
+		require.NotNil(t, pipelineResp.Stages)
+		assert.Len(t, *pipelineResp.Stages, 1)
+	})
+
+	t.Run("errors map to status codes", func(t *testing.T) {
+		handler := &PipelineHandler{}
+
+		assert.IsType(t, GetPipeline404JSONResponse{},
+			handler.getPipelineErrResponse(fmt.Errorf("missing: %w", gferrors.ErrNotFound)))
+		assert.IsType(t, GetPipeline400JSONResponse{},
+			handler.getPipelineErrResponse(fmt.Errorf("unsupported: %w", gferrors.ErrBadRequest)))
+		assert.IsType(t, GetPipeline401JSONResponse{},
+			handler.getPipelineErrResponse(fmt.Errorf("bad token: %w", gferrors.ErrUnauthorized)))
+		assert.IsType(t, GetPipeline500JSONResponse{}, handler.getPipelineErrResponse(errors.New("boom")))
+	})
+}
+
+// --- GetPipelineTestReport tests ---
+
+func TestPipelineHandlerGetPipelineTestReport(t *testing.T) {
+	t.Run("empty pipelineId returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).GetPipelineTestReport(context.Background(),
+			GetPipelineTestReportRequestObject{Params: models.GetPipelineTestReportParams{GitServer: "gl", Project: "p"}})
+		require.NoError(t, err)
+		assert.IsType(t, GetPipelineTestReport400JSONResponse{}, resp)
+	})
+
+	t.Run("returns the report", func(t *testing.T) {
+		stub := &stubPipelineService{testReportResp: &models.PipelineTestReport{
+			PipelineId:     "5",
+			PipelineStatus: string(models.PipelineStatusFailed),
+			Totals:         models.PipelineTestTotals{Count: 2, Success: 1, Failed: 1},
+			Suites:         []models.PipelineTestSuite{},
+		}}
+
+		resp, err := NewPipelineHandler(stub).GetPipelineTestReport(context.Background(),
+			GetPipelineTestReportRequestObject{Params: models.GetPipelineTestReportParams{
+				GitServer: "gl", Project: "krci/app", PipelineId: "5",
+			}})
+		require.NoError(t, err)
+
+		report, ok := resp.(GetPipelineTestReport200JSONResponse)
+		require.True(t, ok, "expected GetPipelineTestReport200JSONResponse")
+		assert.Equal(t, "5", stub.gotTestReportPipelineID)
+		assert.Equal(t, 1, report.Totals.Failed)
+	})
+
+	t.Run("unsupported provider returns 400", func(t *testing.T) {
+		stub := &stubPipelineService{testReportErr: fmt.Errorf("no test reports: %w", gferrors.ErrBadRequest)}
+
+		resp, err := NewPipelineHandler(stub).GetPipelineTestReport(context.Background(),
+			GetPipelineTestReportRequestObject{Params: models.GetPipelineTestReportParams{
+				GitServer: "bb", Project: "krci/app", PipelineId: "5",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, GetPipelineTestReport400JSONResponse{}, resp)
+	})
+}
+
+// --- Pipeline artifacts tests ---
+
+func TestPipelineHandlerListPipelineArtifacts(t *testing.T) {
+	t.Run("empty pipelineId returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).ListPipelineArtifacts(context.Background(),
+			ListPipelineArtifactsRequestObject{Params: models.ListPipelineArtifactsParams{GitServer: "gl", Project: "p"}})
+		require.NoError(t, err)
+		assert.IsType(t, ListPipelineArtifacts400JSONResponse{}, resp)
+	})
+
+	t.Run("returns the artifacts", func(t *testing.T) {
+		stub := &stubPipelineService{artifactsResp: []models.PipelineArtifact{{Id: "16", Name: "artifacts.zip"}}}
+
+		resp, err := NewPipelineHandler(stub).ListPipelineArtifacts(context.Background(),
+			ListPipelineArtifactsRequestObject{Params: models.ListPipelineArtifactsParams{
+				GitServer: "gl", Project: "krci/app", PipelineId: "5", JobId: pointer.To("16"),
+			}})
+		require.NoError(t, err)
+
+		artifacts, ok := resp.(ListPipelineArtifacts200JSONResponse)
+		require.True(t, ok, "expected ListPipelineArtifacts200JSONResponse")
+		assert.Equal(t, "5", stub.gotArtifactsPipelineID)
+		assert.Equal(t, "16", pointer.ValueOrEmpty(stub.gotArtifactsOpts.JobID))
+		require.Len(t, artifacts.Data, 1)
+		assert.Equal(t, "16", artifacts.Data[0].Id)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		stub := &stubPipelineService{artifactsErr: fmt.Errorf("missing: %w", gferrors.ErrNotFound)}
+
+		resp, err := NewPipelineHandler(stub).ListPipelineArtifacts(context.Background(),
+			ListPipelineArtifactsRequestObject{Params: models.ListPipelineArtifactsParams{
+				GitServer: "gl", Project: "krci/app", PipelineId: "5",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, ListPipelineArtifacts404JSONResponse{}, resp)
+	})
+}
+
+func TestPipelineHandlerDownloadPipelineArtifact(t *testing.T) {
+	t.Run("empty artifactId returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).DownloadPipelineArtifact(context.Background(),
+			DownloadPipelineArtifactRequestObject{Params: models.DownloadPipelineArtifactParams{GitServer: "gl", Project: "p"}})
+		require.NoError(t, err)
+		assert.IsType(t, DownloadPipelineArtifact400JSONResponse{}, resp)
+	})
+
+	t.Run("streams the artifact as an attachment", func(t *testing.T) {
+		stub := &stubPipelineService{downloadResp: &models.ArtifactDownload{
+			Body: io.NopCloser(strings.NewReader("zip-bytes")),
+			Name: "test results.zip",
+			Size: 9,
+		}}
+
+		resp, err := NewPipelineHandler(stub).DownloadPipelineArtifact(context.Background(),
+			DownloadPipelineArtifactRequestObject{Params: models.DownloadPipelineArtifactParams{
+				GitServer: "gh", Project: "krci/app", ArtifactId: "42",
+			}})
+		require.NoError(t, err)
+		assert.Equal(t, "42", stub.gotArtifactID)
+
+		rec := httptest.NewRecorder()
+		require.NoError(t, resp.VisitDownloadPipelineArtifactResponse(rec))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/octet-stream", rec.Header().Get("Content-Type"))
+		assert.Equal(t, `attachment; filename="test results.zip"`, rec.Header().Get("Content-Disposition"))
+		assert.Equal(t, "9", rec.Header().Get("Content-Length"))
+		assert.Equal(t, "zip-bytes", rec.Body.String())
+	})
+
+	t.Run("encodes a non-ASCII file name", func(t *testing.T) {
+		stub := &stubPipelineService{downloadResp: &models.ArtifactDownload{
+			Body:        io.NopCloser(strings.NewReader("x")),
+			Name:        "отчёт.zip",
+			Size:        -1,
+			ContentType: "application/zip",
+		}}
+
+		resp, err := NewPipelineHandler(stub).DownloadPipelineArtifact(context.Background(),
+			DownloadPipelineArtifactRequestObject{Params: models.DownloadPipelineArtifactParams{
+				GitServer: "gh", Project: "krci/app", ArtifactId: "42",
+			}})
+		require.NoError(t, err)
+
+		rec := httptest.NewRecorder()
+		require.NoError(t, resp.VisitDownloadPipelineArtifactResponse(rec))
+
+		assert.Equal(t, "application/zip", rec.Header().Get("Content-Type"))
+		assert.Equal(t, "attachment; filename*=utf-8''%D0%BE%D1%82%D1%87%D1%91%D1%82.zip",
+			rec.Header().Get("Content-Disposition"))
+		assert.Empty(t, rec.Header().Get("Content-Length"))
+	})
+
+	t.Run("a failed copy aborts the response", func(t *testing.T) {
+		stub := &stubPipelineService{downloadResp: &models.ArtifactDownload{
+			Body: io.NopCloser(io.MultiReader(strings.NewReader("part"), iotest.ErrReader(errors.New("boom")))),
+			Name: "a.zip",
+			Size: -1,
+		}}
+
+		resp, err := NewPipelineHandler(stub).DownloadPipelineArtifact(context.Background(),
+			DownloadPipelineArtifactRequestObject{Params: models.DownloadPipelineArtifactParams{
+				GitServer: "gh", Project: "krci/app", ArtifactId: "42",
+			}})
+		require.NoError(t, err)
+
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			_ = resp.VisitDownloadPipelineArtifactResponse(httptest.NewRecorder())
+		})
+	})
+
+	t.Run("oversized artifact returns 400", func(t *testing.T) {
+		stub := &stubPipelineService{downloadErr: fmt.Errorf("too large: %w", gferrors.ErrBadRequest)}
+
+		resp, err := NewPipelineHandler(stub).DownloadPipelineArtifact(context.Background(),
+			DownloadPipelineArtifactRequestObject{Params: models.DownloadPipelineArtifactParams{
+				GitServer: "gh", Project: "krci/app", ArtifactId: "42",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, DownloadPipelineArtifact400JSONResponse{}, resp)
+	})
+}
+
+// --- ListPullRequestPipelines tests ---
+
+func TestPipelineHandlerListPullRequestPipelines(t *testing.T) {
+	t.Run("non-positive pullRequest returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).ListPullRequestPipelines(context.Background(),
+			ListPullRequestPipelinesRequestObject{Params: models.ListPullRequestPipelinesParams{
+				GitServer: "gh", Project: "p",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, ListPullRequestPipelines400JSONResponse{}, resp)
+	})
+
+	t.Run("passes the pull request and clamped pagination", func(t *testing.T) {
+		stub := &stubPipelineService{prPipelinesResp: &models.PipelinesResponse{
+			Data: []models.Pipeline{{Id: "100"}},
+		}}
+
+		resp, err := NewPipelineHandler(stub).ListPullRequestPipelines(context.Background(),
+			ListPullRequestPipelinesRequestObject{Params: models.ListPullRequestPipelinesParams{
+				GitServer: "gh", Project: "p", PullRequest: 42, PerPage: pointer.To(500),
+			}})
+		require.NoError(t, err)
+
+		list, ok := resp.(ListPullRequestPipelines200JSONResponse)
+		require.True(t, ok, "expected ListPullRequestPipelines200JSONResponse")
+		assert.Len(t, list.Data, 1)
+		assert.Equal(t, 42, stub.gotPullRequest)
+		assert.Equal(t, 1, stub.gotPullRequestOpts.Page)
+		assert.Equal(t, 100, stub.gotPullRequestOpts.PerPage)
+	})
+
+	t.Run("missing pull request returns 404", func(t *testing.T) {
+		stub := &stubPipelineService{prPipelinesErr: fmt.Errorf("pull request 42: %w", gferrors.ErrNotFound)}
+
+		resp, err := NewPipelineHandler(stub).ListPullRequestPipelines(context.Background(),
+			ListPullRequestPipelinesRequestObject{Params: models.ListPullRequestPipelinesParams{
+				GitServer: "gh", Project: "p", PullRequest: 42,
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, ListPullRequestPipelines404JSONResponse{}, resp)
+	})
+}
+
+// --- GetPipelineGraph tests ---
+
+func TestPipelineHandlerGetPipelineGraph(t *testing.T) {
+	t.Run("empty pipelineId returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).GetPipelineGraph(context.Background(),
+			GetPipelineGraphRequestObject{Params: models.GetPipelineGraphParams{GitServer: "gl", Project: "p"}})
+		require.NoError(t, err)
+		assert.IsType(t, GetPipelineGraph400JSONResponse{}, resp)
+	})
+
+	t.Run("depth out of range returns 400", func(t *testing.T) {
+		for _, depth := range []int{-1, maxPipelineGraphDepth + 1} {
+			resp, err := NewPipelineHandler(&stubPipelineService{}).GetPipelineGraph(context.Background(),
+				GetPipelineGraphRequestObject{Params: models.GetPipelineGraphParams{
+					GitServer: "gl", Project: "p", PipelineId: "5", Depth: pointer.To(depth),
+				}})
+			require.NoError(t, err)
+			assert.IsType(t, GetPipelineGraph400JSONResponse{}, resp, depth)
+		}
+	})
+
+	t.Run("defaults the depth", func(t *testing.T) {
+		stub := &stubPipelineService{graphResp: &models.PipelineGraphNode{Kind: models.Root, Status: "success"}}
+
+		resp, err := NewPipelineHandler(stub).GetPipelineGraph(context.Background(),
+			GetPipelineGraphRequestObject{Params: models.GetPipelineGraphParams{
+				GitServer: "gl", Project: "p", PipelineId: "5",
+			}})
+		require.NoError(t, err)
+
+		graph, ok := resp.(GetPipelineGraph200JSONResponse)
+		require.True(t, ok, "expected GetPipelineGraph200JSONResponse")
+		assert.Equal(t, models.Root, graph.Kind)
+		assert.Equal(t, "5", stub.gotGraphPipelineID)
+		assert.Equal(t, defaultPipelineGraphDepth, stub.gotGraphDepth)
+	})
+
+	t.Run("passes depth 0", func(t *testing.T) {
+		stub := &stubPipelineService{graphResp: &models.PipelineGraphNode{Kind: models.Root}}
+
+		_, err := NewPipelineHandler(stub).GetPipelineGraph(context.Background(),
+			GetPipelineGraphRequestObject{Params: models.GetPipelineGraphParams{
+				GitServer: "gl", Project: "p", PipelineId: "5", Depth: pointer.To(0),
+			}})
+		require.NoError(t, err)
+		assert.Equal(t, 0, stub.gotGraphDepth)
+	})
+
+	t.Run("unsupported provider returns 400", func(t *testing.T) {
+		stub := &stubPipelineService{graphErr: fmt.Errorf("no graphs: %w", gferrors.ErrBadRequest)}
+
+		resp, err := NewPipelineHandler(stub).GetPipelineGraph(context.Background(),
+			GetPipelineGraphRequestObject{Params: models.GetPipelineGraphParams{
+				GitServer: "bb", Project: "p", PipelineId: "5",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, GetPipelineGraph400JSONResponse{}, resp)
+	})
+}
+
+// --- Pipeline schedule tests ---
+
+func TestPipelineHandlerListPipelineSchedules(t *testing.T) {
+	t.Run("returns the schedules", func(t *testing.T) {
+		stub := &stubPipelineService{schedulesResp: []models.PipelineSchedule{{Id: "7", Cron: "0 1 * * *"}}}
+
+		resp, err := NewPipelineHandler(stub).ListPipelineSchedules(context.Background(),
+			ListPipelineSchedulesRequestObject{Params: models.ListPipelineSchedulesParams{
+				GitServer: "gl", Project: "krci/app",
+			}})
+		require.NoError(t, err)
+
+		schedules, ok := resp.(ListPipelineSchedules200JSONResponse)
+		require.True(t, ok, "expected ListPipelineSchedules200JSONResponse")
+		require.Len(t, schedules.Data, 1)
+		assert.Equal(t, "7", schedules.Data[0].Id)
+	})
+
+	t.Run("unsupported provider returns 400", func(t *testing.T) {
+		stub := &stubPipelineService{scheduleErr: fmt.Errorf("no schedules: %w", gferrors.ErrBadRequest)}
+
+		resp, err := NewPipelineHandler(stub).ListPipelineSchedules(context.Background(),
+			ListPipelineSchedulesRequestObject{Params: models.ListPipelineSchedulesParams{
+				GitServer: "bb", Project: "krci/app",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, ListPipelineSchedules400JSONResponse{}, resp)
+	})
+}
+
+func TestPipelineHandlerGetPipelineSchedule(t *testing.T) {
+	t.Run("empty scheduleId returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).GetPipelineSchedule(context.Background(),
+			GetPipelineScheduleRequestObject{Params: models.GetPipelineScheduleParams{GitServer: "gl", Project: "p"}})
+		require.NoError(t, err)
+		assert.IsType(t, GetPipelineSchedule400JSONResponse{}, resp)
+	})
+
+	t.Run("not found returns 404", func(t *testing.T) {
+		stub := &stubPipelineService{scheduleErr: fmt.Errorf("missing: %w", gferrors.ErrNotFound)}
+
+		resp, err := NewPipelineHandler(stub).GetPipelineSchedule(context.Background(),
+			GetPipelineScheduleRequestObject{Params: models.GetPipelineScheduleParams{
+				GitServer: "gl", Project: "krci/app", ScheduleId: "7",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, GetPipelineSchedule404JSONResponse{}, resp)
+		assert.Equal(t, "7", stub.gotScheduleID)
+	})
+}
+
+func TestPipelineHandlerCreatePipelineSchedule(t *testing.T) {
+	t.Run("missing cron returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).CreatePipelineSchedule(context.Background(),
+			CreatePipelineScheduleRequestObject{Params: models.CreatePipelineScheduleParams{
+				GitServer: "gl", Project: "p", Description: "nightly", Ref: "main",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, CreatePipelineSchedule400JSONResponse{}, resp)
+	})
+
+	t.Run("invalid variables return 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).CreatePipelineSchedule(context.Background(),
+			CreatePipelineScheduleRequestObject{Params: models.CreatePipelineScheduleParams{
+				GitServer: "gl", Project: "p", Description: "nightly", Ref: "main", Cron: "0 1 * * *",
+				Variables: pointer.To("{"),
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, CreatePipelineSchedule400JSONResponse{}, resp)
+	})
+
+	t.Run("passes the options and returns 201", func(t *testing.T) {
+		stub := &stubPipelineService{scheduleResp: &models.PipelineSchedule{Id: "7"}}
+
+		resp, err := NewPipelineHandler(stub).CreatePipelineSchedule(context.Background(),
+			CreatePipelineScheduleRequestObject{Params: models.CreatePipelineScheduleParams{
+				GitServer: "gl", Project: "p", Description: "nightly", Ref: "main", Cron: "0 1 * * *",
+				Active: pointer.To(false), Variables: pointer.To(`[{"key":"ENV","value":"dev"}]`),
+			}})
+		require.NoError(t, err)
+
+		created, ok := resp.(CreatePipelineSchedule201JSONResponse)
+		require.True(t, ok, "expected CreatePipelineSchedule201JSONResponse")
+		assert.Equal(t, "7", created.Id)
+		assert.Equal(t, "nightly", *stub.gotScheduleOpts.Description)
+		assert.Equal(t, "0 1 * * *", *stub.gotScheduleOpts.Cron)
+		assert.False(t, *stub.gotScheduleOpts.Active)
+		require.Len(t, stub.gotScheduleOpts.Variables, 1)
+		assert.Equal(t, "ENV", stub.gotScheduleOpts.Variables[0].Key)
+	})
+}
+
+func TestPipelineHandlerUpdatePipelineSchedule(t *testing.T) {
+	t.Run("empty scheduleId returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).UpdatePipelineSchedule(context.Background(),
+			UpdatePipelineScheduleRequestObject{Params: models.UpdatePipelineScheduleParams{GitServer: "gl", Project: "p"}})
+		require.NoError(t, err)
+		assert.IsType(t, UpdatePipelineSchedule400JSONResponse{}, resp)
+	})
+
+	t.Run("absent variables leave them untouched", func(t *testing.T) {
+		stub := &stubPipelineService{scheduleResp: &models.PipelineSchedule{Id: "7"}}
+
+		resp, err := NewPipelineHandler(stub).UpdatePipelineSchedule(context.Background(),
+			UpdatePipelineScheduleRequestObject{Params: models.UpdatePipelineScheduleParams{
+				GitServer: "gl", Project: "p", ScheduleId: "7", Cron: pointer.To("0 2 * * *"),
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, UpdatePipelineSchedule200JSONResponse{}, resp)
+		assert.Equal(t, "7", stub.gotScheduleID)
+		assert.Equal(t, "0 2 * * *", *stub.gotScheduleOpts.Cron)
+		assert.Nil(t, stub.gotScheduleOpts.Description)
+		assert.Nil(t, stub.gotScheduleOpts.Variables)
+	})
+
+	t.Run("empty variables list removes them all", func(t *testing.T) {
+		stub := &stubPipelineService{scheduleResp: &models.PipelineSchedule{Id: "7"}}
+
+		_, err := NewPipelineHandler(stub).UpdatePipelineSchedule(context.Background(),
+			UpdatePipelineScheduleRequestObject{Params: models.UpdatePipelineScheduleParams{
+				GitServer: "gl", Project: "p", ScheduleId: "7", Variables: pointer.To("[]"),
+			}})
+		require.NoError(t, err)
+		assert.NotNil(t, stub.gotScheduleOpts.Variables)
+		assert.Empty(t, stub.gotScheduleOpts.Variables)
+	})
+}
+
+func TestPipelineHandlerDeletePipelineSchedule(t *testing.T) {
+	t.Run("returns 204", func(t *testing.T) {
+		stub := &stubPipelineService{}
+
+		resp, err := NewPipelineHandler(stub).DeletePipelineSchedule(context.Background(),
+			DeletePipelineScheduleRequestObject{Params: models.DeletePipelineScheduleParams{
+				GitServer: "gl", Project: "p", ScheduleId: "7",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, DeletePipelineSchedule204Response{}, resp)
+		assert.Equal(t, "7", stub.gotScheduleID)
+	})
+
+	t.Run("unauthorized returns 401", func(t *testing.T) {
+		stub := &stubPipelineService{scheduleErr: fmt.Errorf("denied: %w", gferrors.ErrUnauthorized)}
+
+		resp, err := NewPipelineHandler(stub).DeletePipelineSchedule(context.Background(),
+			DeletePipelineScheduleRequestObject{Params: models.DeletePipelineScheduleParams{
+				GitServer: "gl", Project: "p", ScheduleId: "7",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, DeletePipelineSchedule401JSONResponse{}, resp)
+	})
+}
+
+func TestPipelineHandlerRunPipelineSchedule(t *testing.T) {
+	t.Run("empty scheduleId returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).RunPipelineSchedule(context.Background(),
+			RunPipelineScheduleRequestObject{Params: models.RunPipelineScheduleParams{GitServer: "gl", Project: "p"}})
+		require.NoError(t, err)
+		assert.IsType(t, RunPipelineSchedule400JSONResponse{}, resp)
+	})
+
+	t.Run("returns 202", func(t *testing.T) {
+		stub := &stubPipelineService{}
+
+		resp, err := NewPipelineHandler(stub).RunPipelineSchedule(context.Background(),
+			RunPipelineScheduleRequestObject{Params: models.RunPipelineScheduleParams{
+				GitServer: "gl", Project: "p", ScheduleId: "7",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, RunPipelineSchedule202Response{}, resp)
+		assert.Equal(t, "7", stub.gotScheduleID)
+	})
+}
+
 // --- ListPipelineJobs tests ---
 
 func TestPipelineHandlerListPipelineJobsValidation(t *testing.T) {
 	handler := NewPipelineHandler(&stubPipelineService{})
 
-	t.Run("non-numeric pipelineId returns 400", func(t *testing.T) {
+	t.Run("empty pipelineId returns 400", func(t *testing.T) {
 		resp, err := handler.ListPipelineJobs(context.Background(), ListPipelineJobsRequestObject{
-			Params: models.ListPipelineJobsParams{GitServer: "gl", Project: "p", PipelineId: "abc"},
+			Params: models.ListPipelineJobsParams{GitServer: "gl", Project: "p", PipelineId: ""},
 		})
 		require.NoError(t, err)
 		assert.IsType(t, ListPipelineJobs400JSONResponse{}, resp)
@@ -502,7 +1364,7 @@ func TestPipelineHandlerListPipelineJobsSuccess(t *testing.T) {
 	require.True(t, ok, "expected ListPipelineJobs200JSONResponse")
 	assert.Equal(t, "gl", stub.gotJobsGitServer)
 	assert.Equal(t, "krci/app", stub.gotJobsProject)
-	assert.Equal(t, 5, stub.gotJobsPipelineID)
+	assert.Equal(t, "5", stub.gotJobsPipelineID)
 	require.Len(t, jobsResp.Data, 1)
 	assert.Equal(t, "build", jobsResp.Data[0].Name)
 }
@@ -526,9 +1388,9 @@ func TestPipelineHandlerJobsErrResponse(t *testing.T) {
 func TestPipelineHandlerGetPipelineJobTraceValidation(t *testing.T) {
 	handler := NewPipelineHandler(&stubPipelineService{})
 
-	t.Run("non-numeric jobId returns 400", func(t *testing.T) {
+	t.Run("empty jobId returns 400", func(t *testing.T) {
 		resp, err := handler.GetPipelineJobTrace(context.Background(), GetPipelineJobTraceRequestObject{
-			Params: models.GetPipelineJobTraceParams{GitServer: "gl", Project: "p", JobId: "nope"},
+			Params: models.GetPipelineJobTraceParams{GitServer: "gl", Project: "p", JobId: ""},
 		})
 		require.NoError(t, err)
 		assert.IsType(t, GetPipelineJobTrace400JSONResponse{}, resp)
@@ -536,7 +1398,7 @@ func TestPipelineHandlerGetPipelineJobTraceValidation(t *testing.T) {
 }
 
 func TestPipelineHandlerGetPipelineJobTraceSuccess(t *testing.T) {
-	stub := &stubPipelineService{traceResp: "hello log"}
+	stub := &stubPipelineService{traceResp: &models.JobTrace{Content: "hello log", TotalSize: pointer.To(int64(9))}}
 	handler := NewPipelineHandler(stub)
 
 	resp, err := handler.GetPipelineJobTrace(context.Background(), GetPipelineJobTraceRequestObject{
@@ -547,9 +1409,83 @@ func TestPipelineHandlerGetPipelineJobTraceSuccess(t *testing.T) {
 
 	traceResp, ok := resp.(GetPipelineJobTrace200JSONResponse)
 	require.True(t, ok, "expected GetPipelineJobTrace200JSONResponse")
-	assert.Equal(t, 23, stub.gotTraceJobID)
+	assert.Equal(t, "23", stub.gotTraceJobID)
+	assert.Equal(t, models.JobTraceWindow{}, stub.gotTraceWindow)
 	assert.Equal(t, "23", traceResp.JobId)
 	assert.Equal(t, "hello log", traceResp.Content)
+	assert.Equal(t, pointer.To(int64(0)), traceResp.Offset)
+	assert.Equal(t, pointer.To(int64(9)), traceResp.TotalSize)
+}
+
+func TestPipelineHandlerGetPipelineJobTraceWindow(t *testing.T) {
+	params := func(offset, limit *int64, tail *int) models.GetPipelineJobTraceParams {
+		return models.GetPipelineJobTraceParams{
+			GitServer: "gl", Project: "krci/app", JobId: "23", Offset: offset, Limit: limit, Tail: tail,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		params     models.GetPipelineJobTraceParams
+		wantWindow models.JobTraceWindow
+		wantErr    bool
+	}{
+		{
+			name:       "offset and limit",
+			params:     params(pointer.To(int64(100)), pointer.To(int64(50)), nil),
+			wantWindow: models.JobTraceWindow{Offset: 100, Limit: 50},
+		},
+		{name: "tail", params: params(nil, nil, pointer.To(20)), wantWindow: models.JobTraceWindow{TailLines: 20}},
+		{name: "tail with offset", params: params(pointer.To(int64(1)), nil, pointer.To(20)), wantErr: true},
+		{name: "negative offset", params: params(pointer.To(int64(-1)), nil, nil), wantErr: true},
+		{name: "zero limit", params: params(nil, pointer.To(int64(0)), nil), wantErr: true},
+		{name: "zero tail", params: params(nil, nil, pointer.To(0)), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubPipelineService{traceResp: &models.JobTrace{}}
+
+			resp, err := NewPipelineHandler(stub).GetPipelineJobTrace(context.Background(),
+				GetPipelineJobTraceRequestObject{Params: tt.params})
+			require.NoError(t, err)
+
+			if tt.wantErr {
+				assert.IsType(t, GetPipelineJobTrace400JSONResponse{}, resp)
+
+				return
+			}
+
+			assert.IsType(t, GetPipelineJobTrace200JSONResponse{}, resp)
+			assert.Equal(t, tt.wantWindow, stub.gotTraceWindow)
+		})
+	}
+}
+
+func TestPipelineHandlerGetPipelineJobTraceStructured(t *testing.T) {
+	structure := &models.PipelineJobTraceStructure{
+		Lines:    []models.PipelineJobTraceLine{{Number: 1, Text: "hello"}},
+		Sections: []models.PipelineJobTraceSection{},
+	}
+	stub := &stubPipelineService{traceResp: &models.JobTrace{Structure: structure}}
+
+	format := models.PipelineJobTraceFormatStructured
+	ansi := models.PipelineJobTraceAnsiStrip
+
+	resp, err := NewPipelineHandler(stub).GetPipelineJobTrace(context.Background(), GetPipelineJobTraceRequestObject{
+		Params: models.GetPipelineJobTraceParams{
+			GitServer: "gl", Project: "krci/app", JobId: "23", Format: &format, Ansi: &ansi, Tail: pointer.To(10),
+		},
+	})
+	require.NoError(t, err)
+
+	traceResp, ok := resp.(GetPipelineJobTrace200JSONResponse)
+	require.True(t, ok, "expected GetPipelineJobTrace200JSONResponse")
+	assert.True(t, stub.gotStructured)
+	assert.True(t, stub.gotTraceStripANSI)
+	assert.Equal(t, models.JobTraceWindow{TailLines: 10}, stub.gotTraceWindow)
+	assert.Empty(t, traceResp.Content)
+	assert.Equal(t, structure, traceResp.Structured)
 }
 
 func TestPipelineHandlerTraceErrResponse(t *testing.T) {
@@ -558,3 +1494,319 @@ func TestPipelineHandlerTraceErrResponse(t *testing.T) {
 	resp := handler.traceErrResponse(fmt.Errorf("boom: %w", gferrors.ErrNotFound))
 	assert.IsType(t, GetPipelineJobTrace404JSONResponse{}, resp)
 }
+
+// --- StreamPipelineJobTrace tests ---
+
+func TestPipelineHandlerStreamPipelineJobTrace(t *testing.T) {
+	t.Run("empty jobId returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).StreamPipelineJobTrace(context.Background(),
+			StreamPipelineJobTraceRequestObject{Params: models.StreamPipelineJobTraceParams{GitServer: "gl", Project: "p"}})
+		require.NoError(t, err)
+		assert.IsType(t, StreamPipelineJobTrace400JSONResponse{}, resp)
+	})
+
+	t.Run("invalid Last-Event-ID returns 400", func(t *testing.T) {
+		resp, err := NewPipelineHandler(&stubPipelineService{}).StreamPipelineJobTrace(context.Background(),
+			StreamPipelineJobTraceRequestObject{Params: models.StreamPipelineJobTraceParams{
+				GitServer: "gl", Project: "p", JobId: "7", LastEventID: pointer.To("abc"),
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, StreamPipelineJobTrace400JSONResponse{}, resp)
+	})
+
+	t.Run("Last-Event-ID takes precedence over offset", func(t *testing.T) {
+		stub := &stubPipelineService{streamEvents: make(chan models.PipelineJobTraceEvent)}
+
+		resp, err := NewPipelineHandler(stub).StreamPipelineJobTrace(context.Background(),
+			StreamPipelineJobTraceRequestObject{Params: models.StreamPipelineJobTraceParams{
+				GitServer: "gl", Project: "p", JobId: "7", Offset: pointer.To(int64(10)), LastEventID: pointer.To("42"),
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, traceEventStream{}, resp)
+		assert.Equal(t, "7", stub.gotStreamJobID)
+		assert.Equal(t, int64(42), stub.gotStreamOffset)
+	})
+
+	t.Run("missing job returns 404 before streaming", func(t *testing.T) {
+		stub := &stubPipelineService{streamErr: fmt.Errorf("job: %w", gferrors.ErrNotFound)}
+
+		resp, err := NewPipelineHandler(stub).StreamPipelineJobTrace(context.Background(),
+			StreamPipelineJobTraceRequestObject{Params: models.StreamPipelineJobTraceParams{
+				GitServer: "gl", Project: "p", JobId: "7",
+			}})
+		require.NoError(t, err)
+		assert.IsType(t, StreamPipelineJobTrace404JSONResponse{}, resp)
+	})
+}
+
+func TestTraceEventStreamVisitResponse(t *testing.T) {
+	events := make(chan models.PipelineJobTraceEvent, 3)
+	events <- models.PipelineJobTraceEvent{Chunk: &models.PipelineJobTraceChunk{Offset: 5, Content: "hello\n"}}
+	events <- models.PipelineJobTraceEvent{End: &models.PipelineJobTraceEnd{Status: "success", Offset: 11}}
+	events <- models.PipelineJobTraceEvent{Err: fmt.Errorf("job: %w", gferrors.ErrUnauthorized)}
+	close(events)
+
+	w := httptest.NewRecorder()
+	err := traceEventStream{events: events, keepAlive: time.Minute}.VisitStreamPipelineJobTraceResponse(w)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/event-stream", w.Header().Get("Content-Type"))
+	assert.Equal(t, "no-cache", w.Header().Get("Cache-Control"))
+	assert.True(t, w.Flushed)
+	assert.Equal(t,
+		"id: 11\nevent: trace\ndata: {\"content\":\"hello\\n\",\"offset\":5}\n\n"+
+			"id: 11\nevent: end\ndata: {\"offset\":11,\"status\":\"success\"}\n\n"+
+			"event: error\ndata: {\"code\":\"401\",\"message\":\"job: unauthorized\"}\n\n",
+		w.Body.String())
+}
+
+func TestPipelineHandlerTraceStreamErrResponse(t *testing.T) {
+	handler := &PipelineHandler{}
+
+	assert.IsType(t, StreamPipelineJobTrace401JSONResponse{}, handler.traceStreamErrResponse(gferrors.ErrUnauthorized))
+	assert.IsType(t, StreamPipelineJobTrace400JSONResponse{}, handler.traceStreamErrResponse(gferrors.ErrBadRequest))
+	assert.IsType(t, StreamPipelineJobTrace500JSONResponse{}, handler.traceStreamErrResponse(errors.New("boom")))
+}
+
+// --- SearchPipelineJobTraces tests ---
+
+func TestPipelineHandlerSearchPipelineJobTracesValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		params models.SearchPipelineJobTracesParams
+	}{
+		{name: "empty pipelineId", params: models.SearchPipelineJobTracesParams{Query: "error"}},
+		{name: "empty query", params: models.SearchPipelineJobTracesParams{PipelineId: "5"}},
+		{
+			name:   "query too long",
+			params: models.SearchPipelineJobTracesParams{PipelineId: "5", Query: strings.Repeat("a", 1001)},
+		},
+		{
+			name:   "context out of range",
+			params: models.SearchPipelineJobTracesParams{PipelineId: "5", Query: "error", Context: pointer.To(11)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := NewPipelineHandler(&stubPipelineService{}).SearchPipelineJobTraces(context.Background(),
+				SearchPipelineJobTracesRequestObject{Params: tt.params})
+			require.NoError(t, err)
+			assert.IsType(t, SearchPipelineJobTraces400JSONResponse{}, resp)
+		})
+	}
+}
+
+func TestPipelineHandlerSearchPipelineJobTraces(t *testing.T) {
+	stub := &stubPipelineService{searchResp: &models.PipelineJobTraceSearchResponse{
+		Matches: []models.PipelineJobTraceSearchMatch{
+			{JobId: "16", JobName: "build", LineNumber: 3, Line: "error: boom", Before: []string{}, After: []string{}},
+		},
+		JobsSearched: 2,
+	}}
+
+	resp, err := NewPipelineHandler(stub).SearchPipelineJobTraces(context.Background(),
+		SearchPipelineJobTracesRequestObject{Params: models.SearchPipelineJobTracesParams{
+			GitServer: "gl", Project: "krci/app", PipelineId: "5", Query: "error", IgnoreCase: pointer.To(true),
+		}})
+	require.NoError(t, err)
+
+	searchResp, ok := resp.(SearchPipelineJobTraces200JSONResponse)
+	require.True(t, ok, "expected SearchPipelineJobTraces200JSONResponse")
+	assert.Equal(t, "5", stub.gotSearchPipelineID)
+	assert.Equal(t, models.JobTraceSearchOptions{Query: "error", IgnoreCase: true, Context: 2}, stub.gotSearchOpts)
+	assert.Equal(t, 2, searchResp.JobsSearched)
+	require.Len(t, searchResp.Matches, 1)
+	assert.Equal(t, "build", searchResp.Matches[0].JobName)
+}
+
+func TestPipelineHandlerTraceSearchErrResponse(t *testing.T) {
+	handler := &PipelineHandler{}
+
+	resp := handler.traceSearchErrResponse(fmt.Errorf("invalid search query: %w", gferrors.ErrBadRequest))
+	assert.IsType(t, SearchPipelineJobTraces400JSONResponse{}, resp)
+
+	resp = handler.traceSearchErrResponse(fmt.Errorf("missing: %w", gferrors.ErrNotFound))
+	assert.IsType(t, SearchPipelineJobTraces404JSONResponse{}, resp)
+}
+
+// --- CancelPipeline / RetryPipeline tests ---
+
+func TestPipelineHandlerCancelPipeline(t *testing.T) {
+	t.Run("empty pipelineId returns 400", func(t *testing.T) {
+		handler := NewPipelineHandler(&stubPipelineService{})
+
+		resp, err := handler.CancelPipeline(context.Background(), CancelPipelineRequestObject{
+			Params: models.CancelPipelineParams{GitServer: "gl", Project: "p"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, CancelPipeline400JSONResponse{}, resp)
+	})
+
+	t.Run("success returns 200", func(t *testing.T) {
+		stub := &stubPipelineService{actionResp: &models.PipelineActionResponse{
+			PipelineId: "5",
+			Action:     models.PipelineActionCancel,
+			Status:     pointer.To("cancelled"),
+		}}
+		handler := NewPipelineHandler(stub)
+
+		resp, err := handler.CancelPipeline(context.Background(), CancelPipelineRequestObject{
+			Params: models.CancelPipelineParams{GitServer: "gl", Project: "krci/app", PipelineId: "5"},
+		})
+		require.NoError(t, err)
+
+		got, ok := resp.(CancelPipeline200JSONResponse)
+		require.True(t, ok, "expected CancelPipeline200JSONResponse, got %T", resp)
+		assert.Equal(t, "gl", stub.gotActionGitServer)
+		assert.Equal(t, "krci/app", stub.gotActionProject)
+		assert.Equal(t, "5", stub.gotActionPipelineID)
+		assert.Equal(t, models.PipelineActionCancel, got.Action)
+	})
+
+	t.Run("unsupported provider returns 400", func(t *testing.T) {
+		handler := NewPipelineHandler(&stubPipelineService{
+			actionErr: fmt.Errorf("provider gitea does not support pipeline actions: %w", gferrors.ErrBadRequest),
+		})
+
+		resp, err := handler.CancelPipeline(context.Background(), CancelPipelineRequestObject{
+			Params: models.CancelPipelineParams{GitServer: "gt", Project: "p", PipelineId: "5"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, CancelPipeline400JSONResponse{}, resp)
+	})
+}
+
+func TestPipelineHandlerRetryPipeline(t *testing.T) {
+	t.Run("empty pipelineId returns 400", func(t *testing.T) {
+		handler := NewPipelineHandler(&stubPipelineService{})
+
+		resp, err := handler.RetryPipeline(context.Background(), RetryPipelineRequestObject{
+			Params: models.RetryPipelineParams{GitServer: "gh", Project: "p"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, RetryPipeline400JSONResponse{}, resp)
+	})
+
+	t.Run("failedOnly is passed through", func(t *testing.T) {
+		stub := &stubPipelineService{actionResp: &models.PipelineActionResponse{
+			PipelineId: "7",
+			Action:     models.PipelineActionRetry,
+		}}
+		handler := NewPipelineHandler(stub)
+
+		resp, err := handler.RetryPipeline(context.Background(), RetryPipelineRequestObject{
+			Params: models.RetryPipelineParams{
+				GitServer:  "gh",
+				Project:    "org/repo",
+				PipelineId: "7",
+				FailedOnly: pointer.To(true),
+			},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, RetryPipeline200JSONResponse{}, resp)
+		assert.True(t, stub.gotRetryOpts.FailedOnly)
+	})
+}
+
+func TestPipelineHandlerRetryErrResponse(t *testing.T) {
+	handler := &PipelineHandler{}
+
+	tests := []struct {
+		name string
+		err  error
+		want RetryPipelineResponseObject
+	}{
+		{name: "unauthorized", err: gferrors.ErrUnauthorized, want: RetryPipeline401JSONResponse{}},
+		{name: "bad request", err: gferrors.ErrBadRequest, want: RetryPipeline400JSONResponse{}},
+		{name: "not found", err: gferrors.ErrNotFound, want: RetryPipeline404JSONResponse{}},
+		{name: "other", err: errors.New("boom"), want: RetryPipeline500JSONResponse{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.IsType(t, tt.want, handler.retryErrResponse(fmt.Errorf("wrapped: %w", tt.err)))
+		})
+	}
+}
+
+// --- Job action tests ---
+
+func TestPipelineHandlerPlayPipelineJob(t *testing.T) {
+	t.Run("empty jobId returns 400", func(t *testing.T) {
+		handler := NewPipelineHandler(&stubPipelineService{})
+
+		resp, err := handler.PlayPipelineJob(context.Background(), PlayPipelineJobRequestObject{
+			Params: models.PlayPipelineJobParams{GitServer: "gl", Project: "p"},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, PlayPipelineJob400JSONResponse{}, resp)
+	})
+
+	t.Run("invalid variables return 400", func(t *testing.T) {
+		handler := NewPipelineHandler(&stubPipelineService{})
+
+		resp, err := handler.PlayPipelineJob(context.Background(), PlayPipelineJobRequestObject{
+			Params: models.PlayPipelineJobParams{GitServer: "gl", Project: "p", JobId: "9", Variables: pointer.To("{")},
+		})
+		require.NoError(t, err)
+		assert.IsType(t, PlayPipelineJob400JSONResponse{}, resp)
+	})
+
+	t.Run("variables are passed through", func(t *testing.T) {
+		stub := &stubPipelineService{jobActionResp: &models.PipelineJob{Id: "9", Name: "deploy", Status: "pending"}}
+		handler := NewPipelineHandler(stub)
+
+		resp, err := handler.PlayPipelineJob(context.Background(), PlayPipelineJobRequestObject{
+			Params: models.PlayPipelineJobParams{
+				GitServer: "gl",
+				Project:   "krci/app",
+				JobId:     "9",
+				Variables: pointer.To(`[{"key":"ENV","value":"prod"}]`),
+			},
+		})
+		require.NoError(t, err)
+
+		got, ok := resp.(PlayPipelineJob200JSONResponse)
+		require.True(t, ok, "expected PlayPipelineJob200JSONResponse, got %T", resp)
+		assert.Equal(t, "pending", got.Status)
+		assert.Equal(t, "9", stub.gotJobActionJobID)
+		assert.Equal(t, []models.PipelineVariable{{Key: "ENV", Value: "prod"}}, stub.gotPlayOpts.Variables)
+	})
+}
+
+func TestPipelineHandlerRetryPipelineJob(t *testing.T) {
+	stub := &stubPipelineService{jobActionResp: &models.PipelineJob{Id: "10", Name: "test", Status: "pending"}}
+	handler := NewPipelineHandler(stub)
+
+	resp, err := handler.RetryPipelineJob(context.Background(), RetryPipelineJobRequestObject{
+		Params: models.RetryPipelineJobParams{GitServer: "gl", Project: "krci/app", JobId: "9"},
+	})
+	require.NoError(t, err)
+
+	got, ok := resp.(RetryPipelineJob200JSONResponse)
+	require.True(t, ok, "expected RetryPipelineJob200JSONResponse, got %T", resp)
+	assert.Equal(t, "10", got.Id, "a retry returns the new job")
+	assert.Equal(t, "9", stub.gotJobActionJobID)
+}
+
+func TestPipelineHandlerCancelPipelineJob(t *testing.T) {
+	handler := NewPipelineHandler(&stubPipelineService{
+		jobActionErr: fmt.Errorf("cannot cancel a single job: %w", gferrors.ErrBadRequest),
+	})
+
+	resp, err := handler.CancelPipelineJob(context.Background(), CancelPipelineJobRequestObject{
+		Params: models.CancelPipelineJobParams{GitServer: "gh", Project: "org/repo", JobId: "9"},
+	})
+	require.NoError(t, err)
+	assert.IsType(t, CancelPipelineJob400JSONResponse{}, resp)
+}
+
+func TestPipelineHandlerJobActionErrResponses(t *testing.T) {
+	handler := &PipelineHandler{}
+
+	assert.IsType(t, PlayPipelineJob404JSONResponse{}, handler.playJobErrResponse(gferrors.ErrNotFound))
+	assert.IsType(t, RetryPipelineJob401JSONResponse{}, handler.retryJobErrResponse(gferrors.ErrUnauthorized))
+	assert.IsType(t, CancelPipelineJob500JSONResponse{}, handler.cancelJobErrResponse(errors.New("boom")))
+}
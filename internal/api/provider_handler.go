@@ -0,0 +1,47 @@
+package api
+
+import (
+	"context"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+)
+
+// ProviderHandler reports the registered git providers and their capabilities.
+type ProviderHandler struct {
+	registry *registry.Registry
+}
+
+// NewProviderHandler creates a new ProviderHandler.
+func NewProviderHandler(reg *registry.Registry) *ProviderHandler {
+	return &ProviderHandler{
+		registry: reg,
+	}
+}
+
+// ListProviders implements api.StrictServerInterface.
+func (h *ProviderHandler) ListProviders(
+	_ context.Context,
+	_ ListProvidersRequestObject,
+) (ListProvidersResponseObject, error) {
+	names := h.registry.Names()
+	providers := make([]models.Provider, 0, len(names))
+
+	for _, name := range names {
+		declared := h.registry.Capabilities(name)
+		capabilities := make([]models.ProviderCapability, 0, len(declared))
+
+		for _, c := range declared {
+			capabilities = append(capabilities, models.ProviderCapability(c))
+		}
+
+		providers = append(providers, models.Provider{
+			Name:         name,
+			Capabilities: capabilities,
+		})
+	}
+
+	return ListProviders200JSONResponse{
+		Data: providers,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+)
+
+func TestProviderHandlerListProviders(t *testing.T) {
+	reg := registry.New()
+	reg.Register("gitlab", struct{}{}, registry.CapabilityRepositories, registry.CapabilityPipelines)
+	reg.Register("gerrit", struct{}{}, registry.CapabilityRepositories)
+
+	resp, err := NewProviderHandler(reg).ListProviders(context.Background(), ListProvidersRequestObject{})
+	require.NoError(t, err)
+
+	got, ok := resp.(ListProviders200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+
+	assert.Equal(t, []models.Provider{
+		{Name: "gerrit", Capabilities: []models.ProviderCapability{models.ProviderCapabilityRepositories}},
+		{Name: "gitlab", Capabilities: []models.ProviderCapability{
+			models.ProviderCapabilityRepositories,
+			models.ProviderCapabilityPipelines,
+		}},
+	}, got.Data)
+}
+
+func TestProviderHandlerCapabilitiesMatchSchema(t *testing.T) {
+	known := map[models.ProviderCapability]bool{
+		models.ProviderCapabilityRepositories:            true,
+		models.ProviderCapabilityOrganizations:           true,
+		models.ProviderCapabilityBranches:                true,
+		models.ProviderCapabilityPullRequests:            true,
+		models.ProviderCapabilityPipelines:               true,
+		models.ProviderCapabilityPipelineJobs:            true,
+		models.ProviderCapabilityPipelineCancel:          true,
+		models.ProviderCapabilityPipelineRetry:           true,
+		models.ProviderCapabilityPipelineJobPlay:         true,
+		models.ProviderCapabilityPipelineJobRetry:        true,
+		models.ProviderCapabilityPipelineJobCancel:       true,
+		models.ProviderCapabilityPipelineDetail:          true,
+		models.ProviderCapabilityPipelineJobTraceStream:  true,
+		models.ProviderCapabilityPipelineJobTraceWindow:  true,
+		models.ProviderCapabilityPipelineTestReport:      true,
+		models.ProviderCapabilityPipelineArtifacts:       true,
+		models.ProviderCapabilityPipelineSchedules:       true,
+		models.ProviderCapabilityPipelineScheduleActions: true,
+		models.ProviderCapabilityPipelineGraph:           true,
+		models.ProviderCapabilityPullRequestPipelines:    true,
+		models.ProviderCapabilityCommitStatuses:          true,
+		models.ProviderCapabilityCommitStatusCreate:      true,
+	}
+
+	reg := registry.NewDefault()
+
+	for _, name := range reg.Names() {
+		for _, c := range reg.Capabilities(name) {
+			assert.True(t, known[models.ProviderCapability(c)], "%s declares %q, which is missing from the API schema", name, c)
+		}
+	}
+}
@@ -13,10 +13,12 @@ import (
 
 	"github.com/KubeRocketCI/gitfusion/internal/cache"
 	"github.com/KubeRocketCI/gitfusion/internal/services/branches"
+	"github.com/KubeRocketCI/gitfusion/internal/services/commitstatuses"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
 	"github.com/KubeRocketCI/gitfusion/internal/services/organizations"
 	"github.com/KubeRocketCI/gitfusion/internal/services/pipelines"
 	"github.com/KubeRocketCI/gitfusion/internal/services/pullrequests"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
 	"github.com/KubeRocketCI/gitfusion/internal/services/repositories"
 )
 
@@ -30,6 +32,8 @@ type Server struct {
 	cacheHandler        *CacheHandler
 	pipelineHandler     *PipelineHandler
 	pullRequestHandler  *PullRequestHandler
+	commitStatusHandler *CommitStatusHandler
+	providerHandler     *ProviderHandler
 }
 
 // NewServer creates a new Server instance.
@@ -40,6 +44,8 @@ func NewServer(
 	cacheHandler *CacheHandler,
 	pipelineHandler *PipelineHandler,
 	pullRequestHandler *PullRequestHandler,
+	commitStatusHandler *CommitStatusHandler,
+	providerHandler *ProviderHandler,
 ) *Server {
 	return &Server{
 		repositoryHandler:   repositoryHandler,
@@ -48,6 +54,8 @@ func NewServer(
 		cacheHandler:        cacheHandler,
 		pipelineHandler:     pipelineHandler,
 		pullRequestHandler:  pullRequestHandler,
+		commitStatusHandler: commitStatusHandler,
+		providerHandler:     providerHandler,
 	}
 }
 
@@ -115,6 +123,14 @@ func (s *Server) ListPipelines(
 	return s.pipelineHandler.ListPipelines(ctx, request)
 }
 
+// GetPipeline implements StrictServerInterface.
+func (s *Server) GetPipeline(
+	ctx context.Context,
+	request GetPipelineRequestObject,
+) (GetPipelineResponseObject, error) {
+	return s.pipelineHandler.GetPipeline(ctx, request)
+}
+
 // ListPipelineJobs implements StrictServerInterface.
 func (s *Server) ListPipelineJobs(
 	ctx context.Context,
@@ -123,6 +139,94 @@ func (s *Server) ListPipelineJobs(
 	return s.pipelineHandler.ListPipelineJobs(ctx, request)
 }
 
+// ListPullRequestPipelines implements StrictServerInterface.
+func (s *Server) ListPullRequestPipelines(
+	ctx context.Context,
+	request ListPullRequestPipelinesRequestObject,
+) (ListPullRequestPipelinesResponseObject, error) {
+	return s.pipelineHandler.ListPullRequestPipelines(ctx, request)
+}
+
+// GetPipelineGraph implements StrictServerInterface.
+func (s *Server) GetPipelineGraph(
+	ctx context.Context,
+	request GetPipelineGraphRequestObject,
+) (GetPipelineGraphResponseObject, error) {
+	return s.pipelineHandler.GetPipelineGraph(ctx, request)
+}
+
+// GetPipelineTestReport implements StrictServerInterface.
+func (s *Server) GetPipelineTestReport(
+	ctx context.Context,
+	request GetPipelineTestReportRequestObject,
+) (GetPipelineTestReportResponseObject, error) {
+	return s.pipelineHandler.GetPipelineTestReport(ctx, request)
+}
+
+// ListPipelineArtifacts implements StrictServerInterface.
+func (s *Server) ListPipelineArtifacts(
+	ctx context.Context,
+	request ListPipelineArtifactsRequestObject,
+) (ListPipelineArtifactsResponseObject, error) {
+	return s.pipelineHandler.ListPipelineArtifacts(ctx, request)
+}
+
+// DownloadPipelineArtifact implements StrictServerInterface.
+func (s *Server) DownloadPipelineArtifact(
+	ctx context.Context,
+	request DownloadPipelineArtifactRequestObject,
+) (DownloadPipelineArtifactResponseObject, error) {
+	return s.pipelineHandler.DownloadPipelineArtifact(ctx, request)
+}
+
+// ListPipelineSchedules implements StrictServerInterface.
+func (s *Server) ListPipelineSchedules(
+	ctx context.Context,
+	request ListPipelineSchedulesRequestObject,
+) (ListPipelineSchedulesResponseObject, error) {
+	return s.pipelineHandler.ListPipelineSchedules(ctx, request)
+}
+
+// GetPipelineSchedule implements StrictServerInterface.
+func (s *Server) GetPipelineSchedule(
+	ctx context.Context,
+	request GetPipelineScheduleRequestObject,
+) (GetPipelineScheduleResponseObject, error) {
+	return s.pipelineHandler.GetPipelineSchedule(ctx, request)
+}
+
+// CreatePipelineSchedule implements StrictServerInterface.
+func (s *Server) CreatePipelineSchedule(
+	ctx context.Context,
+	request CreatePipelineScheduleRequestObject,
+) (CreatePipelineScheduleResponseObject, error) {
+	return s.pipelineHandler.CreatePipelineSchedule(ctx, request)
+}
+
+// UpdatePipelineSchedule implements StrictServerInterface.
+func (s *Server) UpdatePipelineSchedule(
+	ctx context.Context,
+	request UpdatePipelineScheduleRequestObject,
+) (UpdatePipelineScheduleResponseObject, error) {
+	return s.pipelineHandler.UpdatePipelineSchedule(ctx, request)
+}
+
+// DeletePipelineSchedule implements StrictServerInterface.
+func (s *Server) DeletePipelineSchedule(
+	ctx context.Context,
+	request DeletePipelineScheduleRequestObject,
+) (DeletePipelineScheduleResponseObject, error) {
+	return s.pipelineHandler.DeletePipelineSchedule(ctx, request)
+}
+
+// RunPipelineSchedule implements StrictServerInterface.
+func (s *Server) RunPipelineSchedule(
+	ctx context.Context,
+	request RunPipelineScheduleRequestObject,
+) (RunPipelineScheduleResponseObject, error) {
+	return s.pipelineHandler.RunPipelineSchedule(ctx, request)
+}
+
 // GetPipelineJobTrace implements StrictServerInterface.
 func (s *Server) GetPipelineJobTrace(
 	ctx context.Context,
@@ -131,6 +235,86 @@ func (s *Server) GetPipelineJobTrace(
 	return s.pipelineHandler.GetPipelineJobTrace(ctx, request)
 }
 
+// StreamPipelineJobTrace implements StrictServerInterface.
+func (s *Server) StreamPipelineJobTrace(
+	ctx context.Context,
+	request StreamPipelineJobTraceRequestObject,
+) (StreamPipelineJobTraceResponseObject, error) {
+	return s.pipelineHandler.StreamPipelineJobTrace(ctx, request)
+}
+
+// SearchPipelineJobTraces implements StrictServerInterface.
+func (s *Server) SearchPipelineJobTraces(
+	ctx context.Context,
+	request SearchPipelineJobTracesRequestObject,
+) (SearchPipelineJobTracesResponseObject, error) {
+	return s.pipelineHandler.SearchPipelineJobTraces(ctx, request)
+}
+
+// CancelPipeline implements StrictServerInterface.
+func (s *Server) CancelPipeline(
+	ctx context.Context,
+	request CancelPipelineRequestObject,
+) (CancelPipelineResponseObject, error) {
+	return s.pipelineHandler.CancelPipeline(ctx, request)
+}
+
+// RetryPipeline implements StrictServerInterface.
+func (s *Server) RetryPipeline(
+	ctx context.Context,
+	request RetryPipelineRequestObject,
+) (RetryPipelineResponseObject, error) {
+	return s.pipelineHandler.RetryPipeline(ctx, request)
+}
+
+// PlayPipelineJob implements StrictServerInterface.
+func (s *Server) PlayPipelineJob(
+	ctx context.Context,
+	request PlayPipelineJobRequestObject,
+) (PlayPipelineJobResponseObject, error) {
+	return s.pipelineHandler.PlayPipelineJob(ctx, request)
+}
+
+// RetryPipelineJob implements StrictServerInterface.
+func (s *Server) RetryPipelineJob(
+	ctx context.Context,
+	request RetryPipelineJobRequestObject,
+) (RetryPipelineJobResponseObject, error) {
+	return s.pipelineHandler.RetryPipelineJob(ctx, request)
+}
+
+// CancelPipelineJob implements StrictServerInterface.
+func (s *Server) CancelPipelineJob(
+	ctx context.Context,
+	request CancelPipelineJobRequestObject,
+) (CancelPipelineJobResponseObject, error) {
+	return s.pipelineHandler.CancelPipelineJob(ctx, request)
+}
+
+// ListCommitStatuses implements StrictServerInterface.
+func (s *Server) ListCommitStatuses(
+	ctx context.Context,
+	request ListCommitStatusesRequestObject,
+) (ListCommitStatusesResponseObject, error) {
+	return s.commitStatusHandler.ListCommitStatuses(ctx, request)
+}
+
+// CreateCommitStatus implements StrictServerInterface.
+func (s *Server) CreateCommitStatus(
+	ctx context.Context,
+	request CreateCommitStatusRequestObject,
+) (CreateCommitStatusResponseObject, error) {
+	return s.commitStatusHandler.CreateCommitStatus(ctx, request)
+}
+
+// ListProviders implements StrictServerInterface.
+func (s *Server) ListProviders(
+	ctx context.Context,
+	request ListProvidersRequestObject,
+) (ListProvidersResponseObject, error) {
+	return s.providerHandler.ListProviders(ctx, request)
+}
+
 func BuildHandler(conf Config) (ServerInterface, error) {
 	k8sCl, err := initk8sClient()
 	if err != nil {
@@ -139,12 +323,16 @@ func BuildHandler(conf Config) (ServerInterface, error) {
 
 	gitServerService := krci.NewGitServerService(k8sCl, conf.Namespace)
 
+	// All multi-provider services share the provider instances of one registry
+	providerRegistry := registry.NewDefault()
+
 	// Create multi-provider services
-	repoMultiProvider := repositories.NewMultiProviderRepositoryService()
-	orgMultiProvider := organizations.NewMultiProviderOrganizationsService(gitServerService)
-	branchesMultiProvider := branches.NewMultiProviderBranchesService()
-	pipelinesMultiProvider := pipelines.NewMultiProviderPipelineService()
-	pullRequestsMultiProvider := pullrequests.NewMultiProviderPullRequestsService()
+	repoMultiProvider := repositories.NewMultiProviderRepositoryService(providerRegistry)
+	orgMultiProvider := organizations.NewMultiProviderOrganizationsService(providerRegistry, gitServerService)
+	branchesMultiProvider := branches.NewMultiProviderBranchesService(providerRegistry)
+	pipelinesMultiProvider := pipelines.NewMultiProviderPipelineService(providerRegistry)
+	pullRequestsMultiProvider := pullrequests.NewMultiProviderPullRequestsService(providerRegistry)
+	commitStatusesMultiProvider := commitstatuses.NewMultiProviderCommitStatusesService(providerRegistry)
 
 	// Create high-level services
 	repoSvc := repositories.NewRepositoriesService(repoMultiProvider, gitServerService)
@@ -152,6 +340,7 @@ func BuildHandler(conf Config) (ServerInterface, error) {
 	branchesSvc := branches.NewBranchesService(branchesMultiProvider, gitServerService)
 	pipelinesSvc := pipelines.NewPipelinesService(pipelinesMultiProvider, gitServerService)
 	pullRequestsSvc := pullrequests.NewPullRequestsService(pullRequestsMultiProvider, gitServerService)
+	commitStatusesSvc := commitstatuses.NewCommitStatusesService(commitStatusesMultiProvider, gitServerService)
 
 	// Create cache manager with access to all cache instances
 	cacheManager := cache.NewManager(
@@ -162,6 +351,7 @@ func BuildHandler(conf Config) (ServerInterface, error) {
 		pipelinesSvc.GetProvider().GetCache(),
 		pipelinesSvc.GetProvider().GetJobsCache(),
 		pipelinesSvc.GetProvider().GetTraceCache(),
+		pipelinesSvc.GetProvider().GetTestReportCache(),
 	)
 
 	// Create handlers
@@ -169,6 +359,8 @@ func BuildHandler(conf Config) (ServerInterface, error) {
 	cacheHandler := NewCacheHandler(cacheManager)
 	pipelineHandler := NewPipelineHandler(pipelinesSvc)
 	pullRequestHandler := NewPullRequestHandler(pullRequestsSvc)
+	commitStatusHandler := NewCommitStatusHandler(commitStatusesSvc)
+	providerHandler := NewProviderHandler(providerRegistry)
 
 	return NewStrictHandlerWithOptions(
 		NewServer(
@@ -178,6 +370,8 @@ func BuildHandler(conf Config) (ServerInterface, error) {
 			cacheHandler,
 			pipelineHandler,
 			pullRequestHandler,
+			commitStatusHandler,
+			providerHandler,
 		),
 		[]StrictMiddlewareFunc{},
 		StrictHTTPServerOptions{},
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	. "github.com/KubeRocketCI/gitfusion/internal/models"
@@ -23,15 +24,81 @@ type ServerInterface interface {
 	// Invalidate cache for a specific endpoint
 	// (DELETE /api/v1/cache/invalidate)
 	InvalidateCache(w http.ResponseWriter, r *http.Request, params InvalidateCacheParams)
+	// List the statuses reported for a commit
+	// (GET /api/v1/commit-statuses)
+	ListCommitStatuses(w http.ResponseWriter, r *http.Request, params ListCommitStatusesParams)
+	// Report a status for a commit
+	// (POST /api/v1/commit-statuses)
+	CreateCommitStatus(w http.ResponseWriter, r *http.Request, params CreateCommitStatusParams)
+	// Get a single CI/CD pipeline
+	// (GET /api/v1/pipeline)
+	GetPipeline(w http.ResponseWriter, r *http.Request, params GetPipelineParams)
+	// List the artifacts of a CI/CD pipeline
+	// (GET /api/v1/pipeline-artifacts)
+	ListPipelineArtifacts(w http.ResponseWriter, r *http.Request, params ListPipelineArtifactsParams)
+	// Download a CI/CD pipeline artifact
+	// (GET /api/v1/pipeline-artifacts/download)
+	DownloadPipelineArtifact(w http.ResponseWriter, r *http.Request, params DownloadPipelineArtifactParams)
+	// Get the graph of a CI/CD pipeline and its downstream pipelines
+	// (GET /api/v1/pipeline-graph)
+	GetPipelineGraph(w http.ResponseWriter, r *http.Request, params GetPipelineGraphParams)
 	// Get the trace (log) of a CI/CD pipeline job
 	// (GET /api/v1/pipeline-job-trace)
 	GetPipelineJobTrace(w http.ResponseWriter, r *http.Request, params GetPipelineJobTraceParams)
+	// Stream the trace (log) of a running CI/CD pipeline job
+	// (GET /api/v1/pipeline-job-trace/stream)
+	StreamPipelineJobTrace(w http.ResponseWriter, r *http.Request, params StreamPipelineJobTraceParams)
+	// Search the traces (logs) of every job of a CI/CD pipeline
+	// (GET /api/v1/pipeline-job-traces/search)
+	SearchPipelineJobTraces(w http.ResponseWriter, r *http.Request, params SearchPipelineJobTracesParams)
 	// List jobs for a CI/CD pipeline
 	// (GET /api/v1/pipeline-jobs)
 	ListPipelineJobs(w http.ResponseWriter, r *http.Request, params ListPipelineJobsParams)
+	// Cancel a running CI/CD job
+	// (POST /api/v1/pipeline-jobs/cancel)
+	CancelPipelineJob(w http.ResponseWriter, r *http.Request, params CancelPipelineJobParams)
+	// Start a manual CI/CD job
+	// (POST /api/v1/pipeline-jobs/play)
+	PlayPipelineJob(w http.ResponseWriter, r *http.Request, params PlayPipelineJobParams)
+	// Retry a finished CI/CD job
+	// (POST /api/v1/pipeline-jobs/retry)
+	RetryPipelineJob(w http.ResponseWriter, r *http.Request, params RetryPipelineJobParams)
+	// Get a pipeline schedule
+	// (GET /api/v1/pipeline-schedule)
+	GetPipelineSchedule(w http.ResponseWriter, r *http.Request, params GetPipelineScheduleParams)
+	// Delete a pipeline schedule
+	// (DELETE /api/v1/pipeline-schedules)
+	DeletePipelineSchedule(w http.ResponseWriter, r *http.Request, params DeletePipelineScheduleParams)
+	// List the pipeline schedules of a project
+	// (GET /api/v1/pipeline-schedules)
+	ListPipelineSchedules(w http.ResponseWriter, r *http.Request, params ListPipelineSchedulesParams)
+	// Create a pipeline schedule
+	// (POST /api/v1/pipeline-schedules)
+	CreatePipelineSchedule(w http.ResponseWriter, r *http.Request, params CreatePipelineScheduleParams)
+	// Run a pipeline schedule now
+	// (POST /api/v1/pipeline-schedules/run)
+	RunPipelineSchedule(w http.ResponseWriter, r *http.Request, params RunPipelineScheduleParams)
+	// Update a pipeline schedule
+	// (POST /api/v1/pipeline-schedules/update)
+	UpdatePipelineSchedule(w http.ResponseWriter, r *http.Request, params UpdatePipelineScheduleParams)
+	// Get the test report of a CI/CD pipeline
+	// (GET /api/v1/pipeline-test-report)
+	GetPipelineTestReport(w http.ResponseWriter, r *http.Request, params GetPipelineTestReportParams)
 	// List CI/CD pipelines for a project
 	// (GET /api/v1/pipelines)
 	ListPipelines(w http.ResponseWriter, r *http.Request, params ListPipelinesParams)
+	// Cancel a running CI/CD pipeline
+	// (POST /api/v1/pipelines/cancel)
+	CancelPipeline(w http.ResponseWriter, r *http.Request, params CancelPipelineParams)
+	// Retry a finished CI/CD pipeline
+	// (POST /api/v1/pipelines/retry)
+	RetryPipeline(w http.ResponseWriter, r *http.Request, params RetryPipelineParams)
+	// List supported git providers and their capabilities
+	// (GET /api/v1/providers)
+	ListProviders(w http.ResponseWriter, r *http.Request)
+	// List the CI/CD pipelines of a pull request
+	// (GET /api/v1/pull-request-pipelines)
+	ListPullRequestPipelines(w http.ResponseWriter, r *http.Request, params ListPullRequestPipelinesParams)
 	// List pull/merge requests for a repository
 	// (GET /api/v1/pull-requests)
 	ListPullRequests(w http.ResponseWriter, r *http.Request, params ListPullRequestsParams)
@@ -65,24 +132,156 @@ func (_ Unimplemented) InvalidateCache(w http.ResponseWriter, r *http.Request, p
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// List the statuses reported for a commit
+// (GET /api/v1/commit-statuses)
+func (_ Unimplemented) ListCommitStatuses(w http.ResponseWriter, r *http.Request, params ListCommitStatusesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Report a status for a commit
+// (POST /api/v1/commit-statuses)
+func (_ Unimplemented) CreateCommitStatus(w http.ResponseWriter, r *http.Request, params CreateCommitStatusParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a single CI/CD pipeline
+// (GET /api/v1/pipeline)
+func (_ Unimplemented) GetPipeline(w http.ResponseWriter, r *http.Request, params GetPipelineParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the artifacts of a CI/CD pipeline
+// (GET /api/v1/pipeline-artifacts)
+func (_ Unimplemented) ListPipelineArtifacts(w http.ResponseWriter, r *http.Request, params ListPipelineArtifactsParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Download a CI/CD pipeline artifact
+// (GET /api/v1/pipeline-artifacts/download)
+func (_ Unimplemented) DownloadPipelineArtifact(w http.ResponseWriter, r *http.Request, params DownloadPipelineArtifactParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the graph of a CI/CD pipeline and its downstream pipelines
+// (GET /api/v1/pipeline-graph)
+func (_ Unimplemented) GetPipelineGraph(w http.ResponseWriter, r *http.Request, params GetPipelineGraphParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Get the trace (log) of a CI/CD pipeline job
 // (GET /api/v1/pipeline-job-trace)
 func (_ Unimplemented) GetPipelineJobTrace(w http.ResponseWriter, r *http.Request, params GetPipelineJobTraceParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Stream the trace (log) of a running CI/CD pipeline job
+// (GET /api/v1/pipeline-job-trace/stream)
+func (_ Unimplemented) StreamPipelineJobTrace(w http.ResponseWriter, r *http.Request, params StreamPipelineJobTraceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search the traces (logs) of every job of a CI/CD pipeline
+// (GET /api/v1/pipeline-job-traces/search)
+func (_ Unimplemented) SearchPipelineJobTraces(w http.ResponseWriter, r *http.Request, params SearchPipelineJobTracesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List jobs for a CI/CD pipeline
 // (GET /api/v1/pipeline-jobs)
 func (_ Unimplemented) ListPipelineJobs(w http.ResponseWriter, r *http.Request, params ListPipelineJobsParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Cancel a running CI/CD job
+// (POST /api/v1/pipeline-jobs/cancel)
+func (_ Unimplemented) CancelPipelineJob(w http.ResponseWriter, r *http.Request, params CancelPipelineJobParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Start a manual CI/CD job
+// (POST /api/v1/pipeline-jobs/play)
+func (_ Unimplemented) PlayPipelineJob(w http.ResponseWriter, r *http.Request, params PlayPipelineJobParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Retry a finished CI/CD job
+// (POST /api/v1/pipeline-jobs/retry)
+func (_ Unimplemented) RetryPipelineJob(w http.ResponseWriter, r *http.Request, params RetryPipelineJobParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get a pipeline schedule
+// (GET /api/v1/pipeline-schedule)
+func (_ Unimplemented) GetPipelineSchedule(w http.ResponseWriter, r *http.Request, params GetPipelineScheduleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Delete a pipeline schedule
+// (DELETE /api/v1/pipeline-schedules)
+func (_ Unimplemented) DeletePipelineSchedule(w http.ResponseWriter, r *http.Request, params DeletePipelineScheduleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the pipeline schedules of a project
+// (GET /api/v1/pipeline-schedules)
+func (_ Unimplemented) ListPipelineSchedules(w http.ResponseWriter, r *http.Request, params ListPipelineSchedulesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Create a pipeline schedule
+// (POST /api/v1/pipeline-schedules)
+func (_ Unimplemented) CreatePipelineSchedule(w http.ResponseWriter, r *http.Request, params CreatePipelineScheduleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Run a pipeline schedule now
+// (POST /api/v1/pipeline-schedules/run)
+func (_ Unimplemented) RunPipelineSchedule(w http.ResponseWriter, r *http.Request, params RunPipelineScheduleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a pipeline schedule
+// (POST /api/v1/pipeline-schedules/update)
+func (_ Unimplemented) UpdatePipelineSchedule(w http.ResponseWriter, r *http.Request, params UpdatePipelineScheduleParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the test report of a CI/CD pipeline
+// (GET /api/v1/pipeline-test-report)
+func (_ Unimplemented) GetPipelineTestReport(w http.ResponseWriter, r *http.Request, params GetPipelineTestReportParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List CI/CD pipelines for a project
 // (GET /api/v1/pipelines)
 func (_ Unimplemented) ListPipelines(w http.ResponseWriter, r *http.Request, params ListPipelinesParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Cancel a running CI/CD pipeline
+// (POST /api/v1/pipelines/cancel)
+func (_ Unimplemented) CancelPipeline(w http.ResponseWriter, r *http.Request, params CancelPipelineParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Retry a finished CI/CD pipeline
+// (POST /api/v1/pipelines/retry)
+func (_ Unimplemented) RetryPipeline(w http.ResponseWriter, r *http.Request, params RetryPipelineParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List supported git providers and their capabilities
+// (GET /api/v1/providers)
+func (_ Unimplemented) ListProviders(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List the CI/CD pipelines of a pull request
+// (GET /api/v1/pull-request-pipelines)
+func (_ Unimplemented) ListPullRequestPipelines(w http.ResponseWriter, r *http.Request, params ListPullRequestPipelinesParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // List pull/merge requests for a repository
 // (GET /api/v1/pull-requests)
 func (_ Unimplemented) ListPullRequests(w http.ResponseWriter, r *http.Request, params ListPullRequestsParams) {
@@ -220,13 +419,13 @@ func (siw *ServerInterfaceWrapper) InvalidateCache(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// GetPipelineJobTrace operation middleware
-func (siw *ServerInterfaceWrapper) GetPipelineJobTrace(w http.ResponseWriter, r *http.Request) {
+// ListCommitStatuses operation middleware
+func (siw *ServerInterfaceWrapper) ListCommitStatuses(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPipelineJobTraceParams
+	var params ListCommitStatusesParams
 
 	// ------------- Required query parameter "gitServer" -------------
 
@@ -258,23 +457,23 @@ func (siw *ServerInterfaceWrapper) GetPipelineJobTrace(w http.ResponseWriter, r
 		return
 	}
 
-	// ------------- Required query parameter "jobId" -------------
+	// ------------- Required query parameter "sha" -------------
 
-	if paramValue := r.URL.Query().Get("jobId"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("sha"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "jobId"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sha"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "jobId", r.URL.Query(), &params.JobId)
+	err = runtime.BindQueryParameter("form", true, true, "sha", r.URL.Query(), &params.Sha)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sha", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPipelineJobTrace(w, r, params)
+		siw.Handler.ListCommitStatuses(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -284,13 +483,13 @@ func (siw *ServerInterfaceWrapper) GetPipelineJobTrace(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r)
 }
 
-// ListPipelineJobs operation middleware
-func (siw *ServerInterfaceWrapper) ListPipelineJobs(w http.ResponseWriter, r *http.Request) {
+// CreateCommitStatus operation middleware
+func (siw *ServerInterfaceWrapper) CreateCommitStatus(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPipelineJobsParams
+	var params CreateCommitStatusParams
 
 	// ------------- Required query parameter "gitServer" -------------
 
@@ -322,23 +521,69 @@ func (siw *ServerInterfaceWrapper) ListPipelineJobs(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Required query parameter "pipelineId" -------------
+	// ------------- Required query parameter "sha" -------------
 
-	if paramValue := r.URL.Query().Get("pipelineId"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("sha"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pipelineId"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "sha"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "pipelineId", r.URL.Query(), &params.PipelineId)
+	err = runtime.BindQueryParameter("form", true, true, "sha", r.URL.Query(), &params.Sha)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipelineId", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sha", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "state" -------------
+
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "context" -------------
+
+	if paramValue := r.URL.Query().Get("context"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "context"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "context", r.URL.Query(), &params.Context)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "context", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "description" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "description", r.URL.Query(), &params.Description)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "targetUrl" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "targetUrl", r.URL.Query(), &params.TargetUrl)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "targetUrl", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPipelineJobs(w, r, params)
+		siw.Handler.CreateCommitStatus(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -348,13 +593,13 @@ func (siw *ServerInterfaceWrapper) ListPipelineJobs(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ListPipelines operation middleware
-func (siw *ServerInterfaceWrapper) ListPipelines(w http.ResponseWriter, r *http.Request) {
+// GetPipeline operation middleware
+func (siw *ServerInterfaceWrapper) GetPipeline(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPipelinesParams
+	var params GetPipelineParams
 
 	// ------------- Required query parameter "gitServer" -------------
 
@@ -386,40 +631,23 @@ func (siw *ServerInterfaceWrapper) ListPipelines(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Optional query parameter "ref" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "ref", r.URL.Query(), &params.Ref)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ref", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
-	}
+	// ------------- Required query parameter "pipelineId" -------------
 
-	// ------------- Optional query parameter "page" -------------
+	if paramValue := r.URL.Query().Get("pipelineId"); paramValue != "" {
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pipelineId"})
 		return
 	}
 
-	// ------------- Optional query parameter "perPage" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "perPage", r.URL.Query(), &params.PerPage)
+	err = runtime.BindQueryParameter("form", true, true, "pipelineId", r.URL.Query(), &params.PipelineId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "perPage", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipelineId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPipelines(w, r, params)
+		siw.Handler.GetPipeline(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -429,13 +657,13 @@ func (siw *ServerInterfaceWrapper) ListPipelines(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// ListPullRequests operation middleware
-func (siw *ServerInterfaceWrapper) ListPullRequests(w http.ResponseWriter, r *http.Request) {
+// ListPipelineArtifacts operation middleware
+func (siw *ServerInterfaceWrapper) ListPipelineArtifacts(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListPullRequestsParams
+	var params ListPipelineArtifactsParams
 
 	// ------------- Required query parameter "gitServer" -------------
 
@@ -452,62 +680,46 @@ func (siw *ServerInterfaceWrapper) ListPullRequests(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Required query parameter "owner" -------------
+	// ------------- Required query parameter "project" -------------
 
-	if paramValue := r.URL.Query().Get("owner"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "owner"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "owner", r.URL.Query(), &params.Owner)
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "repoName" -------------
+	// ------------- Required query parameter "pipelineId" -------------
 
-	if paramValue := r.URL.Query().Get("repoName"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("pipelineId"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repoName"})
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "repoName", r.URL.Query(), &params.RepoName)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repoName", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "state" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pipelineId"})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, true, "pipelineId", r.URL.Query(), &params.PipelineId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipelineId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "perPage" -------------
+	// ------------- Optional query parameter "jobId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "perPage", r.URL.Query(), &params.PerPage)
+	err = runtime.BindQueryParameter("form", true, false, "jobId", r.URL.Query(), &params.JobId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "perPage", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPullRequests(w, r, params)
+		siw.Handler.ListPipelineArtifacts(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -517,13 +729,13 @@ func (siw *ServerInterfaceWrapper) ListPullRequests(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// ListRepositories operation middleware
-func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *http.Request) {
+// DownloadPipelineArtifact operation middleware
+func (siw *ServerInterfaceWrapper) DownloadPipelineArtifact(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRepositoriesParams
+	var params DownloadPipelineArtifactParams
 
 	// ------------- Required query parameter "gitServer" -------------
 
@@ -540,31 +752,38 @@ func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *ht
 		return
 	}
 
-	// ------------- Required query parameter "owner" -------------
+	// ------------- Required query parameter "project" -------------
 
-	if paramValue := r.URL.Query().Get("owner"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "owner"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "owner", r.URL.Query(), &params.Owner)
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "repoName" -------------
+	// ------------- Required query parameter "artifactId" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "repoName", r.URL.Query(), &params.RepoName)
+	if paramValue := r.URL.Query().Get("artifactId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "artifactId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "artifactId", r.URL.Query(), &params.ArtifactId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repoName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "artifactId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRepositories(w, r, params)
+		siw.Handler.DownloadPipelineArtifact(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -574,13 +793,13 @@ func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// GetRepository operation middleware
-func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.Request) {
+// GetPipelineGraph operation middleware
+func (siw *ServerInterfaceWrapper) GetPipelineGraph(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetRepositoryParams
+	var params GetPipelineGraphParams
 
 	// ------------- Required query parameter "gitServer" -------------
 
@@ -597,38 +816,46 @@ func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Required query parameter "owner" -------------
+	// ------------- Required query parameter "project" -------------
 
-	if paramValue := r.URL.Query().Get("owner"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "owner"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "owner", r.URL.Query(), &params.Owner)
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
 		return
 	}
 
-	// ------------- Required query parameter "repoName" -------------
+	// ------------- Required query parameter "pipelineId" -------------
 
-	if paramValue := r.URL.Query().Get("repoName"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("pipelineId"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repoName"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pipelineId"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "repoName", r.URL.Query(), &params.RepoName)
+	err = runtime.BindQueryParameter("form", true, true, "pipelineId", r.URL.Query(), &params.PipelineId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repoName", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipelineId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "depth" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "depth", r.URL.Query(), &params.Depth)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "depth", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRepository(w, r, params)
+		siw.Handler.GetPipelineGraph(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -638,13 +865,13 @@ func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r)
 }
 
-// TriggerPipeline operation middleware
-func (siw *ServerInterfaceWrapper) TriggerPipeline(w http.ResponseWriter, r *http.Request) {
+// GetPipelineJobTrace operation middleware
+func (siw *ServerInterfaceWrapper) GetPipelineJobTrace(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params TriggerPipelineParams
+	var params GetPipelineJobTraceParams
 
 	// ------------- Required query parameter "gitServer" -------------
 
@@ -676,31 +903,63 @@ func (siw *ServerInterfaceWrapper) TriggerPipeline(w http.ResponseWriter, r *htt
 		return
 	}
 
-	// ------------- Required query parameter "ref" -------------
+	// ------------- Required query parameter "jobId" -------------
 
-	if paramValue := r.URL.Query().Get("ref"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("jobId"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ref"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "jobId"})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "ref", r.URL.Query(), &params.Ref)
+	err = runtime.BindQueryParameter("form", true, true, "jobId", r.URL.Query(), &params.JobId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ref", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "variables" -------------
+	// ------------- Optional query parameter "offset" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "variables", r.URL.Query(), &params.Variables)
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "variables", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "tail" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tail", r.URL.Query(), &params.Tail)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "tail", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ansi" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ansi", r.URL.Query(), &params.Ansi)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ansi", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.TriggerPipeline(w, r, params)
+		siw.Handler.GetPipelineJobTrace(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -710,13 +969,13 @@ func (siw *ServerInterfaceWrapper) TriggerPipeline(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
-// ListUserOrganizations operation middleware
-func (siw *ServerInterfaceWrapper) ListUserOrganizations(w http.ResponseWriter, r *http.Request) {
+// StreamPipelineJobTrace operation middleware
+func (siw *ServerInterfaceWrapper) StreamPipelineJobTrace(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListUserOrganizationsParams
+	var params StreamPipelineJobTraceParams
 
 	// ------------- Required query parameter "gitServer" -------------
 
@@ -733,713 +992,4222 @@ func (siw *ServerInterfaceWrapper) ListUserOrganizations(w http.ResponseWriter,
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListUserOrganizations(w, r, params)
-	}))
+	// ------------- Required query parameter "project" -------------
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
 
-	handler.ServeHTTP(w, r)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	// ------------- Required query parameter "jobId" -------------
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	if paramValue := r.URL.Query().Get("jobId"); paramValue != "" {
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "jobId"})
+		return
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	err = runtime.BindQueryParameter("form", true, true, "jobId", r.URL.Query(), &params.JobId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
+		return
+	}
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional query parameter "offset" -------------
 
-type RequiredParamError struct {
-	ParamName string
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	headers := r.Header
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+		params.LastEventID = &LastEventID
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.StreamPipelineJobTrace(w, r, params)
+	}))
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+	handler.ServeHTTP(w, r)
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+// SearchPipelineJobTraces operation middleware
+func (siw *ServerInterfaceWrapper) SearchPipelineJobTraces(w http.ResponseWriter, r *http.Request) {
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+	var err error
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchPipelineJobTracesParams
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
-}
+	// ------------- Required query parameter "gitServer" -------------
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
 
-	if r == nil {
-		r = chi.NewRouter()
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
 	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
 	}
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/branches", wrapper.ListBranches)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/api/v1/cache/invalidate", wrapper.InvalidateCache)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/pipeline-job-trace", wrapper.GetPipelineJobTrace)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/pipeline-jobs", wrapper.ListPipelineJobs)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/pipelines", wrapper.ListPipelines)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/pull-requests", wrapper.ListPullRequests)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/repositories", wrapper.ListRepositories)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/repository", wrapper.GetRepository)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/api/v1/trigger-pipeline", wrapper.TriggerPipeline)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/api/v1/user/organizations", wrapper.ListUserOrganizations)
-	})
+	// ------------- Required query parameter "pipelineId" -------------
 
-	return r
-}
+	if paramValue := r.URL.Query().Get("pipelineId"); paramValue != "" {
 
-type ListBranchesRequestObject struct {
-	Params ListBranchesParams
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pipelineId"})
+		return
+	}
 
-type ListBranchesResponseObject interface {
-	VisitListBranchesResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, true, "pipelineId", r.URL.Query(), &params.PipelineId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipelineId", Err: err})
+		return
+	}
 
-type ListBranches200JSONResponse BranchesResponse
+	// ------------- Required query parameter "query" -------------
 
-func (response ListBranches200JSONResponse) VisitListBranchesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if paramValue := r.URL.Query().Get("query"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "query"})
+		return
+	}
 
-type ListBranches400JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, true, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
 
-func (response ListBranches400JSONResponse) VisitListBranchesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "regex" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "regex", r.URL.Query(), &params.Regex)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "regex", Err: err})
+		return
+	}
 
-type ListBranches401JSONResponse Error
+	// ------------- Optional query parameter "ignoreCase" -------------
 
-func (response ListBranches401JSONResponse) VisitListBranchesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "ignoreCase", r.URL.Query(), &params.IgnoreCase)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ignoreCase", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "context" -------------
 
-type ListBranches500JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, false, "context", r.URL.Query(), &params.Context)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "context", Err: err})
+		return
+	}
 
-func (response ListBranches500JSONResponse) VisitListBranchesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SearchPipelineJobTraces(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type InvalidateCacheRequestObject struct {
-	Params InvalidateCacheParams
+	handler.ServeHTTP(w, r)
 }
 
-type InvalidateCacheResponseObject interface {
-	VisitInvalidateCacheResponse(w http.ResponseWriter) error
-}
+// ListPipelineJobs operation middleware
+func (siw *ServerInterfaceWrapper) ListPipelineJobs(w http.ResponseWriter, r *http.Request) {
 
-type InvalidateCache200JSONResponse CacheInvalidationResponse
+	var err error
 
-func (response InvalidateCache200JSONResponse) VisitInvalidateCacheResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPipelineJobsParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Required query parameter "gitServer" -------------
 
-type InvalidateCache400JSONResponse Error
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
 
-func (response InvalidateCache400JSONResponse) VisitInvalidateCacheResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
 
-type InvalidateCache500JSONResponse Error
+	// ------------- Required query parameter "project" -------------
 
-func (response InvalidateCache500JSONResponse) VisitInvalidateCacheResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
 
-type GetPipelineJobTraceRequestObject struct {
-	Params GetPipelineJobTraceParams
-}
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
 
-type GetPipelineJobTraceResponseObject interface {
-	VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error
-}
+	// ------------- Required query parameter "pipelineId" -------------
 
-type GetPipelineJobTrace200JSONResponse PipelineJobTraceResponse
+	if paramValue := r.URL.Query().Get("pipelineId"); paramValue != "" {
 
-func (response GetPipelineJobTrace200JSONResponse) VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pipelineId"})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "pipelineId", r.URL.Query(), &params.PipelineId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipelineId", Err: err})
+		return
+	}
 
-type GetPipelineJobTrace400JSONResponse Error
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPipelineJobs(w, r, params)
+	}))
 
-func (response GetPipelineJobTrace400JSONResponse) VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r)
 }
 
-type GetPipelineJobTrace401JSONResponse Error
+// CancelPipelineJob operation middleware
+func (siw *ServerInterfaceWrapper) CancelPipelineJob(w http.ResponseWriter, r *http.Request) {
 
-func (response GetPipelineJobTrace401JSONResponse) VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelPipelineJobParams
 
-type GetPipelineJobTrace404JSONResponse Error
+	// ------------- Required query parameter "gitServer" -------------
 
-func (response GetPipelineJobTrace404JSONResponse) VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
 
-type GetPipelineJobTrace500JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
 
-func (response GetPipelineJobTrace500JSONResponse) VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Required query parameter "project" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
 
-type ListPipelineJobsRequestObject struct {
-	Params ListPipelineJobsParams
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
 
-type ListPipelineJobsResponseObject interface {
-	VisitListPipelineJobsResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
 
-type ListPipelineJobs200JSONResponse PipelineJobsResponse
+	// ------------- Required query parameter "jobId" -------------
 
-func (response ListPipelineJobs200JSONResponse) VisitListPipelineJobsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if paramValue := r.URL.Query().Get("jobId"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "jobId"})
+		return
+	}
 
-type ListPipelineJobs400JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, true, "jobId", r.URL.Query(), &params.JobId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
+		return
+	}
 
-func (response ListPipelineJobs400JSONResponse) VisitListPipelineJobsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelPipelineJob(w, r, params)
+	}))
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
 }
 
-type ListPipelineJobs401JSONResponse Error
+// PlayPipelineJob operation middleware
+func (siw *ServerInterfaceWrapper) PlayPipelineJob(w http.ResponseWriter, r *http.Request) {
 
-func (response ListPipelineJobs401JSONResponse) VisitListPipelineJobsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PlayPipelineJobParams
 
-type ListPipelineJobs404JSONResponse Error
+	// ------------- Required query parameter "gitServer" -------------
 
-func (response ListPipelineJobs404JSONResponse) VisitListPipelineJobsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
 
-type ListPipelineJobs500JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
 
-func (response ListPipelineJobs500JSONResponse) VisitListPipelineJobsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Required query parameter "project" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
 
-type ListPipelinesRequestObject struct {
-	Params ListPipelinesParams
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
 
-type ListPipelinesResponseObject interface {
-	VisitListPipelinesResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
 
-type ListPipelines200JSONResponse PipelinesResponse
+	// ------------- Required query parameter "jobId" -------------
 
-func (response ListPipelines200JSONResponse) VisitListPipelinesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if paramValue := r.URL.Query().Get("jobId"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "jobId"})
+		return
+	}
 
-type ListPipelines400JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, true, "jobId", r.URL.Query(), &params.JobId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
+		return
+	}
 
-func (response ListPipelines400JSONResponse) VisitListPipelinesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	// ------------- Optional query parameter "variables" -------------
 
-	return json.NewEncoder(w).Encode(response)
+	err = runtime.BindQueryParameter("form", true, false, "variables", r.URL.Query(), &params.Variables)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "variables", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PlayPipelineJob(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RetryPipelineJob operation middleware
+func (siw *ServerInterfaceWrapper) RetryPipelineJob(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RetryPipelineJobParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "jobId" -------------
+
+	if paramValue := r.URL.Query().Get("jobId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "jobId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "jobId", r.URL.Query(), &params.JobId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryPipelineJob(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPipelineSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetPipelineSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPipelineScheduleParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "scheduleId" -------------
+
+	if paramValue := r.URL.Query().Get("scheduleId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "scheduleId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "scheduleId", r.URL.Query(), &params.ScheduleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPipelineSchedule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePipelineSchedule operation middleware
+func (siw *ServerInterfaceWrapper) DeletePipelineSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeletePipelineScheduleParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "scheduleId" -------------
+
+	if paramValue := r.URL.Query().Get("scheduleId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "scheduleId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "scheduleId", r.URL.Query(), &params.ScheduleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePipelineSchedule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPipelineSchedules operation middleware
+func (siw *ServerInterfaceWrapper) ListPipelineSchedules(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPipelineSchedulesParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPipelineSchedules(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreatePipelineSchedule operation middleware
+func (siw *ServerInterfaceWrapper) CreatePipelineSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreatePipelineScheduleParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "description" -------------
+
+	if paramValue := r.URL.Query().Get("description"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "description"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "description", r.URL.Query(), &params.Description)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "ref" -------------
+
+	if paramValue := r.URL.Query().Get("ref"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ref"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "ref", r.URL.Query(), &params.Ref)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ref", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "cron" -------------
+
+	if paramValue := r.URL.Query().Get("cron"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "cron"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "cron", r.URL.Query(), &params.Cron)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cron", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cronTimezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cronTimezone", r.URL.Query(), &params.CronTimezone)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cronTimezone", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "variables" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "variables", r.URL.Query(), &params.Variables)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "variables", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePipelineSchedule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RunPipelineSchedule operation middleware
+func (siw *ServerInterfaceWrapper) RunPipelineSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RunPipelineScheduleParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "scheduleId" -------------
+
+	if paramValue := r.URL.Query().Get("scheduleId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "scheduleId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "scheduleId", r.URL.Query(), &params.ScheduleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunPipelineSchedule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePipelineSchedule operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePipelineSchedule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePipelineScheduleParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "scheduleId" -------------
+
+	if paramValue := r.URL.Query().Get("scheduleId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "scheduleId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "scheduleId", r.URL.Query(), &params.ScheduleId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scheduleId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "description" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "description", r.URL.Query(), &params.Description)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "description", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ref" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ref", r.URL.Query(), &params.Ref)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ref", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cron" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cron", r.URL.Query(), &params.Cron)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cron", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "cronTimezone" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cronTimezone", r.URL.Query(), &params.CronTimezone)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cronTimezone", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", r.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "active", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "variables" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "variables", r.URL.Query(), &params.Variables)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "variables", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePipelineSchedule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPipelineTestReport operation middleware
+func (siw *ServerInterfaceWrapper) GetPipelineTestReport(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPipelineTestReportParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "pipelineId" -------------
+
+	if paramValue := r.URL.Query().Get("pipelineId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pipelineId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pipelineId", r.URL.Query(), &params.PipelineId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipelineId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPipelineTestReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPipelines operation middleware
+func (siw *ServerInterfaceWrapper) ListPipelines(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPipelinesParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "ref" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "ref", r.URL.Query(), &params.Ref)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ref", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sha" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sha", r.URL.Query(), &params.Sha)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sha", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "triggeredBy" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "triggeredBy", r.URL.Query(), &params.TriggeredBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "triggeredBy", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "updatedAfter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updatedAfter", r.URL.Query(), &params.UpdatedAfter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updatedAfter", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "updatedBefore" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "updatedBefore", r.URL.Query(), &params.UpdatedBefore)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "updatedBefore", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "perPage", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPipelines(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelPipeline operation middleware
+func (siw *ServerInterfaceWrapper) CancelPipeline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CancelPipelineParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "pipelineId" -------------
+
+	if paramValue := r.URL.Query().Get("pipelineId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pipelineId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pipelineId", r.URL.Query(), &params.PipelineId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipelineId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelPipeline(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RetryPipeline operation middleware
+func (siw *ServerInterfaceWrapper) RetryPipeline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RetryPipelineParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "pipelineId" -------------
+
+	if paramValue := r.URL.Query().Get("pipelineId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pipelineId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pipelineId", r.URL.Query(), &params.PipelineId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipelineId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "failedOnly" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "failedOnly", r.URL.Query(), &params.FailedOnly)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "failedOnly", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RetryPipeline(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListProviders operation middleware
+func (siw *ServerInterfaceWrapper) ListProviders(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProviders(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPullRequestPipelines operation middleware
+func (siw *ServerInterfaceWrapper) ListPullRequestPipelines(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPullRequestPipelinesParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "pullRequest" -------------
+
+	if paramValue := r.URL.Query().Get("pullRequest"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "pullRequest"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pullRequest", r.URL.Query(), &params.PullRequest)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pullRequest", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "perPage", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPullRequestPipelines(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListPullRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListPullRequests(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPullRequestsParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "owner" -------------
+
+	if paramValue := r.URL.Query().Get("owner"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "owner"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "owner", r.URL.Query(), &params.Owner)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "repoName" -------------
+
+	if paramValue := r.URL.Query().Get("repoName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repoName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repoName", r.URL.Query(), &params.RepoName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repoName", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "perPage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "perPage", r.URL.Query(), &params.PerPage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "perPage", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPullRequests(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRepositories operation middleware
+func (siw *ServerInterfaceWrapper) ListRepositories(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRepositoriesParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "owner" -------------
+
+	if paramValue := r.URL.Query().Get("owner"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "owner"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "owner", r.URL.Query(), &params.Owner)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "repoName" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repoName", r.URL.Query(), &params.RepoName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repoName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRepositories(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRepository operation middleware
+func (siw *ServerInterfaceWrapper) GetRepository(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoryParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "owner" -------------
+
+	if paramValue := r.URL.Query().Get("owner"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "owner"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "owner", r.URL.Query(), &params.Owner)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "repoName" -------------
+
+	if paramValue := r.URL.Query().Get("repoName"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "repoName"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repoName", r.URL.Query(), &params.RepoName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "repoName", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRepository(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TriggerPipeline operation middleware
+func (siw *ServerInterfaceWrapper) TriggerPipeline(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TriggerPipelineParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "project" -------------
+
+	if paramValue := r.URL.Query().Get("project"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "project", r.URL.Query(), &params.Project)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "ref" -------------
+
+	if paramValue := r.URL.Query().Get("ref"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "ref"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "ref", r.URL.Query(), &params.Ref)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ref", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "pipeline" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pipeline", r.URL.Query(), &params.Pipeline)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "pipeline", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "variables" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "variables", r.URL.Query(), &params.Variables)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "variables", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TriggerPipeline(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListUserOrganizations operation middleware
+func (siw *ServerInterfaceWrapper) ListUserOrganizations(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUserOrganizationsParams
+
+	// ------------- Required query parameter "gitServer" -------------
+
+	if paramValue := r.URL.Query().Get("gitServer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "gitServer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "gitServer", r.URL.Query(), &params.GitServer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "gitServer", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUserOrganizations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/branches", wrapper.ListBranches)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/cache/invalidate", wrapper.InvalidateCache)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/commit-statuses", wrapper.ListCommitStatuses)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/commit-statuses", wrapper.CreateCommitStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipeline", wrapper.GetPipeline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipeline-artifacts", wrapper.ListPipelineArtifacts)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipeline-artifacts/download", wrapper.DownloadPipelineArtifact)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipeline-graph", wrapper.GetPipelineGraph)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipeline-job-trace", wrapper.GetPipelineJobTrace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipeline-job-trace/stream", wrapper.StreamPipelineJobTrace)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipeline-job-traces/search", wrapper.SearchPipelineJobTraces)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipeline-jobs", wrapper.ListPipelineJobs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/pipeline-jobs/cancel", wrapper.CancelPipelineJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/pipeline-jobs/play", wrapper.PlayPipelineJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/pipeline-jobs/retry", wrapper.RetryPipelineJob)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipeline-schedule", wrapper.GetPipelineSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/api/v1/pipeline-schedules", wrapper.DeletePipelineSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipeline-schedules", wrapper.ListPipelineSchedules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/pipeline-schedules", wrapper.CreatePipelineSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/pipeline-schedules/run", wrapper.RunPipelineSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/pipeline-schedules/update", wrapper.UpdatePipelineSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipeline-test-report", wrapper.GetPipelineTestReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pipelines", wrapper.ListPipelines)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/pipelines/cancel", wrapper.CancelPipeline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/pipelines/retry", wrapper.RetryPipeline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/providers", wrapper.ListProviders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pull-request-pipelines", wrapper.ListPullRequestPipelines)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/pull-requests", wrapper.ListPullRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/repositories", wrapper.ListRepositories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/repository", wrapper.GetRepository)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/api/v1/trigger-pipeline", wrapper.TriggerPipeline)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/api/v1/user/organizations", wrapper.ListUserOrganizations)
+	})
+
+	return r
+}
+
+type ListBranchesRequestObject struct {
+	Params ListBranchesParams
+}
+
+type ListBranchesResponseObject interface {
+	VisitListBranchesResponse(w http.ResponseWriter) error
+}
+
+type ListBranches200JSONResponse BranchesResponse
+
+func (response ListBranches200JSONResponse) VisitListBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBranches400JSONResponse Error
+
+func (response ListBranches400JSONResponse) VisitListBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBranches401JSONResponse Error
+
+func (response ListBranches401JSONResponse) VisitListBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListBranches500JSONResponse Error
+
+func (response ListBranches500JSONResponse) VisitListBranchesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InvalidateCacheRequestObject struct {
+	Params InvalidateCacheParams
+}
+
+type InvalidateCacheResponseObject interface {
+	VisitInvalidateCacheResponse(w http.ResponseWriter) error
+}
+
+type InvalidateCache200JSONResponse CacheInvalidationResponse
+
+func (response InvalidateCache200JSONResponse) VisitInvalidateCacheResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InvalidateCache400JSONResponse Error
+
+func (response InvalidateCache400JSONResponse) VisitInvalidateCacheResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InvalidateCache500JSONResponse Error
+
+func (response InvalidateCache500JSONResponse) VisitInvalidateCacheResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommitStatusesRequestObject struct {
+	Params ListCommitStatusesParams
+}
+
+type ListCommitStatusesResponseObject interface {
+	VisitListCommitStatusesResponse(w http.ResponseWriter) error
+}
+
+type ListCommitStatuses200JSONResponse CommitStatusesResponse
+
+func (response ListCommitStatuses200JSONResponse) VisitListCommitStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommitStatuses400JSONResponse Error
+
+func (response ListCommitStatuses400JSONResponse) VisitListCommitStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommitStatuses401JSONResponse Error
+
+func (response ListCommitStatuses401JSONResponse) VisitListCommitStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommitStatuses404JSONResponse Error
+
+func (response ListCommitStatuses404JSONResponse) VisitListCommitStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCommitStatuses500JSONResponse Error
+
+func (response ListCommitStatuses500JSONResponse) VisitListCommitStatusesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitStatusRequestObject struct {
+	Params CreateCommitStatusParams
+}
+
+type CreateCommitStatusResponseObject interface {
+	VisitCreateCommitStatusResponse(w http.ResponseWriter) error
+}
+
+type CreateCommitStatus201JSONResponse CommitStatus
+
+func (response CreateCommitStatus201JSONResponse) VisitCreateCommitStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitStatus400JSONResponse Error
+
+func (response CreateCommitStatus400JSONResponse) VisitCreateCommitStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitStatus401JSONResponse Error
+
+func (response CreateCommitStatus401JSONResponse) VisitCreateCommitStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitStatus404JSONResponse Error
+
+func (response CreateCommitStatus404JSONResponse) VisitCreateCommitStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommitStatus500JSONResponse Error
+
+func (response CreateCommitStatus500JSONResponse) VisitCreateCommitStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineRequestObject struct {
+	Params GetPipelineParams
+}
+
+type GetPipelineResponseObject interface {
+	VisitGetPipelineResponse(w http.ResponseWriter) error
+}
+
+type GetPipeline200JSONResponse Pipeline
+
+func (response GetPipeline200JSONResponse) VisitGetPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipeline400JSONResponse Error
+
+func (response GetPipeline400JSONResponse) VisitGetPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipeline401JSONResponse Error
+
+func (response GetPipeline401JSONResponse) VisitGetPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipeline404JSONResponse Error
+
+func (response GetPipeline404JSONResponse) VisitGetPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipeline500JSONResponse Error
+
+func (response GetPipeline500JSONResponse) VisitGetPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineArtifactsRequestObject struct {
+	Params ListPipelineArtifactsParams
+}
+
+type ListPipelineArtifactsResponseObject interface {
+	VisitListPipelineArtifactsResponse(w http.ResponseWriter) error
+}
+
+type ListPipelineArtifacts200JSONResponse PipelineArtifactsResponse
+
+func (response ListPipelineArtifacts200JSONResponse) VisitListPipelineArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineArtifacts400JSONResponse Error
+
+func (response ListPipelineArtifacts400JSONResponse) VisitListPipelineArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineArtifacts401JSONResponse Error
+
+func (response ListPipelineArtifacts401JSONResponse) VisitListPipelineArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineArtifacts404JSONResponse Error
+
+func (response ListPipelineArtifacts404JSONResponse) VisitListPipelineArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineArtifacts500JSONResponse Error
+
+func (response ListPipelineArtifacts500JSONResponse) VisitListPipelineArtifactsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadPipelineArtifactRequestObject struct {
+	Params DownloadPipelineArtifactParams
+}
+
+type DownloadPipelineArtifactResponseObject interface {
+	VisitDownloadPipelineArtifactResponse(w http.ResponseWriter) error
+}
+
+type DownloadPipelineArtifact200ResponseHeaders struct {
+	ContentDisposition string
+}
+
+type DownloadPipelineArtifact200ApplicationoctetStreamResponse struct {
+	Body          io.Reader
+	Headers       DownloadPipelineArtifact200ResponseHeaders
+	ContentLength int64
+}
+
+func (response DownloadPipelineArtifact200ApplicationoctetStreamResponse) VisitDownloadPipelineArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/octet-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.Header().Set("Content-Disposition", fmt.Sprint(response.Headers.ContentDisposition))
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type DownloadPipelineArtifact400JSONResponse Error
+
+func (response DownloadPipelineArtifact400JSONResponse) VisitDownloadPipelineArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadPipelineArtifact401JSONResponse Error
+
+func (response DownloadPipelineArtifact401JSONResponse) VisitDownloadPipelineArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadPipelineArtifact404JSONResponse Error
+
+func (response DownloadPipelineArtifact404JSONResponse) VisitDownloadPipelineArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DownloadPipelineArtifact500JSONResponse Error
+
+func (response DownloadPipelineArtifact500JSONResponse) VisitDownloadPipelineArtifactResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineGraphRequestObject struct {
+	Params GetPipelineGraphParams
+}
+
+type GetPipelineGraphResponseObject interface {
+	VisitGetPipelineGraphResponse(w http.ResponseWriter) error
+}
+
+type GetPipelineGraph200JSONResponse PipelineGraphNode
+
+func (response GetPipelineGraph200JSONResponse) VisitGetPipelineGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineGraph400JSONResponse Error
+
+func (response GetPipelineGraph400JSONResponse) VisitGetPipelineGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineGraph401JSONResponse Error
+
+func (response GetPipelineGraph401JSONResponse) VisitGetPipelineGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineGraph404JSONResponse Error
+
+func (response GetPipelineGraph404JSONResponse) VisitGetPipelineGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineGraph500JSONResponse Error
+
+func (response GetPipelineGraph500JSONResponse) VisitGetPipelineGraphResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineJobTraceRequestObject struct {
+	Params GetPipelineJobTraceParams
+}
+
+type GetPipelineJobTraceResponseObject interface {
+	VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error
+}
+
+type GetPipelineJobTrace200JSONResponse PipelineJobTraceResponse
+
+func (response GetPipelineJobTrace200JSONResponse) VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineJobTrace400JSONResponse Error
+
+func (response GetPipelineJobTrace400JSONResponse) VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineJobTrace401JSONResponse Error
+
+func (response GetPipelineJobTrace401JSONResponse) VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineJobTrace404JSONResponse Error
+
+func (response GetPipelineJobTrace404JSONResponse) VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineJobTrace500JSONResponse Error
+
+func (response GetPipelineJobTrace500JSONResponse) VisitGetPipelineJobTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamPipelineJobTraceRequestObject struct {
+	Params StreamPipelineJobTraceParams
+}
+
+type StreamPipelineJobTraceResponseObject interface {
+	VisitStreamPipelineJobTraceResponse(w http.ResponseWriter) error
+}
+
+type StreamPipelineJobTrace200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamPipelineJobTrace200TexteventStreamResponse) VisitStreamPipelineJobTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamPipelineJobTrace400JSONResponse Error
+
+func (response StreamPipelineJobTrace400JSONResponse) VisitStreamPipelineJobTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamPipelineJobTrace401JSONResponse Error
+
+func (response StreamPipelineJobTrace401JSONResponse) VisitStreamPipelineJobTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamPipelineJobTrace404JSONResponse Error
+
+func (response StreamPipelineJobTrace404JSONResponse) VisitStreamPipelineJobTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamPipelineJobTrace500JSONResponse Error
+
+func (response StreamPipelineJobTrace500JSONResponse) VisitStreamPipelineJobTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPipelineJobTracesRequestObject struct {
+	Params SearchPipelineJobTracesParams
+}
+
+type SearchPipelineJobTracesResponseObject interface {
+	VisitSearchPipelineJobTracesResponse(w http.ResponseWriter) error
+}
+
+type SearchPipelineJobTraces200JSONResponse PipelineJobTraceSearchResponse
+
+func (response SearchPipelineJobTraces200JSONResponse) VisitSearchPipelineJobTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPipelineJobTraces400JSONResponse Error
+
+func (response SearchPipelineJobTraces400JSONResponse) VisitSearchPipelineJobTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPipelineJobTraces401JSONResponse Error
+
+func (response SearchPipelineJobTraces401JSONResponse) VisitSearchPipelineJobTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPipelineJobTraces404JSONResponse Error
+
+func (response SearchPipelineJobTraces404JSONResponse) VisitSearchPipelineJobTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchPipelineJobTraces500JSONResponse Error
+
+func (response SearchPipelineJobTraces500JSONResponse) VisitSearchPipelineJobTracesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineJobsRequestObject struct {
+	Params ListPipelineJobsParams
+}
+
+type ListPipelineJobsResponseObject interface {
+	VisitListPipelineJobsResponse(w http.ResponseWriter) error
+}
+
+type ListPipelineJobs200JSONResponse PipelineJobsResponse
+
+func (response ListPipelineJobs200JSONResponse) VisitListPipelineJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineJobs400JSONResponse Error
+
+func (response ListPipelineJobs400JSONResponse) VisitListPipelineJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineJobs401JSONResponse Error
+
+func (response ListPipelineJobs401JSONResponse) VisitListPipelineJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineJobs404JSONResponse Error
+
+func (response ListPipelineJobs404JSONResponse) VisitListPipelineJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineJobs500JSONResponse Error
+
+func (response ListPipelineJobs500JSONResponse) VisitListPipelineJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelPipelineJobRequestObject struct {
+	Params CancelPipelineJobParams
+}
+
+type CancelPipelineJobResponseObject interface {
+	VisitCancelPipelineJobResponse(w http.ResponseWriter) error
+}
+
+type CancelPipelineJob200JSONResponse PipelineJob
+
+func (response CancelPipelineJob200JSONResponse) VisitCancelPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelPipelineJob400JSONResponse Error
+
+func (response CancelPipelineJob400JSONResponse) VisitCancelPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelPipelineJob401JSONResponse Error
+
+func (response CancelPipelineJob401JSONResponse) VisitCancelPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelPipelineJob404JSONResponse Error
+
+func (response CancelPipelineJob404JSONResponse) VisitCancelPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelPipelineJob500JSONResponse Error
+
+func (response CancelPipelineJob500JSONResponse) VisitCancelPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlayPipelineJobRequestObject struct {
+	Params PlayPipelineJobParams
+}
+
+type PlayPipelineJobResponseObject interface {
+	VisitPlayPipelineJobResponse(w http.ResponseWriter) error
+}
+
+type PlayPipelineJob200JSONResponse PipelineJob
+
+func (response PlayPipelineJob200JSONResponse) VisitPlayPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlayPipelineJob400JSONResponse Error
+
+func (response PlayPipelineJob400JSONResponse) VisitPlayPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlayPipelineJob401JSONResponse Error
+
+func (response PlayPipelineJob401JSONResponse) VisitPlayPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlayPipelineJob404JSONResponse Error
+
+func (response PlayPipelineJob404JSONResponse) VisitPlayPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PlayPipelineJob500JSONResponse Error
+
+func (response PlayPipelineJob500JSONResponse) VisitPlayPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPipelineJobRequestObject struct {
+	Params RetryPipelineJobParams
+}
+
+type RetryPipelineJobResponseObject interface {
+	VisitRetryPipelineJobResponse(w http.ResponseWriter) error
+}
+
+type RetryPipelineJob200JSONResponse PipelineJob
+
+func (response RetryPipelineJob200JSONResponse) VisitRetryPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPipelineJob400JSONResponse Error
+
+func (response RetryPipelineJob400JSONResponse) VisitRetryPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPipelineJob401JSONResponse Error
+
+func (response RetryPipelineJob401JSONResponse) VisitRetryPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPipelineJob404JSONResponse Error
+
+func (response RetryPipelineJob404JSONResponse) VisitRetryPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPipelineJob500JSONResponse Error
+
+func (response RetryPipelineJob500JSONResponse) VisitRetryPipelineJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineScheduleRequestObject struct {
+	Params GetPipelineScheduleParams
+}
+
+type GetPipelineScheduleResponseObject interface {
+	VisitGetPipelineScheduleResponse(w http.ResponseWriter) error
+}
+
+type GetPipelineSchedule200JSONResponse PipelineSchedule
+
+func (response GetPipelineSchedule200JSONResponse) VisitGetPipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineSchedule400JSONResponse Error
+
+func (response GetPipelineSchedule400JSONResponse) VisitGetPipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineSchedule401JSONResponse Error
+
+func (response GetPipelineSchedule401JSONResponse) VisitGetPipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineSchedule404JSONResponse Error
+
+func (response GetPipelineSchedule404JSONResponse) VisitGetPipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineSchedule500JSONResponse Error
+
+func (response GetPipelineSchedule500JSONResponse) VisitGetPipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePipelineScheduleRequestObject struct {
+	Params DeletePipelineScheduleParams
+}
+
+type DeletePipelineScheduleResponseObject interface {
+	VisitDeletePipelineScheduleResponse(w http.ResponseWriter) error
+}
+
+type DeletePipelineSchedule204Response struct {
+}
+
+func (response DeletePipelineSchedule204Response) VisitDeletePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeletePipelineSchedule400JSONResponse Error
+
+func (response DeletePipelineSchedule400JSONResponse) VisitDeletePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePipelineSchedule401JSONResponse Error
+
+func (response DeletePipelineSchedule401JSONResponse) VisitDeletePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePipelineSchedule404JSONResponse Error
+
+func (response DeletePipelineSchedule404JSONResponse) VisitDeletePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePipelineSchedule500JSONResponse Error
+
+func (response DeletePipelineSchedule500JSONResponse) VisitDeletePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineSchedulesRequestObject struct {
+	Params ListPipelineSchedulesParams
+}
+
+type ListPipelineSchedulesResponseObject interface {
+	VisitListPipelineSchedulesResponse(w http.ResponseWriter) error
+}
+
+type ListPipelineSchedules200JSONResponse PipelineSchedulesResponse
+
+func (response ListPipelineSchedules200JSONResponse) VisitListPipelineSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineSchedules400JSONResponse Error
+
+func (response ListPipelineSchedules400JSONResponse) VisitListPipelineSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineSchedules401JSONResponse Error
+
+func (response ListPipelineSchedules401JSONResponse) VisitListPipelineSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineSchedules404JSONResponse Error
+
+func (response ListPipelineSchedules404JSONResponse) VisitListPipelineSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelineSchedules500JSONResponse Error
+
+func (response ListPipelineSchedules500JSONResponse) VisitListPipelineSchedulesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePipelineScheduleRequestObject struct {
+	Params CreatePipelineScheduleParams
+}
+
+type CreatePipelineScheduleResponseObject interface {
+	VisitCreatePipelineScheduleResponse(w http.ResponseWriter) error
+}
+
+type CreatePipelineSchedule201JSONResponse PipelineSchedule
+
+func (response CreatePipelineSchedule201JSONResponse) VisitCreatePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePipelineSchedule400JSONResponse Error
+
+func (response CreatePipelineSchedule400JSONResponse) VisitCreatePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePipelineSchedule401JSONResponse Error
+
+func (response CreatePipelineSchedule401JSONResponse) VisitCreatePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePipelineSchedule404JSONResponse Error
+
+func (response CreatePipelineSchedule404JSONResponse) VisitCreatePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePipelineSchedule500JSONResponse Error
+
+func (response CreatePipelineSchedule500JSONResponse) VisitCreatePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunPipelineScheduleRequestObject struct {
+	Params RunPipelineScheduleParams
+}
+
+type RunPipelineScheduleResponseObject interface {
+	VisitRunPipelineScheduleResponse(w http.ResponseWriter) error
+}
+
+type RunPipelineSchedule202Response struct {
+}
+
+func (response RunPipelineSchedule202Response) VisitRunPipelineScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type RunPipelineSchedule400JSONResponse Error
+
+func (response RunPipelineSchedule400JSONResponse) VisitRunPipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunPipelineSchedule401JSONResponse Error
+
+func (response RunPipelineSchedule401JSONResponse) VisitRunPipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunPipelineSchedule404JSONResponse Error
+
+func (response RunPipelineSchedule404JSONResponse) VisitRunPipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunPipelineSchedule500JSONResponse Error
+
+func (response RunPipelineSchedule500JSONResponse) VisitRunPipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePipelineScheduleRequestObject struct {
+	Params UpdatePipelineScheduleParams
+}
+
+type UpdatePipelineScheduleResponseObject interface {
+	VisitUpdatePipelineScheduleResponse(w http.ResponseWriter) error
+}
+
+type UpdatePipelineSchedule200JSONResponse PipelineSchedule
+
+func (response UpdatePipelineSchedule200JSONResponse) VisitUpdatePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePipelineSchedule400JSONResponse Error
+
+func (response UpdatePipelineSchedule400JSONResponse) VisitUpdatePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePipelineSchedule401JSONResponse Error
+
+func (response UpdatePipelineSchedule401JSONResponse) VisitUpdatePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePipelineSchedule404JSONResponse Error
+
+func (response UpdatePipelineSchedule404JSONResponse) VisitUpdatePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePipelineSchedule500JSONResponse Error
+
+func (response UpdatePipelineSchedule500JSONResponse) VisitUpdatePipelineScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineTestReportRequestObject struct {
+	Params GetPipelineTestReportParams
+}
+
+type GetPipelineTestReportResponseObject interface {
+	VisitGetPipelineTestReportResponse(w http.ResponseWriter) error
+}
+
+type GetPipelineTestReport200JSONResponse PipelineTestReport
+
+func (response GetPipelineTestReport200JSONResponse) VisitGetPipelineTestReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineTestReport400JSONResponse Error
+
+func (response GetPipelineTestReport400JSONResponse) VisitGetPipelineTestReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineTestReport401JSONResponse Error
+
+func (response GetPipelineTestReport401JSONResponse) VisitGetPipelineTestReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineTestReport404JSONResponse Error
+
+func (response GetPipelineTestReport404JSONResponse) VisitGetPipelineTestReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPipelineTestReport500JSONResponse Error
+
+func (response GetPipelineTestReport500JSONResponse) VisitGetPipelineTestReportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelinesRequestObject struct {
+	Params ListPipelinesParams
+}
+
+type ListPipelinesResponseObject interface {
+	VisitListPipelinesResponse(w http.ResponseWriter) error
+}
+
+type ListPipelines200JSONResponse PipelinesResponse
+
+func (response ListPipelines200JSONResponse) VisitListPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelines400JSONResponse Error
+
+func (response ListPipelines400JSONResponse) VisitListPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelines401JSONResponse Error
+
+func (response ListPipelines401JSONResponse) VisitListPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelines404JSONResponse Error
+
+func (response ListPipelines404JSONResponse) VisitListPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPipelines500JSONResponse Error
+
+func (response ListPipelines500JSONResponse) VisitListPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelPipelineRequestObject struct {
+	Params CancelPipelineParams
+}
+
+type CancelPipelineResponseObject interface {
+	VisitCancelPipelineResponse(w http.ResponseWriter) error
+}
+
+type CancelPipeline200JSONResponse PipelineActionResponse
+
+func (response CancelPipeline200JSONResponse) VisitCancelPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelPipeline400JSONResponse Error
+
+func (response CancelPipeline400JSONResponse) VisitCancelPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelPipeline401JSONResponse Error
+
+func (response CancelPipeline401JSONResponse) VisitCancelPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelPipeline404JSONResponse Error
+
+func (response CancelPipeline404JSONResponse) VisitCancelPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelPipeline500JSONResponse Error
+
+func (response CancelPipeline500JSONResponse) VisitCancelPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPipelineRequestObject struct {
+	Params RetryPipelineParams
+}
+
+type RetryPipelineResponseObject interface {
+	VisitRetryPipelineResponse(w http.ResponseWriter) error
+}
+
+type RetryPipeline200JSONResponse PipelineActionResponse
+
+func (response RetryPipeline200JSONResponse) VisitRetryPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPipeline400JSONResponse Error
+
+func (response RetryPipeline400JSONResponse) VisitRetryPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPipeline401JSONResponse Error
+
+func (response RetryPipeline401JSONResponse) VisitRetryPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPipeline404JSONResponse Error
+
+func (response RetryPipeline404JSONResponse) VisitRetryPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RetryPipeline500JSONResponse Error
+
+func (response RetryPipeline500JSONResponse) VisitRetryPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListProvidersRequestObject struct {
+}
+
+type ListProvidersResponseObject interface {
+	VisitListProvidersResponse(w http.ResponseWriter) error
+}
+
+type ListProviders200JSONResponse ProvidersResponse
+
+func (response ListProviders200JSONResponse) VisitListProvidersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequestPipelinesRequestObject struct {
+	Params ListPullRequestPipelinesParams
+}
+
+type ListPullRequestPipelinesResponseObject interface {
+	VisitListPullRequestPipelinesResponse(w http.ResponseWriter) error
+}
+
+type ListPullRequestPipelines200JSONResponse PipelinesResponse
+
+func (response ListPullRequestPipelines200JSONResponse) VisitListPullRequestPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequestPipelines400JSONResponse Error
+
+func (response ListPullRequestPipelines400JSONResponse) VisitListPullRequestPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequestPipelines401JSONResponse Error
+
+func (response ListPullRequestPipelines401JSONResponse) VisitListPullRequestPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequestPipelines404JSONResponse Error
+
+func (response ListPullRequestPipelines404JSONResponse) VisitListPullRequestPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequestPipelines500JSONResponse Error
+
+func (response ListPullRequestPipelines500JSONResponse) VisitListPullRequestPipelinesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequestsRequestObject struct {
+	Params ListPullRequestsParams
+}
+
+type ListPullRequestsResponseObject interface {
+	VisitListPullRequestsResponse(w http.ResponseWriter) error
+}
+
+type ListPullRequests200JSONResponse PullRequestsResponse
+
+func (response ListPullRequests200JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequests400JSONResponse Error
+
+func (response ListPullRequests400JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequests401JSONResponse Error
+
+func (response ListPullRequests401JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequests404JSONResponse Error
+
+func (response ListPullRequests404JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListPullRequests500JSONResponse Error
+
+func (response ListPullRequests500JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRepositoriesRequestObject struct {
+	Params ListRepositoriesParams
+}
+
+type ListRepositoriesResponseObject interface {
+	VisitListRepositoriesResponse(w http.ResponseWriter) error
+}
+
+type ListRepositories200JSONResponse RepositoriesResponse
+
+func (response ListRepositories200JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRepositories400JSONResponse Error
+
+func (response ListRepositories400JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRepositories401JSONResponse Error
+
+func (response ListRepositories401JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRepositories500JSONResponse Error
+
+func (response ListRepositories500JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryRequestObject struct {
+	Params GetRepositoryParams
+}
+
+type GetRepositoryResponseObject interface {
+	VisitGetRepositoryResponse(w http.ResponseWriter) error
+}
+
+type GetRepository200JSONResponse Repository
+
+func (response GetRepository200JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepository400JSONResponse Error
+
+func (response GetRepository400JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepository404JSONResponse Error
+
+func (response GetRepository404JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerPipelineRequestObject struct {
+	Params TriggerPipelineParams
+}
+
+type TriggerPipelineResponseObject interface {
+	VisitTriggerPipelineResponse(w http.ResponseWriter) error
+}
+
+type TriggerPipeline201JSONResponse PipelineResponse
+
+func (response TriggerPipeline201JSONResponse) VisitTriggerPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerPipeline202JSONResponse PipelineResponse
+
+func (response TriggerPipeline202JSONResponse) VisitTriggerPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerPipeline400JSONResponse Error
+
+func (response TriggerPipeline400JSONResponse) VisitTriggerPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerPipeline401JSONResponse Error
+
+func (response TriggerPipeline401JSONResponse) VisitTriggerPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerPipeline404JSONResponse Error
+
+func (response TriggerPipeline404JSONResponse) VisitTriggerPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TriggerPipeline500JSONResponse Error
+
+func (response TriggerPipeline500JSONResponse) VisitTriggerPipelineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserOrganizationsRequestObject struct {
+	Params ListUserOrganizationsParams
+}
+
+type ListUserOrganizationsResponseObject interface {
+	VisitListUserOrganizationsResponse(w http.ResponseWriter) error
+}
+
+type ListUserOrganizations200JSONResponse OrganizationsResponse
+
+func (response ListUserOrganizations200JSONResponse) VisitListUserOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserOrganizations400JSONResponse Error
+
+func (response ListUserOrganizations400JSONResponse) VisitListUserOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserOrganizations401JSONResponse Error
+
+func (response ListUserOrganizations401JSONResponse) VisitListUserOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUserOrganizations500JSONResponse Error
+
+func (response ListUserOrganizations500JSONResponse) VisitListUserOrganizationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List branches for a repository
+	// (GET /api/v1/branches)
+	ListBranches(ctx context.Context, request ListBranchesRequestObject) (ListBranchesResponseObject, error)
+	// Invalidate cache for a specific endpoint
+	// (DELETE /api/v1/cache/invalidate)
+	InvalidateCache(ctx context.Context, request InvalidateCacheRequestObject) (InvalidateCacheResponseObject, error)
+	// List the statuses reported for a commit
+	// (GET /api/v1/commit-statuses)
+	ListCommitStatuses(ctx context.Context, request ListCommitStatusesRequestObject) (ListCommitStatusesResponseObject, error)
+	// Report a status for a commit
+	// (POST /api/v1/commit-statuses)
+	CreateCommitStatus(ctx context.Context, request CreateCommitStatusRequestObject) (CreateCommitStatusResponseObject, error)
+	// Get a single CI/CD pipeline
+	// (GET /api/v1/pipeline)
+	GetPipeline(ctx context.Context, request GetPipelineRequestObject) (GetPipelineResponseObject, error)
+	// List the artifacts of a CI/CD pipeline
+	// (GET /api/v1/pipeline-artifacts)
+	ListPipelineArtifacts(ctx context.Context, request ListPipelineArtifactsRequestObject) (ListPipelineArtifactsResponseObject, error)
+	// Download a CI/CD pipeline artifact
+	// (GET /api/v1/pipeline-artifacts/download)
+	DownloadPipelineArtifact(ctx context.Context, request DownloadPipelineArtifactRequestObject) (DownloadPipelineArtifactResponseObject, error)
+	// Get the graph of a CI/CD pipeline and its downstream pipelines
+	// (GET /api/v1/pipeline-graph)
+	GetPipelineGraph(ctx context.Context, request GetPipelineGraphRequestObject) (GetPipelineGraphResponseObject, error)
+	// Get the trace (log) of a CI/CD pipeline job
+	// (GET /api/v1/pipeline-job-trace)
+	GetPipelineJobTrace(ctx context.Context, request GetPipelineJobTraceRequestObject) (GetPipelineJobTraceResponseObject, error)
+	// Stream the trace (log) of a running CI/CD pipeline job
+	// (GET /api/v1/pipeline-job-trace/stream)
+	StreamPipelineJobTrace(ctx context.Context, request StreamPipelineJobTraceRequestObject) (StreamPipelineJobTraceResponseObject, error)
+	// Search the traces (logs) of every job of a CI/CD pipeline
+	// (GET /api/v1/pipeline-job-traces/search)
+	SearchPipelineJobTraces(ctx context.Context, request SearchPipelineJobTracesRequestObject) (SearchPipelineJobTracesResponseObject, error)
+	// List jobs for a CI/CD pipeline
+	// (GET /api/v1/pipeline-jobs)
+	ListPipelineJobs(ctx context.Context, request ListPipelineJobsRequestObject) (ListPipelineJobsResponseObject, error)
+	// Cancel a running CI/CD job
+	// (POST /api/v1/pipeline-jobs/cancel)
+	CancelPipelineJob(ctx context.Context, request CancelPipelineJobRequestObject) (CancelPipelineJobResponseObject, error)
+	// Start a manual CI/CD job
+	// (POST /api/v1/pipeline-jobs/play)
+	PlayPipelineJob(ctx context.Context, request PlayPipelineJobRequestObject) (PlayPipelineJobResponseObject, error)
+	// Retry a finished CI/CD job
+	// (POST /api/v1/pipeline-jobs/retry)
+	RetryPipelineJob(ctx context.Context, request RetryPipelineJobRequestObject) (RetryPipelineJobResponseObject, error)
+	// Get a pipeline schedule
+	// (GET /api/v1/pipeline-schedule)
+	GetPipelineSchedule(ctx context.Context, request GetPipelineScheduleRequestObject) (GetPipelineScheduleResponseObject, error)
+	// Delete a pipeline schedule
+	// (DELETE /api/v1/pipeline-schedules)
+	DeletePipelineSchedule(ctx context.Context, request DeletePipelineScheduleRequestObject) (DeletePipelineScheduleResponseObject, error)
+	// List the pipeline schedules of a project
+	// (GET /api/v1/pipeline-schedules)
+	ListPipelineSchedules(ctx context.Context, request ListPipelineSchedulesRequestObject) (ListPipelineSchedulesResponseObject, error)
+	// Create a pipeline schedule
+	// (POST /api/v1/pipeline-schedules)
+	CreatePipelineSchedule(ctx context.Context, request CreatePipelineScheduleRequestObject) (CreatePipelineScheduleResponseObject, error)
+	// Run a pipeline schedule now
+	// (POST /api/v1/pipeline-schedules/run)
+	RunPipelineSchedule(ctx context.Context, request RunPipelineScheduleRequestObject) (RunPipelineScheduleResponseObject, error)
+	// Update a pipeline schedule
+	// (POST /api/v1/pipeline-schedules/update)
+	UpdatePipelineSchedule(ctx context.Context, request UpdatePipelineScheduleRequestObject) (UpdatePipelineScheduleResponseObject, error)
+	// Get the test report of a CI/CD pipeline
+	// (GET /api/v1/pipeline-test-report)
+	GetPipelineTestReport(ctx context.Context, request GetPipelineTestReportRequestObject) (GetPipelineTestReportResponseObject, error)
+	// List CI/CD pipelines for a project
+	// (GET /api/v1/pipelines)
+	ListPipelines(ctx context.Context, request ListPipelinesRequestObject) (ListPipelinesResponseObject, error)
+	// Cancel a running CI/CD pipeline
+	// (POST /api/v1/pipelines/cancel)
+	CancelPipeline(ctx context.Context, request CancelPipelineRequestObject) (CancelPipelineResponseObject, error)
+	// Retry a finished CI/CD pipeline
+	// (POST /api/v1/pipelines/retry)
+	RetryPipeline(ctx context.Context, request RetryPipelineRequestObject) (RetryPipelineResponseObject, error)
+	// List supported git providers and their capabilities
+	// (GET /api/v1/providers)
+	ListProviders(ctx context.Context, request ListProvidersRequestObject) (ListProvidersResponseObject, error)
+	// List the CI/CD pipelines of a pull request
+	// (GET /api/v1/pull-request-pipelines)
+	ListPullRequestPipelines(ctx context.Context, request ListPullRequestPipelinesRequestObject) (ListPullRequestPipelinesResponseObject, error)
+	// List pull/merge requests for a repository
+	// (GET /api/v1/pull-requests)
+	ListPullRequests(ctx context.Context, request ListPullRequestsRequestObject) (ListPullRequestsResponseObject, error)
+	// List repositories
+	// (GET /api/v1/repositories)
+	ListRepositories(ctx context.Context, request ListRepositoriesRequestObject) (ListRepositoriesResponseObject, error)
+	// Get detailed information for a specific repository
+	// (GET /api/v1/repository)
+	GetRepository(ctx context.Context, request GetRepositoryRequestObject) (GetRepositoryResponseObject, error)
+	// Trigger a CI/CD pipeline
+	// (POST /api/v1/trigger-pipeline)
+	TriggerPipeline(ctx context.Context, request TriggerPipelineRequestObject) (TriggerPipelineResponseObject, error)
+	// List organizations for the authenticated user
+	// (GET /api/v1/user/organizations)
+	ListUserOrganizations(ctx context.Context, request ListUserOrganizationsRequestObject) (ListUserOrganizationsResponseObject, error)
+}
+
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListBranches operation middleware
+func (sh *strictHandler) ListBranches(w http.ResponseWriter, r *http.Request, params ListBranchesParams) {
+	var request ListBranchesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListBranches(ctx, request.(ListBranchesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListBranches")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListBranchesResponseObject); ok {
+		if err := validResponse.VisitListBranchesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// InvalidateCache operation middleware
+func (sh *strictHandler) InvalidateCache(w http.ResponseWriter, r *http.Request, params InvalidateCacheParams) {
+	var request InvalidateCacheRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.InvalidateCache(ctx, request.(InvalidateCacheRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "InvalidateCache")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(InvalidateCacheResponseObject); ok {
+		if err := validResponse.VisitInvalidateCacheResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCommitStatuses operation middleware
+func (sh *strictHandler) ListCommitStatuses(w http.ResponseWriter, r *http.Request, params ListCommitStatusesParams) {
+	var request ListCommitStatusesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCommitStatuses(ctx, request.(ListCommitStatusesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCommitStatuses")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCommitStatusesResponseObject); ok {
+		if err := validResponse.VisitListCommitStatusesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCommitStatus operation middleware
+func (sh *strictHandler) CreateCommitStatus(w http.ResponseWriter, r *http.Request, params CreateCommitStatusParams) {
+	var request CreateCommitStatusRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCommitStatus(ctx, request.(CreateCommitStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCommitStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCommitStatusResponseObject); ok {
+		if err := validResponse.VisitCreateCommitStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPipeline operation middleware
+func (sh *strictHandler) GetPipeline(w http.ResponseWriter, r *http.Request, params GetPipelineParams) {
+	var request GetPipelineRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPipeline(ctx, request.(GetPipelineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPipeline")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPipelineResponseObject); ok {
+		if err := validResponse.VisitGetPipelineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListPipelines401JSONResponse Error
-
-func (response ListPipelines401JSONResponse) VisitListPipelinesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// ListPipelineArtifacts operation middleware
+func (sh *strictHandler) ListPipelineArtifacts(w http.ResponseWriter, r *http.Request, params ListPipelineArtifactsParams) {
+	var request ListPipelineArtifactsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type ListPipelines404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPipelineArtifacts(ctx, request.(ListPipelineArtifactsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPipelineArtifacts")
+	}
 
-func (response ListPipelines404JSONResponse) VisitListPipelinesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPipelineArtifactsResponseObject); ok {
+		if err := validResponse.VisitListPipelineArtifactsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListPipelines500JSONResponse Error
+// DownloadPipelineArtifact operation middleware
+func (sh *strictHandler) DownloadPipelineArtifact(w http.ResponseWriter, r *http.Request, params DownloadPipelineArtifactParams) {
+	var request DownloadPipelineArtifactRequestObject
 
-func (response ListPipelines500JSONResponse) VisitListPipelinesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DownloadPipelineArtifact(ctx, request.(DownloadPipelineArtifactRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DownloadPipelineArtifact")
+	}
 
-type ListPullRequestsRequestObject struct {
-	Params ListPullRequestsParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListPullRequestsResponseObject interface {
-	VisitListPullRequestsResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DownloadPipelineArtifactResponseObject); ok {
+		if err := validResponse.VisitDownloadPipelineArtifactResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListPullRequests200JSONResponse PullRequestsResponse
-
-func (response ListPullRequests200JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetPipelineGraph operation middleware
+func (sh *strictHandler) GetPipelineGraph(w http.ResponseWriter, r *http.Request, params GetPipelineGraphParams) {
+	var request GetPipelineGraphRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type ListPullRequests400JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPipelineGraph(ctx, request.(GetPipelineGraphRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPipelineGraph")
+	}
 
-func (response ListPullRequests400JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPipelineGraphResponseObject); ok {
+		if err := validResponse.VisitGetPipelineGraphResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListPullRequests401JSONResponse Error
-
-func (response ListPullRequests401JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetPipelineJobTrace operation middleware
+func (sh *strictHandler) GetPipelineJobTrace(w http.ResponseWriter, r *http.Request, params GetPipelineJobTraceParams) {
+	var request GetPipelineJobTraceRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type ListPullRequests404JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPipelineJobTrace(ctx, request.(GetPipelineJobTraceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPipelineJobTrace")
+	}
 
-func (response ListPullRequests404JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPipelineJobTraceResponseObject); ok {
+		if err := validResponse.VisitGetPipelineJobTraceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListPullRequests500JSONResponse Error
+// StreamPipelineJobTrace operation middleware
+func (sh *strictHandler) StreamPipelineJobTrace(w http.ResponseWriter, r *http.Request, params StreamPipelineJobTraceParams) {
+	var request StreamPipelineJobTraceRequestObject
 
-func (response ListPullRequests500JSONResponse) VisitListPullRequestsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamPipelineJobTrace(ctx, request.(StreamPipelineJobTraceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamPipelineJobTrace")
+	}
 
-type ListRepositoriesRequestObject struct {
-	Params ListRepositoriesParams
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type ListRepositoriesResponseObject interface {
-	VisitListRepositoriesResponse(w http.ResponseWriter) error
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamPipelineJobTraceResponseObject); ok {
+		if err := validResponse.VisitStreamPipelineJobTraceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListRepositories200JSONResponse RepositoriesResponse
-
-func (response ListRepositories200JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// SearchPipelineJobTraces operation middleware
+func (sh *strictHandler) SearchPipelineJobTraces(w http.ResponseWriter, r *http.Request, params SearchPipelineJobTracesParams) {
+	var request SearchPipelineJobTracesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type ListRepositories400JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SearchPipelineJobTraces(ctx, request.(SearchPipelineJobTracesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SearchPipelineJobTraces")
+	}
 
-func (response ListRepositories400JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchPipelineJobTracesResponseObject); ok {
+		if err := validResponse.VisitSearchPipelineJobTracesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListRepositories401JSONResponse Error
-
-func (response ListRepositories401JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// ListPipelineJobs operation middleware
+func (sh *strictHandler) ListPipelineJobs(w http.ResponseWriter, r *http.Request, params ListPipelineJobsParams) {
+	var request ListPipelineJobsRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type ListRepositories500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPipelineJobs(ctx, request.(ListPipelineJobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPipelineJobs")
+	}
 
-func (response ListRepositories500JSONResponse) VisitListRepositoriesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPipelineJobsResponseObject); ok {
+		if err := validResponse.VisitListPipelineJobsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetRepositoryRequestObject struct {
-	Params GetRepositoryParams
-}
+// CancelPipelineJob operation middleware
+func (sh *strictHandler) CancelPipelineJob(w http.ResponseWriter, r *http.Request, params CancelPipelineJobParams) {
+	var request CancelPipelineJobRequestObject
 
-type GetRepositoryResponseObject interface {
-	VisitGetRepositoryResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type GetRepository200JSONResponse Repository
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelPipelineJob(ctx, request.(CancelPipelineJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelPipelineJob")
+	}
 
-func (response GetRepository200JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelPipelineJobResponseObject); ok {
+		if err := validResponse.VisitCancelPipelineJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetRepository400JSONResponse Error
+// PlayPipelineJob operation middleware
+func (sh *strictHandler) PlayPipelineJob(w http.ResponseWriter, r *http.Request, params PlayPipelineJobParams) {
+	var request PlayPipelineJobRequestObject
 
-func (response GetRepository400JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PlayPipelineJob(ctx, request.(PlayPipelineJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PlayPipelineJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PlayPipelineJobResponseObject); ok {
+		if err := validResponse.VisitPlayPipelineJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetRepository404JSONResponse Error
+// RetryPipelineJob operation middleware
+func (sh *strictHandler) RetryPipelineJob(w http.ResponseWriter, r *http.Request, params RetryPipelineJobParams) {
+	var request RetryPipelineJobRequestObject
 
-func (response GetRepository404JSONResponse) VisitGetRepositoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RetryPipelineJob(ctx, request.(RetryPipelineJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RetryPipelineJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RetryPipelineJobResponseObject); ok {
+		if err := validResponse.VisitRetryPipelineJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type TriggerPipelineRequestObject struct {
-	Params TriggerPipelineParams
-}
+// GetPipelineSchedule operation middleware
+func (sh *strictHandler) GetPipelineSchedule(w http.ResponseWriter, r *http.Request, params GetPipelineScheduleParams) {
+	var request GetPipelineScheduleRequestObject
 
-type TriggerPipelineResponseObject interface {
-	VisitTriggerPipelineResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type TriggerPipeline201JSONResponse PipelineResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPipelineSchedule(ctx, request.(GetPipelineScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPipelineSchedule")
+	}
 
-func (response TriggerPipeline201JSONResponse) VisitTriggerPipelineResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPipelineScheduleResponseObject); ok {
+		if err := validResponse.VisitGetPipelineScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type TriggerPipeline400JSONResponse Error
-
-func (response TriggerPipeline400JSONResponse) VisitTriggerPipelineResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// DeletePipelineSchedule operation middleware
+func (sh *strictHandler) DeletePipelineSchedule(w http.ResponseWriter, r *http.Request, params DeletePipelineScheduleParams) {
+	var request DeletePipelineScheduleRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type TriggerPipeline401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeletePipelineSchedule(ctx, request.(DeletePipelineScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeletePipelineSchedule")
+	}
 
-func (response TriggerPipeline401JSONResponse) VisitTriggerPipelineResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeletePipelineScheduleResponseObject); ok {
+		if err := validResponse.VisitDeletePipelineScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type TriggerPipeline404JSONResponse Error
-
-func (response TriggerPipeline404JSONResponse) VisitTriggerPipelineResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// ListPipelineSchedules operation middleware
+func (sh *strictHandler) ListPipelineSchedules(w http.ResponseWriter, r *http.Request, params ListPipelineSchedulesParams) {
+	var request ListPipelineSchedulesRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type TriggerPipeline500JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListPipelineSchedules(ctx, request.(ListPipelineSchedulesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListPipelineSchedules")
+	}
 
-func (response TriggerPipeline500JSONResponse) VisitTriggerPipelineResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPipelineSchedulesResponseObject); ok {
+		if err := validResponse.VisitListPipelineSchedulesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListUserOrganizationsRequestObject struct {
-	Params ListUserOrganizationsParams
-}
+// CreatePipelineSchedule operation middleware
+func (sh *strictHandler) CreatePipelineSchedule(w http.ResponseWriter, r *http.Request, params CreatePipelineScheduleParams) {
+	var request CreatePipelineScheduleRequestObject
 
-type ListUserOrganizationsResponseObject interface {
-	VisitListUserOrganizationsResponse(w http.ResponseWriter) error
-}
+	request.Params = params
 
-type ListUserOrganizations200JSONResponse OrganizationsResponse
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreatePipelineSchedule(ctx, request.(CreatePipelineScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreatePipelineSchedule")
+	}
 
-func (response ListUserOrganizations200JSONResponse) VisitListUserOrganizationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreatePipelineScheduleResponseObject); ok {
+		if err := validResponse.VisitCreatePipelineScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListUserOrganizations400JSONResponse Error
-
-func (response ListUserOrganizations400JSONResponse) VisitListUserOrganizationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+// RunPipelineSchedule operation middleware
+func (sh *strictHandler) RunPipelineSchedule(w http.ResponseWriter, r *http.Request, params RunPipelineScheduleParams) {
+	var request RunPipelineScheduleRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type ListUserOrganizations401JSONResponse Error
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RunPipelineSchedule(ctx, request.(RunPipelineScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RunPipelineSchedule")
+	}
 
-func (response ListUserOrganizations401JSONResponse) VisitListUserOrganizationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	response, err := handler(r.Context(), w, r, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RunPipelineScheduleResponseObject); ok {
+		if err := validResponse.VisitRunPipelineScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type ListUserOrganizations500JSONResponse Error
+// UpdatePipelineSchedule operation middleware
+func (sh *strictHandler) UpdatePipelineSchedule(w http.ResponseWriter, r *http.Request, params UpdatePipelineScheduleParams) {
+	var request UpdatePipelineScheduleRequestObject
 
-func (response ListUserOrganizations500JSONResponse) VisitListUserOrganizationsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	request.Params = params
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdatePipelineSchedule(ctx, request.(UpdatePipelineScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdatePipelineSchedule")
+	}
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List branches for a repository
-	// (GET /api/v1/branches)
-	ListBranches(ctx context.Context, request ListBranchesRequestObject) (ListBranchesResponseObject, error)
-	// Invalidate cache for a specific endpoint
-	// (DELETE /api/v1/cache/invalidate)
-	InvalidateCache(ctx context.Context, request InvalidateCacheRequestObject) (InvalidateCacheResponseObject, error)
-	// Get the trace (log) of a CI/CD pipeline job
-	// (GET /api/v1/pipeline-job-trace)
-	GetPipelineJobTrace(ctx context.Context, request GetPipelineJobTraceRequestObject) (GetPipelineJobTraceResponseObject, error)
-	// List jobs for a CI/CD pipeline
-	// (GET /api/v1/pipeline-jobs)
-	ListPipelineJobs(ctx context.Context, request ListPipelineJobsRequestObject) (ListPipelineJobsResponseObject, error)
-	// List CI/CD pipelines for a project
-	// (GET /api/v1/pipelines)
-	ListPipelines(ctx context.Context, request ListPipelinesRequestObject) (ListPipelinesResponseObject, error)
-	// List pull/merge requests for a repository
-	// (GET /api/v1/pull-requests)
-	ListPullRequests(ctx context.Context, request ListPullRequestsRequestObject) (ListPullRequestsResponseObject, error)
-	// List repositories
-	// (GET /api/v1/repositories)
-	ListRepositories(ctx context.Context, request ListRepositoriesRequestObject) (ListRepositoriesResponseObject, error)
-	// Get detailed information for a specific repository
-	// (GET /api/v1/repository)
-	GetRepository(ctx context.Context, request GetRepositoryRequestObject) (GetRepositoryResponseObject, error)
-	// Trigger a CI/CD pipeline
-	// (POST /api/v1/trigger-pipeline)
-	TriggerPipeline(ctx context.Context, request TriggerPipelineRequestObject) (TriggerPipelineResponseObject, error)
-	// List organizations for the authenticated user
-	// (GET /api/v1/user/organizations)
-	ListUserOrganizations(ctx context.Context, request ListUserOrganizationsRequestObject) (ListUserOrganizationsResponseObject, error)
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdatePipelineScheduleResponseObject); ok {
+		if err := validResponse.VisitUpdatePipelineScheduleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+// GetPipelineTestReport operation middleware
+func (sh *strictHandler) GetPipelineTestReport(w http.ResponseWriter, r *http.Request, params GetPipelineTestReportParams) {
+	var request GetPipelineTestReportRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPipelineTestReport(ctx, request.(GetPipelineTestReportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPipelineTestReport")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetPipelineTestReportResponseObject); ok {
+		if err := validResponse.VisitGetPipelineTestReportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// ListBranches operation middleware
-func (sh *strictHandler) ListBranches(w http.ResponseWriter, r *http.Request, params ListBranchesParams) {
-	var request ListBranchesRequestObject
+// ListPipelines operation middleware
+func (sh *strictHandler) ListPipelines(w http.ResponseWriter, r *http.Request, params ListPipelinesParams) {
+	var request ListPipelinesRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListBranches(ctx, request.(ListBranchesRequestObject))
+		return sh.ssi.ListPipelines(ctx, request.(ListPipelinesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListBranches")
+		handler = middleware(handler, "ListPipelines")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListBranchesResponseObject); ok {
-		if err := validResponse.VisitListBranchesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPipelinesResponseObject); ok {
+		if err := validResponse.VisitListPipelinesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1447,25 +5215,25 @@ func (sh *strictHandler) ListBranches(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// InvalidateCache operation middleware
-func (sh *strictHandler) InvalidateCache(w http.ResponseWriter, r *http.Request, params InvalidateCacheParams) {
-	var request InvalidateCacheRequestObject
+// CancelPipeline operation middleware
+func (sh *strictHandler) CancelPipeline(w http.ResponseWriter, r *http.Request, params CancelPipelineParams) {
+	var request CancelPipelineRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.InvalidateCache(ctx, request.(InvalidateCacheRequestObject))
+		return sh.ssi.CancelPipeline(ctx, request.(CancelPipelineRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "InvalidateCache")
+		handler = middleware(handler, "CancelPipeline")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(InvalidateCacheResponseObject); ok {
-		if err := validResponse.VisitInvalidateCacheResponse(w); err != nil {
+	} else if validResponse, ok := response.(CancelPipelineResponseObject); ok {
+		if err := validResponse.VisitCancelPipelineResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1473,25 +5241,25 @@ func (sh *strictHandler) InvalidateCache(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetPipelineJobTrace operation middleware
-func (sh *strictHandler) GetPipelineJobTrace(w http.ResponseWriter, r *http.Request, params GetPipelineJobTraceParams) {
-	var request GetPipelineJobTraceRequestObject
+// RetryPipeline operation middleware
+func (sh *strictHandler) RetryPipeline(w http.ResponseWriter, r *http.Request, params RetryPipelineParams) {
+	var request RetryPipelineRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPipelineJobTrace(ctx, request.(GetPipelineJobTraceRequestObject))
+		return sh.ssi.RetryPipeline(ctx, request.(RetryPipelineRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPipelineJobTrace")
+		handler = middleware(handler, "RetryPipeline")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetPipelineJobTraceResponseObject); ok {
-		if err := validResponse.VisitGetPipelineJobTraceResponse(w); err != nil {
+	} else if validResponse, ok := response.(RetryPipelineResponseObject); ok {
+		if err := validResponse.VisitRetryPipelineResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1499,25 +5267,23 @@ func (sh *strictHandler) GetPipelineJobTrace(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// ListPipelineJobs operation middleware
-func (sh *strictHandler) ListPipelineJobs(w http.ResponseWriter, r *http.Request, params ListPipelineJobsParams) {
-	var request ListPipelineJobsRequestObject
-
-	request.Params = params
+// ListProviders operation middleware
+func (sh *strictHandler) ListProviders(w http.ResponseWriter, r *http.Request) {
+	var request ListProvidersRequestObject
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPipelineJobs(ctx, request.(ListPipelineJobsRequestObject))
+		return sh.ssi.ListProviders(ctx, request.(ListProvidersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPipelineJobs")
+		handler = middleware(handler, "ListProviders")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPipelineJobsResponseObject); ok {
-		if err := validResponse.VisitListPipelineJobsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListProvidersResponseObject); ok {
+		if err := validResponse.VisitListProvidersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1525,25 +5291,25 @@ func (sh *strictHandler) ListPipelineJobs(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ListPipelines operation middleware
-func (sh *strictHandler) ListPipelines(w http.ResponseWriter, r *http.Request, params ListPipelinesParams) {
-	var request ListPipelinesRequestObject
+// ListPullRequestPipelines operation middleware
+func (sh *strictHandler) ListPullRequestPipelines(w http.ResponseWriter, r *http.Request, params ListPullRequestPipelinesParams) {
+	var request ListPullRequestPipelinesRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPipelines(ctx, request.(ListPipelinesRequestObject))
+		return sh.ssi.ListPullRequestPipelines(ctx, request.(ListPullRequestPipelinesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPipelines")
+		handler = middleware(handler, "ListPullRequestPipelines")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPipelinesResponseObject); ok {
-		if err := validResponse.VisitListPipelinesResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListPullRequestPipelinesResponseObject); ok {
+		if err := validResponse.VisitListPullRequestPipelinesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
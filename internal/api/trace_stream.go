@@ -0,0 +1,122 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+// traceStreamKeepAlive is how often an idle trace stream sends a comment, so proxies do not
+// close a connection that waits for a quiet job.
+const traceStreamKeepAlive = 15 * time.Second
+
+// traceEventStream writes job trace events as Server-Sent Events, flushing after each one. The
+// generated text/event-stream response copies a reader without flushing, so it is not used.
+type traceEventStream struct {
+	events    <-chan models.PipelineJobTraceEvent
+	keepAlive time.Duration
+}
+
+// VisitStreamPipelineJobTraceResponse implements StreamPipelineJobTraceResponseObject. It returns
+// once the events channel is closed, which the service does when the request context ends.
+func (s traceEventStream) VisitStreamPipelineJobTraceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	// Disables response buffering in nginx-based ingresses.
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	rc := http.NewResponseController(w)
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+
+	keepAlive := time.NewTicker(s.keepAlive)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case ev, ok := <-s.events:
+			if !ok {
+				return nil
+			}
+
+			if err := writeTraceEvent(w, ev); err != nil {
+				return err
+			}
+		case <-keepAlive.C:
+			if _, err := io.WriteString(w, ": keep-alive\n\n"); err != nil {
+				return err
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return err
+		}
+	}
+}
+
+// writeTraceEvent writes one event. Trace and end events carry the offset after their data as
+// the event ID, which a reconnecting EventSource sends back as Last-Event-ID.
+func writeTraceEvent(w io.Writer, ev models.PipelineJobTraceEvent) error {
+	var (
+		name string
+		id   string
+		data any
+	)
+
+	switch {
+	case ev.Chunk != nil:
+		name = "trace"
+		id = fmt.Sprintf("%d", ev.Chunk.Offset+int64(len(ev.Chunk.Content)))
+		data = ev.Chunk
+	case ev.End != nil:
+		name = "end"
+		id = fmt.Sprintf("%d", ev.End.Offset)
+		data = ev.End
+	default:
+		name = "error"
+		data = traceStreamError(ev.Err)
+	}
+
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s event: %w", name, err)
+	}
+
+	if id != "" {
+		if _, err := fmt.Fprintf(w, "id: %s\n", id); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", name, payload)
+
+	return err
+}
+
+// traceStreamError converts an error that ended a started stream to the Error model, using the
+// HTTP status the error would have had before the stream started.
+func traceStreamError(err error) models.Error {
+	code := http.StatusInternalServerError
+
+	switch {
+	case errors.Is(err, gferrors.ErrUnauthorized):
+		code = http.StatusUnauthorized
+	case errors.Is(err, gferrors.ErrBadRequest):
+		code = http.StatusBadRequest
+	case errors.Is(err, gferrors.ErrNotFound):
+		code = http.StatusNotFound
+	}
+
+	return models.Error{
+		Code:    fmt.Sprintf("%d", code),
+		Message: err.Error(),
+	}
+}
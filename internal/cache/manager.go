@@ -10,13 +10,14 @@ import (
 
 // Manager provides centralized cache management for all cache instances.
 type Manager struct {
-	repositoryCache   *sturdyc.Client[[]models.Repository]
-	organizationCache *sturdyc.Client[[]models.Organization]
-	branchCache       *sturdyc.Client[[]models.Branch]
-	pullRequestCache  *sturdyc.Client[models.PullRequestsResponse]
-	pipelineCache     *sturdyc.Client[models.PipelinesResponse]
-	pipelineJobsCache *sturdyc.Client[[]models.PipelineJob]
-	pipelineJobTrace  *TerminalAwareCache[JobTrace]
+	repositoryCache    *sturdyc.Client[[]models.Repository]
+	organizationCache  *sturdyc.Client[[]models.Organization]
+	branchCache        *sturdyc.Client[[]models.Branch]
+	pullRequestCache   *sturdyc.Client[models.PullRequestsResponse]
+	pipelineCache      *sturdyc.Client[models.PipelinesResponse]
+	pipelineJobsCache  *sturdyc.Client[[]models.PipelineJob]
+	pipelineJobTrace   *TerminalAwareCache[JobTrace]
+	pipelineTestReport *TerminalAwareCache[models.PipelineTestReport]
 }
 
 // NewManager creates a new cache manager with all cache instances.
@@ -28,15 +29,17 @@ func NewManager(
 	pipelineCache *sturdyc.Client[models.PipelinesResponse],
 	pipelineJobsCache *sturdyc.Client[[]models.PipelineJob],
 	pipelineJobTrace *TerminalAwareCache[JobTrace],
+	pipelineTestReport *TerminalAwareCache[models.PipelineTestReport],
 ) *Manager {
 	return &Manager{
-		repositoryCache:   repositoryCache,
-		organizationCache: organizationCache,
-		branchCache:       branchCache,
-		pullRequestCache:  pullRequestCache,
-		pipelineCache:     pipelineCache,
-		pipelineJobsCache: pipelineJobsCache,
-		pipelineJobTrace:  pipelineJobTrace,
+		repositoryCache:    repositoryCache,
+		organizationCache:  organizationCache,
+		branchCache:        branchCache,
+		pullRequestCache:   pullRequestCache,
+		pipelineCache:      pipelineCache,
+		pipelineJobsCache:  pipelineJobsCache,
+		pipelineJobTrace:   pipelineJobTrace,
+		pipelineTestReport: pipelineTestReport,
 	}
 }
 
@@ -82,6 +85,7 @@ func (m *Manager) InvalidateCache(endpoint string) error {
 		}
 
 		m.pipelineJobTrace.Invalidate()
+		m.pipelineTestReport.Invalidate()
 
 		return nil
 	default:
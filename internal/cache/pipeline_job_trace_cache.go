@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/viccon/sturdyc"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
 )
 
 // MaxCacheableTraceBytes caps which traces are cached; larger or truncated traces bypass the cache.
@@ -20,6 +22,9 @@ const (
 type JobTrace struct {
 	Content   string
 	Truncated bool
+
+	// Structure is the parsed trace, kept once it has been requested for a finished job.
+	Structure *models.PipelineJobTraceStructure
 }
 
 func NewPipelineJobTraceCache() *TerminalAwareCache[JobTrace] {
@@ -0,0 +1,25 @@
+package cache
+
+import (
+	"github.com/viccon/sturdyc"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+// Test-report tiers: reports of running pipelines cached briefly (live), of finished pipelines
+// long (done), like job traces. A finished pipeline retried through GitFusion is evicted; one
+// retried elsewhere keeps its old report until the done TTL or a cache invalidation.
+const (
+	testReportLiveSize = 50
+	testReportDoneSize = 200
+)
+
+func NewPipelineTestReportCache() *TerminalAwareCache[models.PipelineTestReport] {
+	numShards := 4
+	evictionPercentage := 20
+
+	live := sturdyc.New[models.PipelineTestReport](testReportLiveSize, numShards, traceLiveTTL, evictionPercentage)
+	done := sturdyc.New[models.PipelineTestReport](testReportDoneSize, numShards, traceDoneTTL, evictionPercentage)
+
+	return NewTerminalAwareCache(live, done)
+}
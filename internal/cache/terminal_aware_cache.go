@@ -34,6 +34,12 @@ func (c *TerminalAwareCache[T]) Set(key string, value T, terminal bool) {
 	c.live.Set(key, value)
 }
 
+// Delete removes key from both tiers, e.g. when a finished object becomes live again.
+func (c *TerminalAwareCache[T]) Delete(key string) {
+	c.done.Delete(key)
+	c.live.Delete(key)
+}
+
 func (c *TerminalAwareCache[T]) Invalidate() {
 	for _, key := range c.done.ScanKeys() {
 		c.done.Delete(key)
@@ -46,3 +46,19 @@ func TestTerminalAwareCache_InvalidateClearsBothTiers(t *testing.T) {
 	_, ok = c.Get("done")
 	assert.False(t, ok, "done tier should be cleared")
 }
+
+func TestTerminalAwareCache_DeleteRemovesKeyFromBothTiers(t *testing.T) {
+	c := NewTerminalAwareCache(newStringTier(), newStringTier())
+
+	c.Set("job", "live", false)
+	c.Set("job", "done", true)
+	c.Set("other", "v", true)
+
+	c.Delete("job")
+
+	_, ok := c.Get("job")
+	assert.False(t, ok, "key should be removed from both tiers")
+
+	_, ok = c.Get("other")
+	assert.True(t, ok, "other keys should be kept")
+}
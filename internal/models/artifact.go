@@ -0,0 +1,11 @@
+package models
+
+import "io"
+
+// ArtifactDownload is an artifact being downloaded from a provider. The caller must close Body.
+type ArtifactDownload struct {
+	Body        io.ReadCloser
+	Name        string // File name to offer the client
+	Size        int64  // Size in bytes; -1 when the provider did not report it
+	ContentType string // Content type reported by the provider; empty when unknown
+}
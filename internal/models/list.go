@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 type ListOptions struct {
 	Name *string
 }
@@ -11,8 +13,51 @@ type PullRequestListOptions struct {
 }
 
 type PipelineListOptions struct {
-	Ref     *string // Filter by branch/tag ref
-	Status  *string // Filter by normalized status
-	Page    int
-	PerPage int
+	Ref           *string    // Filter by branch/tag ref
+	Status        *string    // Filter by normalized status
+	Sha           *string    // Filter by commit SHA
+	Source        *string    // Filter by normalized source
+	TriggeredBy   *string    // Filter by the username of the user who triggered the pipeline
+	UpdatedAfter  *time.Time // Only pipelines last updated at or after this time
+	UpdatedBefore *time.Time // Only pipelines last updated at or before this time
+	Ascending     bool       // Oldest first instead of newest first
+	Page          int
+	PerPage       int
+}
+
+type PipelineTriggerOptions struct {
+	Ref       string  // Branch, tag, or commit to run the pipeline for
+	Pipeline  *string // Named pipeline definition to run instead of the default one
+	Variables []PipelineVariable
+}
+
+type PipelineArtifactListOptions struct {
+	JobID *string // Only the artifacts of this job of the pipeline
+}
+
+type PipelineRetryOptions struct {
+	FailedOnly bool // Re-run only the failed jobs instead of the whole pipeline
+}
+
+type PipelineJobPlayOptions struct {
+	Variables []PipelineVariable // Job variables for the manual job
+}
+
+// PipelineScheduleOptions holds the fields to create a pipeline schedule with or, for an update,
+// the fields to change; nil fields are left as they are.
+type PipelineScheduleOptions struct {
+	Description  *string
+	Ref          *string
+	Cron         *string
+	CronTimezone *string
+	Active       *bool
+	Variables    []PipelineVariable // Replaces the schedule's variables when non-nil; empty removes them all
+}
+
+// CommitStatusOptions holds the status to report for a commit.
+type CommitStatusOptions struct {
+	State       CommitState
+	Context     string
+	Description *string
+	TargetURL   *string
 }
@@ -7,6 +7,22 @@ import (
 	"time"
 )
 
+// Defines values for CommitState.
+const (
+	CommitStateCancelled CommitState = "cancelled"
+	CommitStateFailed    CommitState = "failed"
+	CommitStatePending   CommitState = "pending"
+	CommitStateRunning   CommitState = "running"
+	CommitStateSkipped   CommitState = "skipped"
+	CommitStateSuccess   CommitState = "success"
+)
+
+// Defines values for CommitStatusKind.
+const (
+	CommitStatusKindCheckRun CommitStatusKind = "check_run"
+	CommitStatusKindStatus   CommitStatusKind = "status"
+)
+
 // Defines values for PipelineSource.
 const (
 	PipelineSourceManual       PipelineSource = "manual"
@@ -28,12 +44,78 @@ const (
 	PipelineStatusSuccess   PipelineStatus = "success"
 )
 
+// Defines values for PipelineActionResponseAction.
+const (
+	PipelineActionCancel PipelineActionResponseAction = "cancel"
+	PipelineActionRetry  PipelineActionResponseAction = "retry"
+)
+
+// Defines values for PipelineArtifactScope.
+const (
+	PipelineArtifactScopeJob        PipelineArtifactScope = "job"
+	PipelineArtifactScopePipeline   PipelineArtifactScope = "pipeline"
+	PipelineArtifactScopeRepository PipelineArtifactScope = "repository"
+)
+
+// Defines values for PipelineGraphNodeKind.
+const (
+	Child            PipelineGraphNodeKind = "child"
+	MultiProject     PipelineGraphNodeKind = "multi_project"
+	ReusableWorkflow PipelineGraphNodeKind = "reusable_workflow"
+	Root             PipelineGraphNodeKind = "root"
+)
+
+// Defines values for PipelineScheduleLastPipelineStatus.
+const (
+	PipelineScheduleLastPipelineStatusCancelled PipelineScheduleLastPipelineStatus = "cancelled"
+	PipelineScheduleLastPipelineStatusFailed    PipelineScheduleLastPipelineStatus = "failed"
+	PipelineScheduleLastPipelineStatusManual    PipelineScheduleLastPipelineStatus = "manual"
+	PipelineScheduleLastPipelineStatusPending   PipelineScheduleLastPipelineStatus = "pending"
+	PipelineScheduleLastPipelineStatusRunning   PipelineScheduleLastPipelineStatus = "running"
+	PipelineScheduleLastPipelineStatusSkipped   PipelineScheduleLastPipelineStatus = "skipped"
+	PipelineScheduleLastPipelineStatusSuccess   PipelineScheduleLastPipelineStatus = "success"
+)
+
+// Defines values for PipelineTestCaseStatus.
+const (
+	PipelineTestCaseStatusError   PipelineTestCaseStatus = "error"
+	PipelineTestCaseStatusFailed  PipelineTestCaseStatus = "failed"
+	PipelineTestCaseStatusSkipped PipelineTestCaseStatus = "skipped"
+	PipelineTestCaseStatusSuccess PipelineTestCaseStatus = "success"
+)
+
 // Defines values for PipelineVariableVariableType.
 const (
 	EnvVar PipelineVariableVariableType = "env_var"
 	File   PipelineVariableVariableType = "file"
 )
 
+// Defines values for ProviderCapability.
+const (
+	ProviderCapabilityBranches                ProviderCapability = "branches"
+	ProviderCapabilityCommitStatusCreate      ProviderCapability = "commitStatusCreate"
+	ProviderCapabilityCommitStatuses          ProviderCapability = "commitStatuses"
+	ProviderCapabilityOrganizations           ProviderCapability = "organizations"
+	ProviderCapabilityPipelineArtifacts       ProviderCapability = "pipelineArtifacts"
+	ProviderCapabilityPipelineCancel          ProviderCapability = "pipelineCancel"
+	ProviderCapabilityPipelineDetail          ProviderCapability = "pipelineDetail"
+	ProviderCapabilityPipelineGraph           ProviderCapability = "pipelineGraph"
+	ProviderCapabilityPipelineJobCancel       ProviderCapability = "pipelineJobCancel"
+	ProviderCapabilityPipelineJobPlay         ProviderCapability = "pipelineJobPlay"
+	ProviderCapabilityPipelineJobRetry        ProviderCapability = "pipelineJobRetry"
+	ProviderCapabilityPipelineJobTraceStream  ProviderCapability = "pipelineJobTraceStream"
+	ProviderCapabilityPipelineJobTraceWindow  ProviderCapability = "pipelineJobTraceWindow"
+	ProviderCapabilityPipelineJobs            ProviderCapability = "pipelineJobs"
+	ProviderCapabilityPipelineRetry           ProviderCapability = "pipelineRetry"
+	ProviderCapabilityPipelineScheduleActions ProviderCapability = "pipelineScheduleActions"
+	ProviderCapabilityPipelineSchedules       ProviderCapability = "pipelineSchedules"
+	ProviderCapabilityPipelineTestReport      ProviderCapability = "pipelineTestReport"
+	ProviderCapabilityPipelines               ProviderCapability = "pipelines"
+	ProviderCapabilityPullRequestPipelines    ProviderCapability = "pullRequestPipelines"
+	ProviderCapabilityPullRequests            ProviderCapability = "pullRequests"
+	ProviderCapabilityRepositories            ProviderCapability = "repositories"
+)
+
 // Defines values for PullRequestState.
 const (
 	PullRequestStateClosed PullRequestState = "closed"
@@ -55,11 +137,32 @@ const (
 
 // Defines values for InvalidateCacheParamsEndpoint.
 const (
-	Branches      InvalidateCacheParamsEndpoint = "branches"
-	Organizations InvalidateCacheParamsEndpoint = "organizations"
-	Pipelines     InvalidateCacheParamsEndpoint = "pipelines"
-	Pullrequests  InvalidateCacheParamsEndpoint = "pullrequests"
-	Repositories  InvalidateCacheParamsEndpoint = "repositories"
+	InvalidateCacheParamsEndpointBranches      InvalidateCacheParamsEndpoint = "branches"
+	InvalidateCacheParamsEndpointOrganizations InvalidateCacheParamsEndpoint = "organizations"
+	InvalidateCacheParamsEndpointPipelines     InvalidateCacheParamsEndpoint = "pipelines"
+	InvalidateCacheParamsEndpointPullrequests  InvalidateCacheParamsEndpoint = "pullrequests"
+	InvalidateCacheParamsEndpointRepositories  InvalidateCacheParamsEndpoint = "repositories"
+)
+
+// Defines values for CreateCommitStatusParamsState.
+const (
+	CreateCommitStatusStateCancelled CreateCommitStatusParamsState = "cancelled"
+	CreateCommitStatusStateFailed    CreateCommitStatusParamsState = "failed"
+	CreateCommitStatusStatePending   CreateCommitStatusParamsState = "pending"
+	CreateCommitStatusStateRunning   CreateCommitStatusParamsState = "running"
+	CreateCommitStatusStateSuccess   CreateCommitStatusParamsState = "success"
+)
+
+// Defines values for GetPipelineJobTraceParamsFormat.
+const (
+	PipelineJobTraceFormatRaw        GetPipelineJobTraceParamsFormat = "raw"
+	PipelineJobTraceFormatStructured GetPipelineJobTraceParamsFormat = "structured"
+)
+
+// Defines values for GetPipelineJobTraceParamsAnsi.
+const (
+	PipelineJobTraceAnsiSpans GetPipelineJobTraceParamsAnsi = "spans"
+	PipelineJobTraceAnsiStrip GetPipelineJobTraceParamsAnsi = "strip"
 )
 
 // Defines values for ListPipelinesParamsStatus.
@@ -73,6 +176,22 @@ const (
 	Success   ListPipelinesParamsStatus = "success"
 )
 
+// Defines values for ListPipelinesParamsSource.
+const (
+	ListPipelinesSourceManual       ListPipelinesParamsSource = "manual"
+	ListPipelinesSourceMergeRequest ListPipelinesParamsSource = "merge_request"
+	ListPipelinesSourceOther        ListPipelinesParamsSource = "other"
+	ListPipelinesSourcePush         ListPipelinesParamsSource = "push"
+	ListPipelinesSourceSchedule     ListPipelinesParamsSource = "schedule"
+	ListPipelinesSourceTrigger      ListPipelinesParamsSource = "trigger"
+)
+
+// Defines values for ListPipelinesParamsSort.
+const (
+	ListPipelinesSortAsc  ListPipelinesParamsSort = "asc"
+	ListPipelinesSortDesc ListPipelinesParamsSort = "desc"
+)
+
 // Defines values for ListPullRequestsParamsState.
 const (
 	ListPullRequestsParamsStateAll    ListPullRequestsParamsState = "all"
@@ -100,6 +219,42 @@ type CacheInvalidationResponse struct {
 	Message string `json:"message"`
 }
 
+// CommitState Normalized state of a commit status or check run
+type CommitState string
+
+// CommitStatus defines model for CommitStatus.
+type CommitStatus struct {
+	// Context Status context (GitHub), name (GitLab) or key (Bitbucket), or the check run name
+	Context   string     `json:"context"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Description Status description, or the check run's output title
+	Description *string `json:"description,omitempty"`
+
+	// Kind A commit status, or a GitHub check run
+	Kind CommitStatusKind `json:"kind"`
+
+	// State Normalized state of a commit status or check run
+	State CommitState `json:"state"`
+
+	// TargetUrl Link to the build that reported the status
+	TargetUrl *string    `json:"target_url,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// CommitStatusKind A commit status, or a GitHub check run
+type CommitStatusKind string
+
+// CommitStatusesResponse defines model for CommitStatusesResponse.
+type CommitStatusesResponse struct {
+	// Sha Commit SHA
+	Sha string `json:"sha"`
+
+	// State Normalized state of a commit status or check run
+	State    CommitState    `json:"state"`
+	Statuses []CommitStatus `json:"statuses"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	// Code A short error code representing the type of error
@@ -137,14 +292,25 @@ type Pagination struct {
 
 // Pipeline defines model for Pipeline.
 type Pipeline struct {
+	// CommitTitle First line of the commit message (pipeline detail only)
+	CommitTitle *string `json:"commit_title,omitempty"`
+
+	// Coverage Code coverage percentage, when the provider reports it (pipeline detail only)
+	Coverage  *float32  `json:"coverage,omitempty"`
 	CreatedAt time.Time `json:"created_at"`
 
+	// Duration Run time in seconds, excluding the time spent queued (pipeline detail only)
+	Duration *float32 `json:"duration,omitempty"`
+
 	// Id Pipeline ID (string to accommodate different providers)
 	Id string `json:"id"`
 
 	// ProjectId Project/repository ID
 	ProjectId *string `json:"project_id,omitempty"`
 
+	// QueuedDuration Time in seconds the pipeline waited before it started (pipeline detail only)
+	QueuedDuration *float32 `json:"queued_duration,omitempty"`
+
 	// Ref Branch/tag ref
 	Ref string `json:"ref"`
 
@@ -154,9 +320,13 @@ type Pipeline struct {
 	// Source What triggered the pipeline
 	Source *PipelineSource `json:"source,omitempty"`
 
+	// Stages Stages in execution order with their status aggregated from the jobs (pipeline detail only)
+	Stages *[]PipelineStage `json:"stages,omitempty"`
+
 	// Status Normalized pipeline status
-	Status    PipelineStatus `json:"status"`
-	UpdatedAt *time.Time     `json:"updated_at,omitempty"`
+	Status      PipelineStatus `json:"status"`
+	TriggeredBy *Owner         `json:"triggered_by,omitempty"`
+	UpdatedAt   *time.Time     `json:"updated_at,omitempty"`
 
 	// WebUrl URL to view pipeline in provider UI
 	WebUrl string `json:"web_url"`
@@ -168,6 +338,103 @@ type PipelineSource string
 // PipelineStatus Normalized pipeline status
 type PipelineStatus string
 
+// PipelineActionResponse defines model for PipelineActionResponse.
+type PipelineActionResponse struct {
+	// Action The action that was applied
+	Action PipelineActionResponseAction `json:"action"`
+
+	// PipelineId ID of the pipeline the action was applied to
+	PipelineId string `json:"pipeline_id"`
+
+	// Status Normalized pipeline status (see Pipeline.status) after the action, when the provider
+	// reports it synchronously
+	Status *string `json:"status,omitempty"`
+
+	// WebUrl URL to view the pipeline in the provider UI, when the provider reports it
+	WebUrl *string `json:"web_url,omitempty"`
+}
+
+// PipelineActionResponseAction The action that was applied
+type PipelineActionResponseAction string
+
+// PipelineArtifact defines model for PipelineArtifact.
+type PipelineArtifact struct {
+	// CreatedAt When the artifact was created, when reported
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Expired Whether the artifact has expired and can no longer be downloaded
+	Expired bool `json:"expired"`
+
+	// ExpiresAt When the artifact expires, when it does
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Id Artifact ID to download the artifact by
+	Id string `json:"id"`
+
+	// JobId ID of the job that produced the artifact (GitLab)
+	JobId *string `json:"job_id,omitempty"`
+
+	// JobName Name of the job that produced the artifact (GitLab)
+	JobName *string `json:"job_name,omitempty"`
+
+	// Name Artifact name
+	Name string `json:"name"`
+
+	// Scope What produced the artifact: a job of the pipeline (GitLab), the pipeline as a whole
+	// (GitHub), or nothing linked to the pipeline, as for the repository Downloads (Bitbucket)
+	Scope PipelineArtifactScope `json:"scope"`
+
+	// Size Artifact size in bytes, when reported
+	Size *int64 `json:"size,omitempty"`
+}
+
+// PipelineArtifactScope What produced the artifact: a job of the pipeline (GitLab), the pipeline as a whole
+// (GitHub), or nothing linked to the pipeline, as for the repository Downloads (Bitbucket)
+type PipelineArtifactScope string
+
+// PipelineArtifactsResponse defines model for PipelineArtifactsResponse.
+type PipelineArtifactsResponse struct {
+	Data []PipelineArtifact `json:"data"`
+}
+
+// PipelineGraphNode defines model for PipelineGraphNode.
+type PipelineGraphNode struct {
+	// Children Downstream pipelines and reusable-workflow calls started by the node
+	Children []PipelineGraphNode `json:"children"`
+
+	// Jobs Jobs of the node, including bridge jobs, in execution order
+	Jobs []PipelineJob `json:"jobs"`
+
+	// Kind How the node relates to its parent: the requested pipeline (root), a GitLab child
+	// pipeline in the same project (child), a GitLab pipeline in another project
+	// (multi_project) or the jobs of a GitHub reusable-workflow call (reusable_workflow)
+	Kind PipelineGraphNodeKind `json:"kind"`
+
+	// Name Name of the bridge job or reusable-workflow call that started the node (all but root)
+	Name     *string   `json:"name,omitempty"`
+	Pipeline *Pipeline `json:"pipeline,omitempty"`
+
+	// Project Project the node's pipeline belongs to
+	Project string `json:"project"`
+
+	// Status Normalized status (see Pipeline.status) aggregated over the node and its descendants
+	Status string `json:"status"`
+
+	// TriggerJobId ID of the bridge job in the parent pipeline that started the node (GitLab)
+	TriggerJobId *string `json:"trigger_job_id,omitempty"`
+
+	// Truncated Whether the node started pipelines the graph leaves out because of the depth limit
+	Truncated bool `json:"truncated"`
+
+	// Workflow Path of the called reusable workflow, when the calling workflow file names it (GitHub)
+	Workflow *string `json:"workflow,omitempty"`
+}
+
+// PipelineGraphNodeKind How the node relates to its parent: the requested pipeline (root), a GitLab child
+// pipeline in the same project (child), a GitLab pipeline in another project
+// (multi_project) or the jobs of a GitHub reusable-workflow call (reusable_workflow)
+type PipelineGraphNodeKind string
+
 // PipelineJob defines model for PipelineJob.
 type PipelineJob struct {
 	// AllowFailure Whether the job is allowed to fail without failing the pipeline
@@ -187,12 +454,18 @@ type PipelineJob struct {
 	// Name Job name
 	Name string `json:"name"`
 
+	// PipelineId ID of the pipeline the job belongs to
+	PipelineId *string `json:"pipeline_id,omitempty"`
+
 	// Ref Branch/tag ref
 	Ref *string `json:"ref,omitempty"`
 
 	// Stage Pipeline stage the job belongs to
-	Stage     string     `json:"stage"`
-	StartedAt *time.Time `json:"started_at,omitempty"`
+	Stage string `json:"stage"`
+
+	// Stages Stages executed within the job, in order (GitHub Actions steps). Omitted when the provider has none.
+	Stages    *[]PipelineJobStage `json:"stages,omitempty"`
+	StartedAt *time.Time          `json:"started_at,omitempty"`
 
 	// Status Job status (provider-native, e.g. success, failed, running, manual)
 	Status string `json:"status"`
@@ -201,6 +474,54 @@ type PipelineJob struct {
 	WebUrl *string `json:"web_url,omitempty"`
 }
 
+// PipelineJobStage defines model for PipelineJobStage.
+type PipelineJobStage struct {
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+
+	// Name Stage name
+	Name string `json:"name"`
+
+	// Number Position of the stage within the job, starting at 1
+	Number    int        `json:"number"`
+	StartedAt *time.Time `json:"started_at,omitempty"`
+
+	// Status Stage status (provider-native, e.g. success, failure, in_progress)
+	Status string `json:"status"`
+}
+
+// PipelineJobTraceChunk Data of a `trace` event of a job trace stream
+type PipelineJobTraceChunk struct {
+	// Content Trace bytes starting at offset
+	Content string `json:"content"`
+
+	// Offset Byte offset of the first byte of content within the trace
+	Offset int64 `json:"offset"`
+}
+
+// PipelineJobTraceEnd Data of the `end` event of a job trace stream
+type PipelineJobTraceEnd struct {
+	// Offset Total trace size in bytes
+	Offset int64 `json:"offset"`
+
+	// Status Final job status (provider-native, see PipelineJob.status)
+	Status string `json:"status"`
+}
+
+// PipelineJobTraceLine defines model for PipelineJobTraceLine.
+type PipelineJobTraceLine struct {
+	// Number 1-based line number; lines holding only section markers are omitted
+	Number int `json:"number"`
+
+	// Spans The text split into styled spans; omitted for unstyled lines or with ansi=strip
+	Spans *[]PipelineJobTraceSpan `json:"spans,omitempty"`
+
+	// Text Line text without ANSI escapes
+	Text string `json:"text"`
+
+	// Timestamp Time the line was logged, when the provider prefixes lines with it
+	Timestamp *time.Time `json:"timestamp,omitempty"`
+}
+
 // PipelineJobTraceResponse defines model for PipelineJobTraceResponse.
 type PipelineJobTraceResponse struct {
 	// Content Raw job trace (log) text
@@ -209,10 +530,101 @@ type PipelineJobTraceResponse struct {
 	// JobId Job ID the trace belongs to
 	JobId string `json:"job_id"`
 
-	// Truncated Whether the trace was truncated due to size limit
+	// Offset Byte offset of the returned content within the trace
+	Offset *int64 `json:"offset,omitempty"`
+
+	// Structured A job trace parsed into lines and collapsible sections
+	Structured *PipelineJobTraceStructure `json:"structured,omitempty"`
+
+	// TotalSize Size of the whole trace in bytes; omitted when the provider does not report it
+	TotalSize *int64 `json:"total_size,omitempty"`
+
+	// Truncated Whether more trace bytes follow the returned content
 	Truncated *bool `json:"truncated,omitempty"`
 }
 
+// PipelineJobTraceSearchMatch defines model for PipelineJobTraceSearchMatch.
+type PipelineJobTraceSearchMatch struct {
+	// After Lines following the matching line
+	After []string `json:"after"`
+
+	// Before Lines preceding the matching line, nearest last
+	Before []string `json:"before"`
+
+	// JobId ID of the job whose trace has the line
+	JobId string `json:"job_id"`
+
+	// JobName Name of the job whose trace has the line
+	JobName string `json:"job_name"`
+
+	// Line The matching line
+	Line string `json:"line"`
+
+	// LineNumber Number of the matching line within the trace
+	LineNumber int `json:"line_number"`
+}
+
+// PipelineJobTraceSearchResponse defines model for PipelineJobTraceSearchResponse.
+type PipelineJobTraceSearchResponse struct {
+	// JobsSearched Number of job traces searched
+	JobsSearched int `json:"jobs_searched"`
+
+	// Matches Matching lines, in job list order and then line order
+	Matches []PipelineJobTraceSearchMatch `json:"matches"`
+
+	// Truncated Whether more matches exist than were returned
+	Truncated bool `json:"truncated"`
+
+	// TruncatedJobs IDs of the jobs whose trace was only searched up to the 4 MiB read cap
+	TruncatedJobs *[]string `json:"truncated_jobs,omitempty"`
+}
+
+// PipelineJobTraceSection defines model for PipelineJobTraceSection.
+type PipelineJobTraceSection struct {
+	// Collapsed Whether the section should be shown collapsed
+	Collapsed *bool `json:"collapsed,omitempty"`
+
+	// Duration Section duration in seconds, when both ends carry a time
+	Duration *float64 `json:"duration,omitempty"`
+
+	// EndLine Number of the section's last line; the window's last line for an unclosed section
+	EndLine int `json:"end_line"`
+
+	// Header Text of the section's first line, when it has one
+	Header *string `json:"header,omitempty"`
+
+	// Name Section name (GitLab) or group title (GitHub Actions, Azure Pipelines)
+	Name string `json:"name"`
+
+	// Sections Nested sections
+	Sections *[]PipelineJobTraceSection `json:"sections,omitempty"`
+
+	// StartLine Number of the section's first line
+	StartLine int `json:"start_line"`
+}
+
+// PipelineJobTraceSpan defines model for PipelineJobTraceSpan.
+type PipelineJobTraceSpan struct {
+	// Bg Background color, in the same form as fg
+	Bg   *string `json:"bg,omitempty"`
+	Bold *bool   `json:"bold,omitempty"`
+
+	// Fg Foreground color, a name such as "red" or "bright-cyan", or "#rrggbb"
+	Fg        *string `json:"fg,omitempty"`
+	Italic    *bool   `json:"italic,omitempty"`
+	Text      string  `json:"text"`
+	Underline *bool   `json:"underline,omitempty"`
+}
+
+// PipelineJobTraceStructure A job trace parsed into lines and collapsible sections
+type PipelineJobTraceStructure struct {
+	// Lines Trace lines without section markers, ANSI escapes and timestamps
+	Lines []PipelineJobTraceLine `json:"lines"`
+
+	// Sections Top-level sections; lines outside every section belong to none
+	Sections []PipelineJobTraceSection `json:"sections"`
+}
+
 // PipelineJobsResponse defines model for PipelineJobsResponse.
 type PipelineJobsResponse struct {
 	Data []PipelineJob `json:"data"`
@@ -220,8 +632,9 @@ type PipelineJobsResponse struct {
 
 // PipelineResponse defines model for PipelineResponse.
 type PipelineResponse struct {
-	// Id Pipeline ID
-	Id int `json:"id"`
+	// Id Pipeline ID, usable with the pipeline drill-down endpoints. Empty only in the 202
+	// response of a trigger whose created run could not be identified (GitHub and Gitea).
+	Id string `json:"id"`
 
 	// Ref Branch/tag/commit used
 	Ref string `json:"ref"`
@@ -232,15 +645,155 @@ type PipelineResponse struct {
 	// Status Pipeline status
 	Status string `json:"status"`
 
-	// WebUrl URL to view pipeline in GitLab UI
+	// WebUrl URL to view pipeline in the provider UI, or the workflow's runs when id is empty
 	WebUrl string `json:"web_url"`
 }
 
+// PipelineSchedule defines model for PipelineSchedule.
+type PipelineSchedule struct {
+	// Active Whether the schedule runs pipelines (for GitHub, whether its workflow is enabled)
+	Active    bool       `json:"active"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Cron Cron expression of the schedule
+	Cron string `json:"cron"`
+
+	// CronTimezone Time zone of the cron expression (always UTC for GitHub)
+	CronTimezone string `json:"cron_timezone"`
+
+	// Description Schedule description (the workflow name for GitHub)
+	Description string `json:"description"`
+
+	// Id Schedule ID (numeric for GitLab; workflow ID and cron entry index for GitHub)
+	Id string `json:"id"`
+
+	// LastPipeline The pipeline the schedule started last (GitLab)
+	LastPipeline *PipelineScheduleLastPipeline `json:"last_pipeline,omitempty"`
+
+	// NextRunAt When the schedule runs next, when reported (GitLab)
+	NextRunAt *time.Time `json:"next_run_at,omitempty"`
+	Owner     *Owner     `json:"owner,omitempty"`
+
+	// Ref Branch or tag the scheduled pipelines run for (the default branch for GitHub)
+	Ref       string     `json:"ref"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// Variables Variables passed to the scheduled pipelines (GitLab single-schedule reads and writes only)
+	Variables *[]PipelineVariable `json:"variables,omitempty"`
+
+	// Workflow Path of the workflow file that declares the schedule (GitHub)
+	Workflow *string `json:"workflow,omitempty"`
+}
+
+// PipelineScheduleLastPipeline The pipeline the schedule started last (GitLab)
+type PipelineScheduleLastPipeline struct {
+	Id string `json:"id"`
+
+	// Status Normalized pipeline status
+	Status PipelineScheduleLastPipelineStatus `json:"status"`
+	WebUrl *string                            `json:"web_url,omitempty"`
+}
+
+// PipelineScheduleLastPipelineStatus Normalized pipeline status
+type PipelineScheduleLastPipelineStatus string
+
+// PipelineSchedulesResponse defines model for PipelineSchedulesResponse.
+type PipelineSchedulesResponse struct {
+	Data []PipelineSchedule `json:"data"`
+}
+
+// PipelineStage defines model for PipelineStage.
+type PipelineStage struct {
+	// Jobs Number of jobs in the stage
+	Jobs int `json:"jobs"`
+
+	// Name Stage name; jobs without a stage form a stage named after the job
+	Name string `json:"name"`
+
+	// Status Normalized stage status (see Pipeline.status) aggregated from the stage's jobs
+	Status string `json:"status"`
+}
+
+// PipelineTestCase defines model for PipelineTestCase.
+type PipelineTestCase struct {
+	// Classname Class or package of the test case
+	Classname *string `json:"classname,omitempty"`
+
+	// Duration Test case time in seconds
+	Duration *float64 `json:"duration,omitempty"`
+
+	// File File of the test case, when reported
+	File *string `json:"file,omitempty"`
+
+	// Message Failure, error or skip message
+	Message *string `json:"message,omitempty"`
+
+	// Name Test case name
+	Name string `json:"name"`
+
+	// StackTrace Failure or error details, typically a stack trace
+	StackTrace *string `json:"stack_trace,omitempty"`
+
+	// Status Test case result
+	Status PipelineTestCaseStatus `json:"status"`
+
+	// SystemOutput Output the test case wrote
+	SystemOutput *string `json:"system_output,omitempty"`
+}
+
+// PipelineTestCaseStatus Test case result
+type PipelineTestCaseStatus string
+
+// PipelineTestReport defines model for PipelineTestReport.
+type PipelineTestReport struct {
+	// PipelineId ID of the pipeline the report belongs to
+	PipelineId string `json:"pipeline_id"`
+
+	// PipelineStatus Normalized status of the pipeline when the report was read (see Pipeline.status); the
+	// report is final once the pipeline is success, failed, cancelled or skipped
+	PipelineStatus string              `json:"pipeline_status"`
+	Suites         []PipelineTestSuite `json:"suites"`
+	Totals         PipelineTestTotals  `json:"totals"`
+}
+
+// PipelineTestSuite defines model for PipelineTestSuite.
+type PipelineTestSuite struct {
+	Cases []PipelineTestCase `json:"cases"`
+
+	// Name Suite name (the job name for GitLab)
+	Name   string             `json:"name"`
+	Totals PipelineTestTotals `json:"totals"`
+}
+
+// PipelineTestTotals defines model for PipelineTestTotals.
+type PipelineTestTotals struct {
+	// Count Number of test cases
+	Count int `json:"count"`
+
+	// Duration Total test time in seconds
+	Duration float64 `json:"duration"`
+
+	// Error Number of test cases that errored
+	Error int `json:"error"`
+
+	// Failed Number of failed test cases
+	Failed int `json:"failed"`
+
+	// Skipped Number of skipped test cases
+	Skipped int `json:"skipped"`
+
+	// Success Number of passed test cases
+	Success int `json:"success"`
+}
+
 // PipelineVariable defines model for PipelineVariable.
 type PipelineVariable struct {
 	// Key Variable name
 	Key string `json:"key"`
 
+	// Secured Whether the provider should mask the value (Bitbucket secured variables, Azure DevOps secret variables)
+	Secured *bool `json:"secured,omitempty"`
+
 	// Value Variable value
 	Value string `json:"value"`
 
@@ -257,6 +810,22 @@ type PipelinesResponse struct {
 	Pagination Pagination `json:"pagination"`
 }
 
+// Provider defines model for Provider.
+type Provider struct {
+	Capabilities []ProviderCapability `json:"capabilities"`
+
+	// Name Provider name as used in the GitServer gitProvider field
+	Name string `json:"name"`
+}
+
+// ProviderCapability A group of operations a git provider implements
+type ProviderCapability string
+
+// ProvidersResponse defines model for ProvidersResponse.
+type ProvidersResponse struct {
+	Data []Provider `json:"data"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	Author *Owner `json:"author,omitempty"`
@@ -360,6 +929,100 @@ type InvalidateCacheParams struct {
 // InvalidateCacheParamsEndpoint defines parameters for InvalidateCache.
 type InvalidateCacheParamsEndpoint string
 
+// ListCommitStatusesParams defines parameters for ListCommitStatuses.
+type ListCommitStatusesParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// Sha Commit SHA
+	Sha string `form:"sha" json:"sha"`
+}
+
+// CreateCommitStatusParams defines parameters for CreateCommitStatus.
+type CreateCommitStatusParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// Sha Commit SHA
+	Sha string `form:"sha" json:"sha"`
+
+	// State State to report
+	State CreateCommitStatusParamsState `form:"state" json:"state"`
+
+	// Context Name identifying the reporting system (e.g., "tekton/build")
+	Context string `form:"context" json:"context"`
+
+	// Description Short description of the status
+	Description *string `form:"description,omitempty" json:"description,omitempty"`
+
+	// TargetUrl URL of the build the status links to; required for Bitbucket
+	TargetUrl *string `form:"targetUrl,omitempty" json:"targetUrl,omitempty"`
+}
+
+// CreateCommitStatusParamsState defines parameters for CreateCommitStatus.
+type CreateCommitStatusParamsState string
+
+// GetPipelineParams defines parameters for GetPipeline.
+type GetPipelineParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// PipelineId Pipeline ID as returned by the pipelines list (numeric for GitLab/GitHub, a UUID for Bitbucket)
+	PipelineId string `form:"pipelineId" json:"pipelineId"`
+}
+
+// ListPipelineArtifactsParams defines parameters for ListPipelineArtifacts.
+type ListPipelineArtifactsParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// PipelineId Pipeline ID as returned by the pipelines list (numeric for GitLab/GitHub, a UUID for Bitbucket)
+	PipelineId string `form:"pipelineId" json:"pipelineId"`
+
+	// JobId Only the artifacts of this job of the pipeline. GitLab only; other providers do not link
+	// artifacts to jobs and answer 400.
+	JobId *string `form:"jobId,omitempty" json:"jobId,omitempty"`
+}
+
+// DownloadPipelineArtifactParams defines parameters for DownloadPipelineArtifact.
+type DownloadPipelineArtifactParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// ArtifactId Artifact ID as returned by the pipeline artifacts list
+	ArtifactId string `form:"artifactId" json:"artifactId"`
+}
+
+// GetPipelineGraphParams defines parameters for GetPipelineGraph.
+type GetPipelineGraphParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// PipelineId ID of the pipeline at the root of the graph
+	PipelineId string `form:"pipelineId" json:"pipelineId"`
+
+	// Depth Number of downstream levels to resolve; 0 returns the root pipeline only
+	Depth *int `form:"depth,omitempty" json:"depth,omitempty"`
+}
+
 // GetPipelineJobTraceParams defines parameters for GetPipelineJobTrace.
 type GetPipelineJobTraceParams struct {
 	// GitServer The Git server name.
@@ -368,8 +1031,74 @@ type GetPipelineJobTraceParams struct {
 	// Project Project path (e.g., "krci/my-app")
 	Project string `form:"project" json:"project"`
 
-	// JobId Job ID
+	// JobId Job ID as returned by the pipeline jobs list
+	JobId string `form:"jobId" json:"jobId"`
+
+	// Offset Byte offset of the first trace byte to return
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Maximum number of bytes to return; capped at 4 MiB
+	Limit *int64 `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Tail Return the last N lines of the trace (within its last 4 MiB)
+	Tail *int `form:"tail,omitempty" json:"tail,omitempty"`
+
+	// Format `raw` returns the trace text as is. `structured` parses it into lines and a tree of
+	// collapsible sections (GitLab `section_start`/`section_end` markers and `##[group]` log
+	// groups of GitHub Actions and Azure Pipelines); content is then empty. Line numbers count
+	// from the start of the returned window.
+	Format *GetPipelineJobTraceParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// Ansi How structured lines carry ANSI colors and styles, as style spans or not at all
+	Ansi *GetPipelineJobTraceParamsAnsi `form:"ansi,omitempty" json:"ansi,omitempty"`
+}
+
+// GetPipelineJobTraceParamsFormat defines parameters for GetPipelineJobTrace.
+type GetPipelineJobTraceParamsFormat string
+
+// GetPipelineJobTraceParamsAnsi defines parameters for GetPipelineJobTrace.
+type GetPipelineJobTraceParamsAnsi string
+
+// StreamPipelineJobTraceParams defines parameters for StreamPipelineJobTrace.
+type StreamPipelineJobTraceParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// JobId Job ID as returned by the pipeline jobs list
 	JobId string `form:"jobId" json:"jobId"`
+
+	// Offset Byte offset to start streaming from
+	Offset *int64 `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// LastEventID Set by a reconnecting EventSource; takes precedence over offset
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
+// SearchPipelineJobTracesParams defines parameters for SearchPipelineJobTraces.
+type SearchPipelineJobTracesParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// PipelineId Pipeline ID as returned by the pipelines list (numeric for GitLab/GitHub, a UUID for Bitbucket)
+	PipelineId string `form:"pipelineId" json:"pipelineId"`
+
+	// Query Text or, with regex, regular expression to search for (at most 1000 characters)
+	Query string `form:"query" json:"query"`
+
+	// Regex Treat query as an RE2 regular expression instead of a literal string
+	Regex *bool `form:"regex,omitempty" json:"regex,omitempty"`
+
+	// IgnoreCase Match regardless of letter case
+	IgnoreCase *bool `form:"ignoreCase,omitempty" json:"ignoreCase,omitempty"`
+
+	// Context Number of lines to return before and after each matching line
+	Context *int `form:"context,omitempty" json:"context,omitempty"`
 }
 
 // ListPipelineJobsParams defines parameters for ListPipelineJobs.
@@ -380,7 +1109,160 @@ type ListPipelineJobsParams struct {
 	// Project Project path (e.g., "krci/my-app")
 	Project string `form:"project" json:"project"`
 
-	// PipelineId Pipeline ID
+	// PipelineId Pipeline ID as returned by the pipelines list (numeric for GitLab/GitHub, a UUID for Bitbucket)
+	PipelineId string `form:"pipelineId" json:"pipelineId"`
+}
+
+// CancelPipelineJobParams defines parameters for CancelPipelineJob.
+type CancelPipelineJobParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// JobId Job ID as returned by the pipeline jobs list
+	JobId string `form:"jobId" json:"jobId"`
+}
+
+// PlayPipelineJobParams defines parameters for PlayPipelineJob.
+type PlayPipelineJobParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// JobId Job ID as returned by the pipeline jobs list
+	JobId string `form:"jobId" json:"jobId"`
+
+	// Variables JSON array of job variables (GitLab only)
+	Variables *string `form:"variables,omitempty" json:"variables,omitempty"`
+}
+
+// RetryPipelineJobParams defines parameters for RetryPipelineJob.
+type RetryPipelineJobParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// JobId Job ID as returned by the pipeline jobs list
+	JobId string `form:"jobId" json:"jobId"`
+}
+
+// GetPipelineScheduleParams defines parameters for GetPipelineSchedule.
+type GetPipelineScheduleParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// ScheduleId Schedule ID as returned by the pipeline schedules list
+	ScheduleId string `form:"scheduleId" json:"scheduleId"`
+}
+
+// DeletePipelineScheduleParams defines parameters for DeletePipelineSchedule.
+type DeletePipelineScheduleParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// ScheduleId Schedule ID as returned by the pipeline schedules list
+	ScheduleId string `form:"scheduleId" json:"scheduleId"`
+}
+
+// ListPipelineSchedulesParams defines parameters for ListPipelineSchedules.
+type ListPipelineSchedulesParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+}
+
+// CreatePipelineScheduleParams defines parameters for CreatePipelineSchedule.
+type CreatePipelineScheduleParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// Description Schedule description
+	Description string `form:"description" json:"description"`
+
+	// Ref Branch or tag the scheduled pipelines run for
+	Ref string `form:"ref" json:"ref"`
+
+	// Cron Cron expression of the schedule (e.g., "0 1 * * *")
+	Cron string `form:"cron" json:"cron"`
+
+	// CronTimezone Time zone of the cron expression (e.g., "UTC" or "Europe/Berlin"); GitLab defaults to UTC
+	CronTimezone *string `form:"cronTimezone,omitempty" json:"cronTimezone,omitempty"`
+
+	// Active Whether the schedule runs pipelines; GitLab makes new schedules active by default
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+
+	// Variables JSON array of variables passed to the scheduled pipelines
+	Variables *string `form:"variables,omitempty" json:"variables,omitempty"`
+}
+
+// RunPipelineScheduleParams defines parameters for RunPipelineSchedule.
+type RunPipelineScheduleParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// ScheduleId Schedule ID as returned by the pipeline schedules list
+	ScheduleId string `form:"scheduleId" json:"scheduleId"`
+}
+
+// UpdatePipelineScheduleParams defines parameters for UpdatePipelineSchedule.
+type UpdatePipelineScheduleParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// ScheduleId Schedule ID as returned by the pipeline schedules list
+	ScheduleId string `form:"scheduleId" json:"scheduleId"`
+
+	// Description Schedule description
+	Description *string `form:"description,omitempty" json:"description,omitempty"`
+
+	// Ref Branch or tag the scheduled pipelines run for
+	Ref *string `form:"ref,omitempty" json:"ref,omitempty"`
+
+	// Cron Cron expression of the schedule (e.g., "0 1 * * *")
+	Cron *string `form:"cron,omitempty" json:"cron,omitempty"`
+
+	// CronTimezone Time zone of the cron expression (e.g., "UTC" or "Europe/Berlin"); GitLab defaults to UTC
+	CronTimezone *string `form:"cronTimezone,omitempty" json:"cronTimezone,omitempty"`
+
+	// Active Whether the schedule runs pipelines; GitLab makes new schedules active by default
+	Active *bool `form:"active,omitempty" json:"active,omitempty"`
+
+	// Variables JSON array of variables that replaces the schedule's variables
+	Variables *string `form:"variables,omitempty" json:"variables,omitempty"`
+}
+
+// GetPipelineTestReportParams defines parameters for GetPipelineTestReport.
+type GetPipelineTestReportParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// PipelineId Pipeline ID as returned by the pipelines list (numeric for GitLab/GitHub, a UUID for Bitbucket)
 	PipelineId string `form:"pipelineId" json:"pipelineId"`
 }
 
@@ -396,14 +1278,85 @@ type ListPipelinesParams struct {
 	Ref *string `form:"ref,omitempty" json:"ref,omitempty"`
 
 	// Status Filter by pipeline status
-	Status  *ListPipelinesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
-	Page    *int                       `form:"page,omitempty" json:"page,omitempty"`
-	PerPage *int                       `form:"perPage,omitempty" json:"perPage,omitempty"`
+	Status *ListPipelinesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Sha Filter by commit SHA
+	Sha *string `form:"sha,omitempty" json:"sha,omitempty"`
+
+	// Source Filter by what triggered the pipeline. Bitbucket, and GitLab and GitHub for "other",
+	// filter each page after fetching it, so pages may hold fewer pipelines than perPage.
+	Source *ListPipelinesParamsSource `form:"source,omitempty" json:"source,omitempty"`
+
+	// TriggeredBy Filter by the user who triggered the pipeline: a GitLab username, a GitHub login, or a
+	// Bitbucket nickname, account ID or UUID. Bitbucket filters each page after fetching it.
+	TriggeredBy *string `form:"triggeredBy,omitempty" json:"triggeredBy,omitempty"`
+
+	// UpdatedAfter Only pipelines last updated at or after this time. GitHub and Bitbucket filter each
+	// page after fetching it; Bitbucket takes the completion time, or the creation time of
+	// unfinished pipelines, for the last update.
+	UpdatedAfter *time.Time `form:"updatedAfter,omitempty" json:"updatedAfter,omitempty"`
+
+	// UpdatedBefore Only pipelines last updated at or before this time; see updatedAfter.
+	UpdatedBefore *time.Time `form:"updatedBefore,omitempty" json:"updatedBefore,omitempty"`
+
+	// Sort Order by creation, oldest first (asc) or newest first (desc). GitHub lists only the
+	// newest 1000 runs of a filtered listing, so a filtered oldest-first page reaching past
+	// them answers 400.
+	Sort    *ListPipelinesParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+	Page    *int                     `form:"page,omitempty" json:"page,omitempty"`
+	PerPage *int                     `form:"perPage,omitempty" json:"perPage,omitempty"`
 }
 
 // ListPipelinesParamsStatus defines parameters for ListPipelines.
 type ListPipelinesParamsStatus string
 
+// ListPipelinesParamsSource defines parameters for ListPipelines.
+type ListPipelinesParamsSource string
+
+// ListPipelinesParamsSort defines parameters for ListPipelines.
+type ListPipelinesParamsSort string
+
+// CancelPipelineParams defines parameters for CancelPipeline.
+type CancelPipelineParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// PipelineId Pipeline ID as returned by the pipelines list (numeric for GitLab/GitHub, a UUID for Bitbucket)
+	PipelineId string `form:"pipelineId" json:"pipelineId"`
+}
+
+// RetryPipelineParams defines parameters for RetryPipeline.
+type RetryPipelineParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// PipelineId Pipeline ID as returned by the pipelines list (numeric for GitLab/GitHub)
+	PipelineId string `form:"pipelineId" json:"pipelineId"`
+
+	// FailedOnly Re-run only the failed jobs (GitHub); GitLab always behaves this way
+	FailedOnly *bool `form:"failedOnly,omitempty" json:"failedOnly,omitempty"`
+}
+
+// ListPullRequestPipelinesParams defines parameters for ListPullRequestPipelines.
+type ListPullRequestPipelinesParams struct {
+	// GitServer The Git server name.
+	GitServer GitServerParam `form:"gitServer" json:"gitServer"`
+
+	// Project Project path (e.g., "krci/my-app")
+	Project string `form:"project" json:"project"`
+
+	// PullRequest Pull request number (the merge request IID on GitLab)
+	PullRequest int  `form:"pullRequest" json:"pullRequest"`
+	Page        *int `form:"page,omitempty" json:"page,omitempty"`
+	PerPage     *int `form:"perPage,omitempty" json:"perPage,omitempty"`
+}
+
 // ListPullRequestsParams defines parameters for ListPullRequests.
 type ListPullRequestsParams struct {
 	// GitServer The Git server name.
@@ -459,6 +1412,12 @@ type TriggerPipelineParams struct {
 	// Ref Branch/tag/commit (e.g., "main")
 	Ref string `form:"ref" json:"ref"`
 
+	// Pipeline Named pipeline definition to run instead of the default one. For Bitbucket this is a
+	// custom pipeline from bitbucket-pipelines.yml; for GitHub it is a workflow file name
+	// (e.g., "deploy.yaml"); for Azure DevOps it is the pipeline name, required when the
+	// repository has several pipelines. Not supported for GitLab.
+	Pipeline *string `form:"pipeline,omitempty" json:"pipeline,omitempty"`
+
 	// Variables JSON array of pipeline variables
 	Variables *string `form:"variables,omitempty" json:"variables,omitempty"`
 }
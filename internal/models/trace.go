@@ -0,0 +1,46 @@
+package models
+
+// JobTraceRange is the part of a job trace from a byte offset on, together with the job status
+// read before the trace: once Status is terminal and Truncated is false, Content reaches the end
+// of the trace.
+type JobTraceRange struct {
+	Content   string // Trace bytes from the requested offset; empty when there is nothing new
+	Status    string // Provider-native job status
+	Truncated bool   // Content stopped at the provider's read cap and more bytes follow
+}
+
+// PipelineJobTraceEvent is one event of a job trace stream; exactly one field is set.
+type PipelineJobTraceEvent struct {
+	Chunk *PipelineJobTraceChunk
+	End   *PipelineJobTraceEnd
+	Err   error
+}
+
+// JobTraceWindow selects the part of a job trace to read: up to Limit bytes from Offset on or,
+// when TailLines is positive, the last TailLines lines. The zero value reads the trace from its
+// start up to the provider's read cap.
+type JobTraceWindow struct {
+	Offset    int64
+	Limit     int64 // Maximum bytes to read; 0 reads up to the provider's read cap
+	TailLines int
+}
+
+// JobTrace is a window of a job trace.
+type JobTrace struct {
+	Content   string
+	Offset    int64  // Byte offset of Content within the trace
+	TotalSize *int64 // Size of the whole trace in bytes; nil when the provider did not report it
+	Truncated bool   // More trace bytes follow Content
+
+	// Structure is Content parsed into lines and sections, set for structured reads, which leave
+	// Content empty.
+	Structure *PipelineJobTraceStructure
+}
+
+// JobTraceSearchOptions selects the lines a search of a pipeline's job traces returns.
+type JobTraceSearchOptions struct {
+	Query      string // Literal text or, when Regex is set, an RE2 regular expression
+	Regex      bool
+	IgnoreCase bool
+	Context    int // Lines to return before and after each matching line
+}
@@ -0,0 +1,509 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+	"github.com/KubeRocketCI/gitfusion/pkg/xiter"
+)
+
+// apiVersion is the Azure DevOps REST API version sent with every request.
+const apiVersion = "7.1"
+
+// continuationHeader carries the token of the next page for list APIs that page by continuation.
+const continuationHeader = "X-Ms-Continuationtoken"
+
+// pageSize is the page size ($top) used when scanning list APIs.
+const pageSize = 100
+
+const branchRefPrefix = "refs/heads/"
+
+const (
+	prStatusActive    = "active"
+	prStatusCompleted = "completed"
+	prStatusAbandoned = "abandoned"
+)
+
+// trailingPort matches a port appended after the organization path. The GitServer API URL is
+// built as "<host><path>:<port>", which puts the port after the organization or collection.
+var trailingPort = regexp.MustCompile(`:(\d+)/?$`)
+
+// AzureDevOpsProvider implements every provider interface for Azure DevOps Services and Server.
+//
+// A GitServer points at one Azure DevOps organization (https://dev.azure.com/{organization})
+// or Server collection (https://host/tfs/{collection}). Azure DevOps projects are exposed as
+// organizations and used as repository owners, so a pipeline project is "{project}/{repository}".
+// The GitServer token is a personal access token.
+type AzureDevOpsProvider struct {
+	httpClient *resty.Client
+}
+
+func NewAzureDevOpsProvider() *AzureDevOpsProvider {
+	return &AzureDevOpsProvider{
+		httpClient: resty.New(),
+	}
+}
+
+// listResponse is the envelope of Azure DevOps collection responses.
+type listResponse[T any] struct {
+	Count int `json:"count"`
+	Value []T `json:"value"`
+}
+
+type azureProject struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+}
+
+type azureRepository struct {
+	ID            string       `json:"id"`
+	Name          string       `json:"name"`
+	WebURL        string       `json:"webUrl"`
+	DefaultBranch string       `json:"defaultBranch"`
+	Project       azureProject `json:"project"`
+}
+
+type azureRef struct {
+	Name     string `json:"name"`
+	ObjectID string `json:"objectId"`
+}
+
+type azureIdentity struct {
+	ID          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	UniqueName  string `json:"uniqueName"`
+	ImageURL    string `json:"imageUrl"`
+}
+
+type azureCommitRef struct {
+	CommitID string `json:"commitId"`
+}
+
+type azurePullRequest struct {
+	PullRequestID         int             `json:"pullRequestId"`
+	Title                 string          `json:"title"`
+	Description           string          `json:"description"`
+	Status                string          `json:"status"`
+	IsDraft               *bool           `json:"isDraft,omitempty"`
+	CreatedBy             azureIdentity   `json:"createdBy"`
+	CreationDate          time.Time       `json:"creationDate"`
+	ClosedDate            *time.Time      `json:"closedDate,omitempty"`
+	SourceRefName         string          `json:"sourceRefName"`
+	TargetRefName         string          `json:"targetRefName"`
+	LastMergeSourceCommit *azureCommitRef `json:"lastMergeSourceCommit,omitempty"`
+	Repository            azureRepository `json:"repository"`
+}
+
+func (a *AzureDevOpsProvider) GetRepository(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+) (*models.Repository, error) {
+	azureRepo, err := a.getRepository(ctx, owner, repo, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertRepository(*azureRepo), nil
+}
+
+func (a *AzureDevOpsProvider) getRepository(
+	ctx context.Context,
+	project, repo string,
+	settings krci.GitServerSettings,
+) (*azureRepository, error) {
+	base, err := collectionURL(settings.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	var azureRepo azureRepository
+
+	resp, err := a.request(ctx, settings).
+		SetResult(&azureRepo).
+		Get(repositoryURL(base, project, repo))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository %s/%s: %w", project, repo, err)
+	}
+
+	if err := checkResponse(resp, fmt.Sprintf("repository %s/%s", project, repo)); err != nil {
+		return nil, err
+	}
+
+	return &azureRepo, nil
+}
+
+// ListRepositories lists the Git repositories of an Azure DevOps project.
+func (a *AzureDevOpsProvider) ListRepositories(
+	ctx context.Context,
+	owner string,
+	settings krci.GitServerSettings,
+	listOptions models.ListOptions,
+) ([]models.Repository, error) {
+	base, err := collectionURL(settings.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	var repos listResponse[azureRepository]
+
+	resp, err := a.request(ctx, settings).
+		SetResult(&repos).
+		Get(fmt.Sprintf("%s/%s/_apis/git/repositories", base, url.PathEscape(owner)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repositories for project %s: %w", owner, err)
+	}
+
+	if err := checkResponse(resp, "project "+owner); err != nil {
+		return nil, err
+	}
+
+	nameFilter := strings.ToLower(pointer.ValueOrEmpty(listOptions.Name))
+	result := make([]models.Repository, 0, len(repos.Value))
+
+	for _, repo := range repos.Value {
+		if nameFilter != "" && !strings.Contains(strings.ToLower(repo.Name), nameFilter) {
+			continue
+		}
+
+		result = append(result, *convertRepository(repo))
+	}
+
+	return result, nil
+}
+
+// ListUserOrganizations returns the projects of the organization the GitServer points at.
+func (a *AzureDevOpsProvider) ListUserOrganizations(
+	ctx context.Context,
+	settings krci.GitServerSettings,
+) ([]models.Organization, error) {
+	base, err := collectionURL(settings.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Organization, 0)
+
+	for project, err := range scanList[azureProject](ctx, a, settings, base+"/_apis/projects", nil, "projects") {
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, models.Organization{
+			Id:   project.ID,
+			Name: project.Name,
+		})
+	}
+
+	return result, nil
+}
+
+// ListBranches returns the branch refs (refs/heads/*) of a repository.
+func (a *AzureDevOpsProvider) ListBranches(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	opts models.ListOptions,
+) ([]models.Branch, error) {
+	base, err := collectionURL(settings.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("filter", strings.TrimPrefix(branchRefPrefix, "refs/"))
+
+	if opts.Name != nil && *opts.Name != "" {
+		query.Set("filterContains", *opts.Name)
+	}
+
+	result := make([]models.Branch, 0)
+
+	for ref, err := range scanList[azureRef](
+		ctx, a, settings, repositoryURL(base, owner, repo)+"/refs", query, fmt.Sprintf("repository %s/%s", owner, repo),
+	) {
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, models.Branch{
+			Name: strings.TrimPrefix(ref.Name, branchRefPrefix),
+		})
+	}
+
+	return result, nil
+}
+
+// ListPullRequests returns a single page of pull requests. Azure DevOps pages by $skip/$top and
+// does not report a total count, so while further pages may exist the total is reported as one
+// past the current page.
+func (a *AzureDevOpsProvider) ListPullRequests(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	opts models.PullRequestListOptions,
+) (*models.PullRequestsResponse, error) {
+	base, err := collectionURL(settings.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := (opts.Page - 1) * opts.PerPage
+
+	var prs listResponse[azurePullRequest]
+
+	resp, err := a.request(ctx, settings).
+		SetQueryParam("searchCriteria.status", convertPRStateFilter(opts.State)).
+		SetQueryParam("$skip", strconv.Itoa(offset)).
+		SetQueryParam("$top", strconv.Itoa(opts.PerPage)).
+		SetResult(&prs).
+		Get(repositoryURL(base, owner, repo) + "/pullrequests")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests for %s/%s: %w", owner, repo, err)
+	}
+
+	if err := checkResponse(resp, fmt.Sprintf("repository %s/%s", owner, repo)); err != nil {
+		return nil, err
+	}
+
+	result := make([]models.PullRequest, 0, len(prs.Value))
+	for _, pr := range prs.Value {
+		result = append(result, convertPullRequest(pr))
+	}
+
+	total := offset + len(result)
+	if len(result) >= opts.PerPage {
+		total = opts.Page*opts.PerPage + 1
+	}
+
+	return &models.PullRequestsResponse{
+		Data: result,
+		Pagination: models.Pagination{
+			Total:   total,
+			Page:    &opts.Page,
+			PerPage: &opts.PerPage,
+		},
+	}, nil
+}
+
+// scanList iterates over every item of a list API that pages by continuation token.
+// subject names the requested resource in not-found errors.
+func scanList[T any](
+	ctx context.Context,
+	a *AzureDevOpsProvider,
+	settings krci.GitServerSettings,
+	apiURL string,
+	query url.Values,
+	subject string,
+) xiter.Scan[T] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		continuationToken := ""
+
+		for {
+			var page listResponse[T]
+
+			req := a.request(ctx, settings).
+				SetQueryParamsFromValues(query).
+				SetQueryParam("$top", strconv.Itoa(pageSize)).
+				SetResult(&page)
+
+			if continuationToken != "" {
+				req.SetQueryParam("continuationToken", continuationToken)
+			}
+
+			resp, err := req.Get(apiURL)
+			if err != nil {
+				yield(zero, fmt.Errorf("failed to list %s: %w", subject, err))
+
+				return
+			}
+
+			if err := checkResponse(resp, subject); err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range page.Value {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			next := resp.Header().Get(continuationHeader)
+			if next == "" || next == continuationToken || len(page.Value) == 0 {
+				return
+			}
+
+			continuationToken = next
+		}
+	}
+}
+
+func (a *AzureDevOpsProvider) request(ctx context.Context, settings krci.GitServerSettings) *resty.Request {
+	return a.httpClient.R().
+		SetContext(ctx).
+		SetBasicAuth("", settings.Token).
+		SetQueryParam("api-version", apiVersion).
+		SetHeader("Accept", "application/json")
+}
+
+// checkResponse maps Azure DevOps error statuses to gitfusion error sentinels. A rejected personal
+// access token is answered with 203 and a sign-in page rather than 401.
+func checkResponse(resp *resty.Response, subject string) error {
+	switch resp.StatusCode() {
+	case http.StatusNotFound:
+		return fmt.Errorf("%s: %w", subject, gferrors.ErrNotFound)
+	case http.StatusUnauthorized, http.StatusForbidden, http.StatusNonAuthoritativeInfo:
+		return fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case http.StatusBadRequest:
+		return fmt.Errorf("%s: %s: %w", subject, resp.String(), gferrors.ErrBadRequest)
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("request for %s failed: status %d, body: %s", subject, resp.StatusCode(), resp.String())
+	}
+
+	return nil
+}
+
+// collectionURL returns the organization (or collection) URL from the GitServer API URL, moving
+// a port that was appended after the path back to the host and dropping it when it is the
+// scheme default.
+func collectionURL(serverURL string) (string, error) {
+	u, err := url.Parse(strings.TrimRight(serverURL, "/"))
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid Azure DevOps server URL %q: %w", serverURL, gferrors.ErrBadRequest)
+	}
+
+	if m := trailingPort.FindStringSubmatch(u.Path); m != nil {
+		u.Path = strings.TrimSuffix(u.Path, m[0])
+
+		isDefault := (u.Scheme == "https" && m[1] == "443") || (u.Scheme == "http" && m[1] == "80")
+		if !isDefault && u.Port() == "" {
+			u.Host = net.JoinHostPort(u.Hostname(), m[1])
+		}
+	}
+
+	u.Path = strings.TrimRight(u.Path, "/")
+	if u.Path == "" {
+		return "", fmt.Errorf("server URL %q must include the Azure DevOps organization or collection: %w",
+			serverURL, gferrors.ErrBadRequest)
+	}
+
+	u.RawPath = ""
+
+	return u.String(), nil
+}
+
+func repositoryURL(base, project, repo string) string {
+	return fmt.Sprintf("%s/%s/_apis/git/repositories/%s", base, url.PathEscape(project), url.PathEscape(repo))
+}
+
+func convertRepository(repo azureRepository) *models.Repository {
+	visibility := models.RepositoryVisibilityPrivate
+	if repo.Project.Visibility == "public" {
+		visibility = models.RepositoryVisibilityPublic
+	}
+
+	result := &models.Repository{
+		Id:         repo.ID,
+		Name:       repo.Name,
+		Owner:      &repo.Project.Name,
+		Url:        &repo.WebURL,
+		Visibility: &visibility,
+	}
+
+	if repo.DefaultBranch != "" {
+		defaultBranch := strings.TrimPrefix(repo.DefaultBranch, branchRefPrefix)
+		result.DefaultBranch = &defaultBranch
+	}
+
+	return result
+}
+
+func convertPullRequest(pr azurePullRequest) models.PullRequest {
+	author := &models.Owner{
+		Id:   pr.CreatedBy.ID,
+		Name: pr.CreatedBy.DisplayName,
+	}
+
+	if pr.CreatedBy.ImageURL != "" {
+		author.AvatarUrl = &pr.CreatedBy.ImageURL
+	}
+
+	updatedAt := pr.CreationDate
+	if pr.ClosedDate != nil && pr.ClosedDate.After(updatedAt) {
+		updatedAt = *pr.ClosedDate
+	}
+
+	result := models.PullRequest{
+		Id:           strconv.Itoa(pr.PullRequestID),
+		Number:       pr.PullRequestID,
+		Title:        pr.Title,
+		State:        convertPRState(pr.Status),
+		SourceBranch: strings.TrimPrefix(pr.SourceRefName, branchRefPrefix),
+		TargetBranch: strings.TrimPrefix(pr.TargetRefName, branchRefPrefix),
+		Author:       author,
+		CreatedAt:    pr.CreationDate,
+		UpdatedAt:    updatedAt,
+		Draft:        pr.IsDraft,
+	}
+
+	if pr.Repository.WebURL != "" {
+		result.Url = fmt.Sprintf("%s/pullrequest/%d", pr.Repository.WebURL, pr.PullRequestID)
+	}
+
+	if pr.Description != "" {
+		result.Description = &pr.Description
+	}
+
+	if pr.LastMergeSourceCommit != nil && pr.LastMergeSourceCommit.CommitID != "" {
+		result.CommitSha = &pr.LastMergeSourceCommit.CommitID
+	}
+
+	return result
+}
+
+// convertPRState maps an Azure DevOps pull request status to the unified state:
+// active is open, completed is merged and abandoned is closed.
+func convertPRState(status string) models.PullRequestState {
+	switch status {
+	case prStatusCompleted:
+		return models.PullRequestStateMerged
+	case prStatusAbandoned:
+		return models.PullRequestStateClosed
+	default:
+		return models.PullRequestStateOpen
+	}
+}
+
+func convertPRStateFilter(state string) string {
+	switch state {
+	case "merged":
+		return prStatusCompleted
+	case "closed":
+		return prStatusAbandoned
+	case "all":
+		return "all"
+	default:
+		return prStatusActive
+	}
+}
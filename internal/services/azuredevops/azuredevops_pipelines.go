@@ -0,0 +1,740 @@
+package azuredevops
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// jobIDSeparator joins the build ID, the timeline record ID and the attempt into a job ID. A job
+// log can only be fetched through its build, and a retried job keeps its record ID, so the attempt
+// tells retries apart.
+const jobIDSeparator = "/"
+
+const (
+	recordTypeStage = "Stage"
+	recordTypeJob   = "Job"
+	recordTypeTask  = "Task"
+
+	stateCompleted = "completed"
+)
+
+// maxListedBuilds bounds page*perPage, the number of builds ListPipelines fetches to cut the
+// requested page from; later pages are rejected.
+const maxListedBuilds = 5000
+
+var commitSHA = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+type azureBuild struct {
+	ID            int        `json:"id"`
+	BuildNumber   string     `json:"buildNumber"`
+	Status        string     `json:"status"`
+	Result        string     `json:"result"`
+	Reason        string     `json:"reason"`
+	SourceBranch  string     `json:"sourceBranch"`
+	SourceVersion string     `json:"sourceVersion"`
+	QueueTime     time.Time  `json:"queueTime"`
+	StartTime     *time.Time `json:"startTime,omitempty"`
+	FinishTime    *time.Time `json:"finishTime,omitempty"`
+	Project       struct {
+		ID string `json:"id"`
+	} `json:"project"`
+	Links struct {
+		Web struct {
+			Href string `json:"href"`
+		} `json:"web"`
+	} `json:"_links"`
+}
+
+type azureTimeline struct {
+	Records []azureTimelineRecord `json:"records"`
+}
+
+type azureTimelineRecord struct {
+	ID         string     `json:"id"`
+	ParentID   *string    `json:"parentId,omitempty"`
+	Type       string     `json:"type"`
+	Name       string     `json:"name"`
+	State      string     `json:"state"`
+	Result     *string    `json:"result,omitempty"`
+	Order      int        `json:"order"`
+	Attempt    int        `json:"attempt"`
+	StartTime  *time.Time `json:"startTime,omitempty"`
+	FinishTime *time.Time `json:"finishTime,omitempty"`
+	Log        *struct {
+		ID int `json:"id"`
+	} `json:"log,omitempty"`
+	PreviousAttempts []struct {
+		Attempt    int    `json:"attempt"`
+		TimelineID string `json:"timelineId"`
+	} `json:"previousAttempts,omitempty"`
+}
+
+type azureBuildDefinition struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	Path string `json:"path"`
+}
+
+type azurePipelineRun struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	State  string `json:"state"`
+	Result string `json:"result"`
+	Links  struct {
+		Web struct {
+			Href string `json:"href"`
+		} `json:"web"`
+	} `json:"_links"`
+}
+
+// ListPipelines returns the Azure Pipelines builds of a repository, newest first. The build API
+// pages by continuation token only, so the requested page is cut from the first page*perPage builds,
+// which may not exceed maxListedBuilds. Only the ref and status filters are applied; the others
+// are rejected.
+func (a *AzureDevOpsProvider) ListPipelines(
+	ctx context.Context,
+	project string,
+	settings krci.GitServerSettings,
+	opts models.PipelineListOptions,
+) (*models.PipelinesResponse, error) {
+	if err := common.RejectPipelineListFilters("azuredevops", opts); err != nil {
+		return nil, err
+	}
+
+	// Compare by division so that a huge page cannot overflow page*perPage.
+	if opts.PerPage > 0 && opts.Page > maxListedBuilds/opts.PerPage {
+		return nil, fmt.Errorf("page %d with %d per page is past the first %d builds: %w",
+			opts.Page, opts.PerPage, maxListedBuilds, gferrors.ErrBadRequest)
+	}
+
+	azureProject, repoName, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := collectionURL(settings.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := a.getRepository(ctx, azureProject, repoName, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	top := opts.Page * opts.PerPage
+
+	query := url.Values{}
+	query.Set("repositoryId", repo.ID)
+	query.Set("repositoryType", "TfsGit")
+	query.Set("queryOrder", "queueTimeDescending")
+	query.Set("$top", strconv.Itoa(top))
+
+	if opts.Ref != nil {
+		query.Set("branchName", toRefName(*opts.Ref))
+	}
+
+	if opts.Status != nil {
+		for k, v := range mapPipelineStatusToAzure(*opts.Status) {
+			query.Set(k, v)
+		}
+	}
+
+	var builds listResponse[azureBuild]
+
+	resp, err := a.request(ctx, settings).
+		SetQueryParamsFromValues(query).
+		SetResult(&builds).
+		Get(fmt.Sprintf("%s/%s/_apis/build/builds", base, url.PathEscape(azureProject)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipelines for %s: %w", project, err)
+	}
+
+	if err := checkResponse(resp, "project "+project); err != nil {
+		return nil, err
+	}
+
+	offset := min((opts.Page-1)*opts.PerPage, len(builds.Value))
+
+	result := make([]models.Pipeline, 0, opts.PerPage)
+	for _, build := range builds.Value[offset:] {
+		result = append(result, convertBuild(build))
+	}
+
+	total := len(builds.Value)
+	if len(builds.Value) >= top && resp.Header().Get(continuationHeader) != "" {
+		total = top + 1
+	}
+
+	return &models.PipelinesResponse{
+		Data: result,
+		Pagination: models.Pagination{
+			Total:   total,
+			Page:    &opts.Page,
+			PerPage: &opts.PerPage,
+		},
+	}, nil
+}
+
+// TriggerPipeline queues a run of the repository's pipeline on ref. A named pipeline selects the
+// pipeline definition by name; otherwise the repository must have exactly one. Variables must be
+// declared settable at queue time in the pipeline, and Secured marks them as secret.
+func (a *AzureDevOpsProvider) TriggerPipeline(
+	ctx context.Context,
+	project string,
+	settings krci.GitServerSettings,
+	opts models.PipelineTriggerOptions,
+) (*models.PipelineResponse, error) {
+	azureProject, repoName, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := collectionURL(settings.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := a.getRepository(ctx, azureProject, repoName, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	definition, err := a.findPipelineDefinition(ctx, base, azureProject, repo, settings, opts.Pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	self := map[string]string{}
+	if commitSHA.MatchString(opts.Ref) {
+		self["version"] = opts.Ref
+	} else {
+		self["refName"] = toRefName(opts.Ref)
+	}
+
+	body := map[string]any{
+		"resources": map[string]any{
+			"repositories": map[string]any{"self": self},
+		},
+	}
+
+	if len(opts.Variables) > 0 {
+		variables := make(map[string]any, len(opts.Variables))
+		for _, v := range opts.Variables {
+			variables[v.Key] = map[string]any{
+				"value":    v.Value,
+				"isSecret": v.Secured != nil && *v.Secured,
+			}
+		}
+
+		body["variables"] = variables
+	}
+
+	var run azurePipelineRun
+
+	resp, err := a.request(ctx, settings).
+		SetBody(body).
+		SetResult(&run).
+		Post(fmt.Sprintf("%s/%s/_apis/pipelines/%d/runs", base, url.PathEscape(azureProject), definition.ID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to run pipeline %s for %s ref %s: %w", definition.Name, project, opts.Ref, err)
+	}
+
+	subject := fmt.Sprintf("run pipeline %s for %s ref %s", definition.Name, project, opts.Ref)
+	if err := checkResponse(resp, subject); err != nil {
+		return nil, err
+	}
+
+	result := &models.PipelineResponse{
+		Id:     strconv.Itoa(run.ID),
+		WebUrl: run.Links.Web.Href,
+		Status: string(normalizeBuildStatus(run.State, run.Result)),
+		Ref:    opts.Ref,
+	}
+
+	if commitSHA.MatchString(opts.Ref) {
+		result.Sha = &opts.Ref
+	}
+
+	return result, nil
+}
+
+// findPipelineDefinition returns the pipeline definition of repo with the given name, or the only
+// definition of repo when no name is given.
+func (a *AzureDevOpsProvider) findPipelineDefinition(
+	ctx context.Context,
+	base, azureProject string,
+	repo *azureRepository,
+	settings krci.GitServerSettings,
+	name *string,
+) (*azureBuildDefinition, error) {
+	var definitions listResponse[azureBuildDefinition]
+
+	req := a.request(ctx, settings).
+		SetQueryParam("repositoryId", repo.ID).
+		SetQueryParam("repositoryType", "TfsGit").
+		SetResult(&definitions)
+
+	if name != nil {
+		req.SetQueryParam("name", *name)
+	}
+
+	resp, err := req.Get(fmt.Sprintf("%s/%s/_apis/build/definitions", base, url.PathEscape(azureProject)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pipelines of repository %s/%s: %w", azureProject, repo.Name, err)
+	}
+
+	if err := checkResponse(resp, fmt.Sprintf("repository %s/%s", azureProject, repo.Name)); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(definitions.Value) == 0 && name != nil:
+		return nil, fmt.Errorf("pipeline %s of repository %s/%s: %w", *name, azureProject, repo.Name, gferrors.ErrNotFound)
+	case len(definitions.Value) == 0:
+		return nil, fmt.Errorf("no pipeline for repository %s/%s: %w", azureProject, repo.Name, gferrors.ErrNotFound)
+	case len(definitions.Value) > 1 && name == nil:
+		names := make([]string, 0, len(definitions.Value))
+		for _, d := range definitions.Value {
+			names = append(names, d.Name)
+		}
+
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("repository %s/%s has several pipelines (%s), choose one by name: %w",
+			azureProject, repo.Name, strings.Join(names, ", "), gferrors.ErrBadRequest)
+	}
+
+	return &definitions.Value[0], nil
+}
+
+// ListPipelineJobs returns the latest attempt of every job of a build from its timeline. Each job
+// carries the name of the stage it runs in and its tasks as stages. Job IDs have the form
+// "<buildID>/<recordID>/<attempt>".
+func (a *AzureDevOpsProvider) ListPipelineJobs(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+) ([]models.PipelineJob, error) {
+	azureProject, _, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateBuildID(pipelineID); err != nil {
+		return nil, err
+	}
+
+	timeline, webURL, err := a.getTimeline(ctx, azureProject, pipelineID, "", settings)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*azureTimelineRecord, len(timeline.Records))
+	for i := range timeline.Records {
+		byID[timeline.Records[i].ID] = &timeline.Records[i]
+	}
+
+	tasks := make(map[string][]azureTimelineRecord)
+	jobs := make([]azureTimelineRecord, 0)
+
+	for _, r := range timeline.Records {
+		switch r.Type {
+		case recordTypeJob:
+			jobs = append(jobs, r)
+		case recordTypeTask:
+			if r.ParentID != nil {
+				tasks[*r.ParentID] = append(tasks[*r.ParentID], r)
+			}
+		}
+	}
+
+	stageOf := func(r azureTimelineRecord) *azureTimelineRecord {
+		for parent := r.ParentID; parent != nil; {
+			p, ok := byID[*parent]
+			if !ok {
+				return nil
+			}
+
+			if p.Type == recordTypeStage {
+				return p
+			}
+
+			parent = p.ParentID
+		}
+
+		return nil
+	}
+
+	sort.SliceStable(jobs, func(i, k int) bool {
+		si, sk := stageOf(jobs[i]), stageOf(jobs[k])
+		if si != nil && sk != nil && si.Order != sk.Order {
+			return si.Order < sk.Order
+		}
+
+		return jobs[i].Order < jobs[k].Order
+	})
+
+	result := make([]models.PipelineJob, 0, len(jobs))
+
+	for _, r := range jobs {
+		job := convertTimelineJob(pipelineID, r, tasks[r.ID])
+
+		if stage := stageOf(r); stage != nil {
+			job.Stage = stage.Name
+		}
+
+		if webURL != "" {
+			jobURL := fmt.Sprintf("%s&view=logs&j=%s", webURL, r.ID)
+			job.WebUrl = &jobURL
+		}
+
+		result = append(result, job)
+	}
+
+	return result, nil
+}
+
+// GetJobTrace returns the log of a timeline job and whether it was truncated to common.MaxTraceBytes.
+// Jobs that have not started yet have no log and return an empty trace.
+func (a *AzureDevOpsProvider) GetJobTrace(
+	ctx context.Context,
+	project string,
+	jobID string,
+	settings krci.GitServerSettings,
+) (string, bool, error) {
+	azureProject, _, err := common.SplitProject(project)
+	if err != nil {
+		return "", false, err
+	}
+
+	buildID, recordID, attempt, err := parseJobID(jobID)
+	if err != nil {
+		return "", false, err
+	}
+
+	record, err := a.findJobRecord(ctx, azureProject, buildID, recordID, attempt, settings)
+	if err != nil {
+		return "", false, err
+	}
+
+	if record.Log == nil {
+		return "", false, nil
+	}
+
+	base, err := collectionURL(settings.Url)
+	if err != nil {
+		return "", false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, common.TraceRequestTimeout)
+	defer cancel()
+
+	resp, err := a.request(ctx, settings).
+		SetHeader("Accept", "text/plain").
+		SetDoNotParseResponse(true).
+		Get(fmt.Sprintf("%s/%s/_apis/build/builds/%s/logs/%d", base, url.PathEscape(azureProject), buildID, record.Log.ID))
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get job log for %s job %s: %w", project, jobID, err)
+	}
+
+	body := resp.RawBody()
+	defer func() { _ = body.Close() }()
+
+	if resp.StatusCode() != http.StatusOK {
+		switch resp.StatusCode() {
+		case http.StatusNotFound:
+			return "", false, fmt.Errorf("project %s or job %s: %w", project, jobID, gferrors.ErrNotFound)
+		case http.StatusUnauthorized, http.StatusForbidden, http.StatusNonAuthoritativeInfo:
+			return "", false, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+		default:
+			return "", false, fmt.Errorf("azure devops job log download failed for %s job %s: status %d",
+				project, jobID, resp.StatusCode())
+		}
+	}
+
+	// As with the other providers, we intentionally do not drain the remainder on truncation.
+	data, err := io.ReadAll(io.LimitReader(body, common.MaxTraceBytes+1))
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read job log for %s job %s: %w", project, jobID, err)
+	}
+
+	if len(data) > common.MaxTraceBytes {
+		return string(data[:common.MaxTraceBytes]), true, nil
+	}
+
+	return string(data), false, nil
+}
+
+// findJobRecord returns the timeline record of the given attempt of a job. Earlier attempts of a
+// retried job live in their own timelines.
+func (a *AzureDevOpsProvider) findJobRecord(
+	ctx context.Context,
+	azureProject, buildID, recordID string,
+	attempt int,
+	settings krci.GitServerSettings,
+) (*azureTimelineRecord, error) {
+	notFound := fmt.Errorf("attempt %d of job %s of build %s: %w", attempt, recordID, buildID, gferrors.ErrNotFound)
+
+	timeline, _, err := a.getTimeline(ctx, azureProject, buildID, "", settings)
+	if err != nil {
+		return nil, err
+	}
+
+	record := timeline.record(recordID)
+	if record == nil {
+		return nil, notFound
+	}
+
+	if record.Attempt == attempt {
+		return record, nil
+	}
+
+	for _, previous := range record.PreviousAttempts {
+		if previous.Attempt != attempt {
+			continue
+		}
+
+		timeline, _, err := a.getTimeline(ctx, azureProject, buildID, previous.TimelineID, settings)
+		if err != nil {
+			return nil, err
+		}
+
+		if record := timeline.record(recordID); record != nil {
+			return record, nil
+		}
+	}
+
+	return nil, notFound
+}
+
+func (t *azureTimeline) record(id string) *azureTimelineRecord {
+	for i := range t.Records {
+		if t.Records[i].ID == id {
+			return &t.Records[i]
+		}
+	}
+
+	return nil
+}
+
+// getTimeline returns a timeline of a build, the latest one when timelineID is empty, together
+// with the build's web URL.
+func (a *AzureDevOpsProvider) getTimeline(
+	ctx context.Context,
+	azureProject, buildID, timelineID string,
+	settings krci.GitServerSettings,
+) (*azureTimeline, string, error) {
+	base, err := collectionURL(settings.Url)
+	if err != nil {
+		return nil, "", err
+	}
+
+	buildURL := fmt.Sprintf("%s/%s/_apis/build/builds/%s", base, url.PathEscape(azureProject), buildID)
+
+	timelineURL := buildURL + "/timeline"
+	if timelineID != "" {
+		timelineURL += "/" + url.PathEscape(timelineID)
+	}
+
+	var timeline azureTimeline
+
+	resp, err := a.request(ctx, settings).
+		SetResult(&timeline).
+		Get(timelineURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get timeline of build %s in %s: %w", buildID, azureProject, err)
+	}
+
+	if err := checkResponse(resp, fmt.Sprintf("project %s or build %s", azureProject, buildID)); err != nil {
+		return nil, "", err
+	}
+
+	webURL := fmt.Sprintf("%s/%s/_build/results?buildId=%s", base, url.PathEscape(azureProject), buildID)
+
+	return &timeline, webURL, nil
+}
+
+// parseJobID splits a "<buildID>/<recordID>/<attempt>" job ID.
+func parseJobID(jobID string) (buildID, recordID string, attempt int, err error) {
+	parts := strings.Split(jobID, jobIDSeparator)
+	if len(parts) == 3 && parts[1] != "" {
+		attempt, err = strconv.Atoi(parts[2])
+	}
+
+	if len(parts) != 3 || parts[1] == "" || err != nil {
+		return "", "", 0, fmt.Errorf("job ID %q must have the form <buildID>/<recordID>/<attempt>: %w",
+			jobID, gferrors.ErrBadRequest)
+	}
+
+	if err := validateBuildID(parts[0]); err != nil {
+		return "", "", 0, err
+	}
+
+	return parts[0], parts[1], attempt, nil
+}
+
+func validateBuildID(id string) error {
+	if _, err := strconv.Atoi(id); err != nil {
+		return fmt.Errorf("build ID %q must be a numeric value: %w", id, gferrors.ErrBadRequest)
+	}
+
+	return nil
+}
+
+// toRefName turns a branch name into a full ref; refs that are already qualified are kept.
+func toRefName(ref string) string {
+	if strings.HasPrefix(ref, "refs/") {
+		return ref
+	}
+
+	return branchRefPrefix + ref
+}
+
+// recordStatus returns the provider-native status of a timeline record: the result once it has
+// completed, otherwise its state (pending, inProgress).
+func recordStatus(r azureTimelineRecord) string {
+	if r.State == stateCompleted && r.Result != nil {
+		return *r.Result
+	}
+
+	return r.State
+}
+
+func convertTimelineJob(buildID string, r azureTimelineRecord, tasks []azureTimelineRecord) models.PipelineJob {
+	job := models.PipelineJob{
+		Id:         strings.Join([]string{buildID, r.ID, strconv.Itoa(r.Attempt)}, jobIDSeparator),
+		Name:       r.Name,
+		Status:     recordStatus(r),
+		StartedAt:  r.StartTime,
+		FinishedAt: r.FinishTime,
+	}
+
+	if job.StartedAt != nil && job.FinishedAt != nil {
+		duration := float32(job.FinishedAt.Sub(*job.StartedAt).Seconds())
+		job.Duration = &duration
+	}
+
+	if len(tasks) > 0 {
+		sort.SliceStable(tasks, func(i, k int) bool {
+			return tasks[i].Order < tasks[k].Order
+		})
+
+		stages := make([]models.PipelineJobStage, 0, len(tasks))
+		for i, task := range tasks {
+			stages = append(stages, models.PipelineJobStage{
+				Number:     i + 1,
+				Name:       task.Name,
+				Status:     recordStatus(task),
+				StartedAt:  task.StartTime,
+				FinishedAt: task.FinishTime,
+			})
+		}
+
+		job.Stages = &stages
+	}
+
+	return job
+}
+
+func convertBuild(build azureBuild) models.Pipeline {
+	pipeline := models.Pipeline{
+		Id:        strconv.Itoa(build.ID),
+		Status:    normalizeBuildStatus(build.Status, build.Result),
+		Ref:       strings.TrimPrefix(build.SourceBranch, branchRefPrefix),
+		Sha:       build.SourceVersion,
+		WebUrl:    build.Links.Web.Href,
+		CreatedAt: build.QueueTime,
+	}
+
+	if build.FinishTime != nil {
+		pipeline.UpdatedAt = build.FinishTime
+	} else if build.StartTime != nil {
+		pipeline.UpdatedAt = build.StartTime
+	}
+
+	if build.Project.ID != "" {
+		pipeline.ProjectId = &build.Project.ID
+	}
+
+	if build.Reason != "" {
+		source := normalizeBuildReason(build.Reason)
+		pipeline.Source = &source
+	}
+
+	return pipeline
+}
+
+// normalizeBuildStatus maps an Azure Pipelines build status (or pipeline run state) and result to
+// the unified pipeline status enum.
+func normalizeBuildStatus(status, result string) models.PipelineStatus {
+	switch status {
+	case "notStarted", "postponed", "unknown":
+		return models.PipelineStatusPending
+	case "inProgress", "cancelling", "canceling":
+		return models.PipelineStatusRunning
+	case stateCompleted:
+		switch result {
+		case "succeeded", "partiallySucceeded":
+			return models.PipelineStatusSuccess
+		case "canceled":
+			return models.PipelineStatusCancelled
+		case "skipped":
+			return models.PipelineStatusSkipped
+		default:
+			return models.PipelineStatusFailed
+		}
+	default:
+		return models.PipelineStatusPending
+	}
+}
+
+// normalizeBuildReason maps the reason a build was queued to the unified pipeline source enum.
+func normalizeBuildReason(reason string) models.PipelineSource {
+	switch reason {
+	case "manual", "userCreated":
+		return models.PipelineSourceManual
+	case "individualCI", "batchedCI":
+		return models.PipelineSourcePush
+	case "pullRequest":
+		return models.PipelineSourceMergeRequest
+	case "schedule":
+		return models.PipelineSourceSchedule
+	case "buildCompletion", "resourceTrigger", "triggered":
+		return models.PipelineSourceTrigger
+	default:
+		return models.PipelineSourceOther
+	}
+}
+
+// mapPipelineStatusToAzure maps a unified status filter to build list query parameters.
+func mapPipelineStatusToAzure(status string) map[string]string {
+	switch status {
+	case "pending":
+		return map[string]string{"statusFilter": "notStarted"}
+	case "running":
+		return map[string]string{"statusFilter": "inProgress"}
+	case "success":
+		return map[string]string{"statusFilter": stateCompleted, "resultFilter": "succeeded"}
+	case "failed":
+		return map[string]string{"statusFilter": stateCompleted, "resultFilter": "failed"}
+	case "cancelled":
+		return map[string]string{"statusFilter": stateCompleted, "resultFilter": "canceled"}
+	default:
+		return nil
+	}
+}
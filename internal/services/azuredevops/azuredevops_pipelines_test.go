@@ -0,0 +1,300 @@
+package azuredevops
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+)
+
+const (
+	testRepoID   = "5febef5a-833d-4e14-b9c0-14cb638f91e6"
+	timelinePath = "GET /contoso/Platform/_apis/build/builds/310/timeline"
+	unitTestsJob = "c0000000-0000-0000-0000-000000000002"
+)
+
+func TestAzureDevOpsProviderListPipelines(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /contoso/Platform/_apis/git/repositories/api": {fixture: "repository.json"},
+		"GET /contoso/Platform/_apis/build/builds": {
+			fixture: "builds.json",
+			headers: map[string]string{continuationHeader: "next"},
+			query: map[string]string{
+				"repositoryId":   testRepoID,
+				"repositoryType": "TfsGit",
+				"branchName":     "refs/heads/main",
+				"statusFilter":   "completed",
+				"resultFilter":   "failed",
+				"$top":           "3",
+			},
+		},
+	})
+
+	resp, err := NewAzureDevOpsProvider().ListPipelines(context.Background(), "Platform/api",
+		testSettings(server.URL), models.PipelineListOptions{
+			Ref:     pointer.To("main"),
+			Status:  pointer.To("failed"),
+			Page:    3,
+			PerPage: 1,
+		})
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, resp.Pagination.Total)
+	require.Len(t, resp.Data, 1)
+
+	build := resp.Data[0]
+	assert.Equal(t, "310", build.Id)
+	assert.Equal(t, models.PipelineStatusFailed, build.Status)
+	assert.Equal(t, "refs/pull/17/merge", build.Ref)
+	assert.Equal(t, "0a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b", build.Sha)
+	assert.Equal(t, time.Date(2025, 3, 2, 11, 0, 0, 0, time.UTC), build.CreatedAt)
+	assert.Equal(t, time.Date(2025, 3, 2, 11, 2, 0, 0, time.UTC), *build.UpdatedAt)
+	assert.Equal(t, models.PipelineSourceMergeRequest, *build.Source)
+}
+
+func TestAzureDevOpsProviderListPipelinesFirstPage(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /contoso/Platform/_apis/git/repositories/api": {fixture: "repository.json"},
+		"GET /contoso/Platform/_apis/build/builds":         {fixture: "builds.json"},
+	})
+
+	resp, err := NewAzureDevOpsProvider().ListPipelines(context.Background(), "Platform/api",
+		testSettings(server.URL), models.PipelineListOptions{Page: 1, PerPage: 20})
+
+	require.NoError(t, err)
+	assert.Equal(t, 3, resp.Pagination.Total)
+	require.Len(t, resp.Data, 3)
+
+	assert.Equal(t, models.PipelineStatusRunning, resp.Data[0].Status)
+	assert.Equal(t, "main", resp.Data[0].Ref)
+	assert.Equal(t, models.PipelineSourceManual, *resp.Data[0].Source)
+	assert.Equal(t, "https://dev.azure.com/contoso/3b0e8e3a-c5c5-4a52-b61e-4a6f8e0b51b3/_build/results?buildId=312",
+		resp.Data[0].WebUrl)
+
+	assert.Equal(t, models.PipelineStatusSuccess, resp.Data[1].Status)
+	assert.Equal(t, models.PipelineSourcePush, *resp.Data[1].Source)
+}
+
+func TestAzureDevOpsProviderListPipelinesRejectsDistantPages(t *testing.T) {
+	for _, page := range []int{maxListedBuilds/20 + 1, math.MaxInt} {
+		_, err := NewAzureDevOpsProvider().ListPipelines(context.Background(), "Platform/api",
+			testSettings("http://127.0.0.1:0"), models.PipelineListOptions{Page: page, PerPage: 20})
+
+		require.Error(t, err, "page %d", page)
+		assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+	}
+}
+
+func TestAzureDevOpsProviderListPipelineJobs(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		timelinePath: {fixture: "timeline.json"},
+	})
+
+	jobs, err := NewAzureDevOpsProvider().ListPipelineJobs(context.Background(), "Platform/api", "310",
+		testSettings(server.URL))
+
+	require.NoError(t, err)
+	require.Len(t, jobs, 3)
+
+	assert.Equal(t, "310/c0000000-0000-0000-0000-000000000001/1", jobs[0].Id)
+	assert.Equal(t, "Compile", jobs[0].Name)
+	assert.Equal(t, "Build", jobs[0].Stage)
+	assert.Equal(t, "succeeded", jobs[0].Status)
+	require.NotNil(t, jobs[0].Duration)
+	assert.InDelta(t, 15, *jobs[0].Duration, 0.001)
+	assert.Nil(t, jobs[0].Stages)
+
+	assert.Equal(t, "310/"+unitTestsJob+"/2", jobs[1].Id)
+	assert.Equal(t, "failed", jobs[1].Status)
+	assert.Equal(t, server.URL+"/contoso/Platform/_build/results?buildId=310&view=logs&j="+unitTestsJob,
+		pointer.ValueOrEmpty(jobs[1].WebUrl))
+	require.NotNil(t, jobs[1].Stages)
+	require.Len(t, *jobs[1].Stages, 2)
+	assert.Equal(t, "Checkout", (*jobs[1].Stages)[0].Name)
+	assert.Equal(t, "go test ./...", (*jobs[1].Stages)[1].Name)
+	assert.Equal(t, "failed", (*jobs[1].Stages)[1].Status)
+
+	assert.Equal(t, "Deploy staging", jobs[2].Name)
+	assert.Equal(t, "Deploy", jobs[2].Stage)
+	assert.Equal(t, "pending", jobs[2].Status)
+}
+
+func TestAzureDevOpsProviderGetJobTrace(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		timelinePath: {fixture: "timeline.json"},
+		timelinePath + "/f0000000-0000-0000-0000-000000000001": {fixture: "timeline_attempt1.json"},
+		"GET /contoso/Platform/_apis/build/builds/310/logs/7":  {fixture: "job_log.txt"},
+		"GET /contoso/Platform/_apis/build/builds/310/logs/3":  {status: http.StatusNotFound},
+	})
+
+	expected, err := os.ReadFile(filepath.Join("testdata", "job_log.txt"))
+	require.NoError(t, err)
+
+	provider := NewAzureDevOpsProvider()
+	settings := testSettings(server.URL)
+
+	content, truncated, err := provider.GetJobTrace(context.Background(), "Platform/api", "310/"+unitTestsJob+"/2",
+		settings)
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, string(expected), content)
+
+	// The first attempt's log is looked up through its own timeline.
+	_, _, err = provider.GetJobTrace(context.Background(), "Platform/api", "310/"+unitTestsJob+"/1", settings)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, gferrors.ErrNotFound))
+
+	content, truncated, err = provider.GetJobTrace(context.Background(), "Platform/api",
+		"310/c0000000-0000-0000-0000-000000000003/1", settings)
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Empty(t, content)
+
+	_, _, err = provider.GetJobTrace(context.Background(), "Platform/api", "310/"+unitTestsJob+"/5", settings)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, gferrors.ErrNotFound))
+}
+
+func TestAzureDevOpsProviderGetJobTraceTruncates(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc(timelinePath, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"records": [{"id": "job", "type": "Job", "attempt": 1, "log": {"id": 1}}]}`))
+	})
+	mux.HandleFunc("GET /contoso/Platform/_apis/build/builds/310/logs/1", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("x", common.MaxTraceBytes+10)))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	content, truncated, err := NewAzureDevOpsProvider().GetJobTrace(context.Background(), "Platform/api",
+		"310/job/1", testSettings(server.URL))
+
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Len(t, content, common.MaxTraceBytes)
+}
+
+func TestParseJobID(t *testing.T) {
+	buildID, recordID, attempt, err := parseJobID("310/" + unitTestsJob + "/2")
+	require.NoError(t, err)
+	assert.Equal(t, "310", buildID)
+	assert.Equal(t, unitTestsJob, recordID)
+	assert.Equal(t, 2, attempt)
+
+	for _, id := range []string{"310", "310/" + unitTestsJob, "abc/" + unitTestsJob + "/1", "310//1", "310/x/y"} {
+		_, _, _, err := parseJobID(id)
+		require.Error(t, err, id)
+		assert.True(t, errors.Is(err, gferrors.ErrBadRequest), id)
+	}
+}
+
+func TestAzureDevOpsProviderTriggerPipeline(t *testing.T) {
+	var gotRun map[string]any
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /contoso/Platform/_apis/git/repositories/api", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		body, err := os.ReadFile(filepath.Join("testdata", "repository.json"))
+		require.NoError(t, err)
+		_, _ = w.Write(body)
+	})
+	mux.HandleFunc("GET /contoso/Platform/_apis/build/definitions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testRepoID, r.URL.Query().Get("repositoryId"))
+		assert.Equal(t, "api-release", r.URL.Query().Get("name"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"count": 1, "value": [{"id": 13, "name": "api-release"}]}`))
+	})
+	mux.HandleFunc("POST /contoso/Platform/_apis/pipelines/13/runs", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotRun))
+		w.Header().Set("Content-Type", "application/json")
+		body, err := os.ReadFile(filepath.Join("testdata", "run.json"))
+		require.NoError(t, err)
+		_, _ = w.Write(body)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	resp, err := NewAzureDevOpsProvider().TriggerPipeline(context.Background(), "Platform/api",
+		testSettings(server.URL), models.PipelineTriggerOptions{
+			Ref:      "main",
+			Pipeline: pointer.To("api-release"),
+			Variables: []models.PipelineVariable{
+				{Key: "environment", Value: "staging"},
+				{Key: "apiKey", Value: "s3cr3t", Secured: pointer.To(true)},
+			},
+		})
+
+	require.NoError(t, err)
+	assert.Equal(t, "313", resp.Id)
+	assert.Equal(t, "main", resp.Ref)
+	assert.Equal(t, string(models.PipelineStatusRunning), resp.Status)
+	assert.Equal(t, "https://dev.azure.com/contoso/3b0e8e3a-c5c5-4a52-b61e-4a6f8e0b51b3/_build/results?buildId=313",
+		resp.WebUrl)
+
+	assert.Equal(t, map[string]any{
+		"resources": map[string]any{
+			"repositories": map[string]any{
+				"self": map[string]any{"refName": "refs/heads/main"},
+			},
+		},
+		"variables": map[string]any{
+			"environment": map[string]any{"value": "staging", "isSecret": false},
+			"apiKey":      map[string]any{"value": "s3cr3t", "isSecret": true},
+		},
+	}, gotRun)
+}
+
+func TestAzureDevOpsProviderTriggerPipelineRequiresNameForSeveralPipelines(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /contoso/Platform/_apis/git/repositories/api": {fixture: "repository.json"},
+		"GET /contoso/Platform/_apis/build/definitions":    {fixture: "definitions.json"},
+	})
+
+	_, err := NewAzureDevOpsProvider().TriggerPipeline(context.Background(), "Platform/api",
+		testSettings(server.URL), models.PipelineTriggerOptions{Ref: "main"})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, gferrors.ErrBadRequest))
+	assert.Contains(t, err.Error(), "api-ci, api-release")
+}
+
+func TestNormalizeBuildStatus(t *testing.T) {
+	tests := []struct {
+		status string
+		result string
+		want   models.PipelineStatus
+	}{
+		{status: "notStarted", want: models.PipelineStatusPending},
+		{status: "postponed", want: models.PipelineStatusPending},
+		{status: "inProgress", want: models.PipelineStatusRunning},
+		{status: "cancelling", want: models.PipelineStatusRunning},
+		{status: "completed", result: "succeeded", want: models.PipelineStatusSuccess},
+		{status: "completed", result: "partiallySucceeded", want: models.PipelineStatusSuccess},
+		{status: "completed", result: "failed", want: models.PipelineStatusFailed},
+		{status: "completed", result: "canceled", want: models.PipelineStatusCancelled},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status+"/"+tt.result, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeBuildStatus(tt.status, tt.result))
+		})
+	}
+}
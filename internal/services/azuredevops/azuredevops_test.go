@@ -0,0 +1,256 @@
+package azuredevops
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+)
+
+// recorded is a response captured from Azure DevOps Services and stored under testdata.
+type recorded struct {
+	fixture string
+	status  int
+	headers map[string]string
+	query   map[string]string
+}
+
+// newRecordedServer replays recorded responses keyed by ServeMux pattern
+// (e.g. "GET /contoso/_apis/projects"). Every request must carry the test token and API version.
+func newRecordedServer(t *testing.T, routes map[string]recorded) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	for pattern, rec := range routes {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			user, password, ok := r.BasicAuth()
+			assert.True(t, ok, "request must use basic auth")
+			assert.Empty(t, user)
+			assert.Equal(t, "test-token", password)
+			assert.Equal(t, apiVersion, r.URL.Query().Get("api-version"))
+
+			for k, v := range rec.query {
+				assert.Equal(t, v, r.URL.Query().Get(k), "query parameter %s", k)
+			}
+
+			if filepath.Ext(rec.fixture) == ".json" {
+				w.Header().Set("Content-Type", "application/json")
+			}
+
+			for k, v := range rec.headers {
+				w.Header().Set(k, v)
+			}
+
+			if rec.status != 0 {
+				w.WriteHeader(rec.status)
+			}
+
+			if rec.fixture != "" {
+				body, err := os.ReadFile(filepath.Join("testdata", rec.fixture))
+				require.NoError(t, err)
+
+				_, _ = w.Write(body)
+			}
+		})
+	}
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func testSettings(serverURL string) krci.GitServerSettings {
+	return krci.GitServerSettings{
+		Url:           serverURL + "/contoso",
+		Token:         "test-token",
+		GitProvider:   "azuredevops",
+		GitServerName: "azure",
+	}
+}
+
+func TestAzureDevOpsProviderGetRepository(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /contoso/Platform/_apis/git/repositories/api": {fixture: "repository.json"},
+	})
+
+	repo, err := NewAzureDevOpsProvider().GetRepository(context.Background(), "Platform", "api",
+		testSettings(server.URL))
+
+	require.NoError(t, err)
+	assert.Equal(t, "5febef5a-833d-4e14-b9c0-14cb638f91e6", repo.Id)
+	assert.Equal(t, "api", repo.Name)
+	assert.Equal(t, "Platform", pointer.ValueOrEmpty(repo.Owner))
+	assert.Equal(t, "main", pointer.ValueOrEmpty(repo.DefaultBranch))
+	assert.Equal(t, "https://dev.azure.com/contoso/Platform/_git/api", pointer.ValueOrEmpty(repo.Url))
+	assert.Equal(t, models.RepositoryVisibilityPrivate, *repo.Visibility)
+}
+
+func TestAzureDevOpsProviderGetRepositoryErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "not found", status: http.StatusNotFound, wantErr: gferrors.ErrNotFound},
+		{name: "unauthorized", status: http.StatusUnauthorized, wantErr: gferrors.ErrUnauthorized},
+		{name: "sign-in page", status: http.StatusNonAuthoritativeInfo, wantErr: gferrors.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRecordedServer(t, map[string]recorded{
+				"GET /contoso/Platform/_apis/git/repositories/api": {status: tt.status},
+			})
+
+			_, err := NewAzureDevOpsProvider().GetRepository(context.Background(), "Platform", "api",
+				testSettings(server.URL))
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.wantErr))
+		})
+	}
+}
+
+func TestAzureDevOpsProviderListRepositories(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /contoso/Platform/_apis/git/repositories": {fixture: "repositories.json"},
+	})
+
+	repos, err := NewAzureDevOpsProvider().ListRepositories(context.Background(), "Platform",
+		testSettings(server.URL), models.ListOptions{Name: pointer.To("api")})
+
+	require.NoError(t, err)
+	require.Len(t, repos, 2)
+	assert.Equal(t, "api", repos[0].Name)
+	assert.Equal(t, "API-Gateway", repos[1].Name)
+	assert.Nil(t, repos[1].DefaultBranch)
+	assert.Equal(t, models.RepositoryVisibilityPublic, *repos[1].Visibility)
+}
+
+func TestAzureDevOpsProviderListUserOrganizations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/contoso/_apis/projects", r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+
+		fixture := "projects_page1.json"
+		if r.URL.Query().Get("continuationToken") == "next" {
+			fixture = "projects_page2.json"
+		} else {
+			w.Header().Set(continuationHeader, "next")
+		}
+
+		body, err := os.ReadFile(filepath.Join("testdata", fixture))
+		require.NoError(t, err)
+
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	orgs, err := NewAzureDevOpsProvider().ListUserOrganizations(context.Background(), testSettings(server.URL))
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Organization{
+		{Id: "3b0e8e3a-c5c5-4a52-b61e-4a6f8e0b51b3", Name: "Platform"},
+		{Id: "7a1c2d3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e", Name: "Tooling"},
+	}, orgs)
+}
+
+func TestAzureDevOpsProviderListBranches(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /contoso/Platform/_apis/git/repositories/api/refs": {
+			fixture: "refs.json",
+			query:   map[string]string{"filter": "heads/", "filterContains": "ma"},
+		},
+	})
+
+	branches, err := NewAzureDevOpsProvider().ListBranches(context.Background(), "Platform", "api",
+		testSettings(server.URL), models.ListOptions{Name: pointer.To("ma")})
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Branch{{Name: "develop"}, {Name: "main"}}, branches)
+}
+
+func TestAzureDevOpsProviderListPullRequests(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /contoso/Platform/_apis/git/repositories/api/pullrequests": {
+			fixture: "pullrequests.json",
+			query:   map[string]string{"searchCriteria.status": "completed", "$skip": "10", "$top": "10"},
+		},
+	})
+
+	resp, err := NewAzureDevOpsProvider().ListPullRequests(context.Background(), "Platform", "api",
+		testSettings(server.URL), models.PullRequestListOptions{State: "merged", Page: 2, PerPage: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, 11, resp.Pagination.Total)
+	require.Len(t, resp.Data, 1)
+
+	pr := resp.Data[0]
+	assert.Equal(t, "17", pr.Id)
+	assert.Equal(t, 17, pr.Number)
+	assert.Equal(t, models.PullRequestStateMerged, pr.State)
+	assert.Equal(t, "feature/health", pr.SourceBranch)
+	assert.Equal(t, "main", pr.TargetBranch)
+	assert.Equal(t, "Jamie Doe", pr.Author.Name)
+	assert.Equal(t, "https://dev.azure.com/contoso/Platform/_git/api/pullrequest/17", pr.Url)
+	assert.Equal(t, "Adds /healthz", pointer.ValueOrEmpty(pr.Description))
+	assert.Equal(t, "c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2", pointer.ValueOrEmpty(pr.CommitSha))
+	assert.False(t, pointer.ValueOrEmpty(pr.Draft))
+	assert.Equal(t, time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC), pr.CreatedAt)
+	assert.Equal(t, time.Date(2025, 3, 2, 8, 30, 0, 0, time.UTC), pr.UpdatedAt)
+}
+
+func TestConvertPRState(t *testing.T) {
+	assert.Equal(t, models.PullRequestStateOpen, convertPRState("active"))
+	assert.Equal(t, models.PullRequestStateMerged, convertPRState("completed"))
+	assert.Equal(t, models.PullRequestStateClosed, convertPRState("abandoned"))
+}
+
+func TestCollectionURL(t *testing.T) {
+	tests := []struct {
+		serverURL string
+		want      string
+		wantErr   bool
+	}{
+		{serverURL: "https://dev.azure.com/contoso", want: "https://dev.azure.com/contoso"},
+		{serverURL: "https://dev.azure.com/contoso/", want: "https://dev.azure.com/contoso"},
+		{serverURL: "https://dev.azure.com/contoso:443", want: "https://dev.azure.com/contoso"},
+		{
+			serverURL: "https://tfs.example.com/tfs/DefaultCollection:8443",
+			want:      "https://tfs.example.com:8443/tfs/DefaultCollection",
+		},
+		{serverURL: "http://tfs.example.com/tfs/DefaultCollection:80", want: "http://tfs.example.com/tfs/DefaultCollection"},
+		{serverURL: "https://dev.azure.com", wantErr: true},
+		{serverURL: "https://dev.azure.com:443", wantErr: true},
+		{serverURL: "dev.azure.com/contoso", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.serverURL, func(t *testing.T) {
+			got, err := collectionURL(tt.serverURL)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.True(t, errors.Is(err, gferrors.ErrBadRequest))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
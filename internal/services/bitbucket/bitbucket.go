@@ -5,8 +5,10 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -19,6 +21,7 @@ import (
 	"github.com/KubeRocketCI/gitfusion/internal/services/common"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
 	bitbucketpkg "github.com/KubeRocketCI/gitfusion/pkg/bitbucket"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
 )
 
 // defaultBitbucketAPIURL is the base URL for the Bitbucket Cloud REST API.
@@ -28,6 +31,9 @@ import (
 // would require changes across all Bitbucket methods and the underlying library.
 const defaultBitbucketAPIURL = "https://api.bitbucket.org/2.0"
 
+// bitbucketWebURL is the base URL of the Bitbucket Cloud web UI.
+const bitbucketWebURL = "https://bitbucket.org"
+
 // isBitbucketNotFound checks whether the error from the go-bitbucket library
 // indicates a 404 response. The library returns *UnexpectedResponseStatusError
 // with Status set to the HTTP status text (e.g. "404 Not Found").
@@ -504,8 +510,21 @@ type bitbucketPipeline struct {
 		Name string `json:"name"`
 	} `json:"trigger"`
 
-	CreatedOn   string `json:"created_on"`
-	CompletedOn string `json:"completed_on"`
+	Creator struct {
+		DisplayName string `json:"display_name"`
+		Nickname    string `json:"nickname"`
+		AccountID   string `json:"account_id"`
+		UUID        string `json:"uuid"`
+		Links       struct {
+			Avatar struct {
+				Href string `json:"href"`
+			} `json:"avatar"`
+		} `json:"links"`
+	} `json:"creator"`
+
+	CreatedOn         string `json:"created_on"`
+	CompletedOn       string `json:"completed_on"`
+	DurationInSeconds int    `json:"duration_in_seconds"`
 
 	Links struct {
 		HTML struct {
@@ -514,7 +533,9 @@ type bitbucketPipeline struct {
 	} `json:"links"`
 }
 
-// ListPipelines returns pipelines for a Bitbucket repository using the REST API.
+// ListPipelines returns pipelines for a Bitbucket repository using the REST API. The pipelines
+// API cannot filter by source, creator or last update, so these are applied to each page after
+// listing and such pages may hold fewer pipelines than requested.
 func (b *BitbucketService) ListPipelines(
 	ctx context.Context,
 	project string,
@@ -539,6 +560,10 @@ func (b *BitbucketService) ListPipelines(
 	queryParams.Set("pagelen", strconv.Itoa(opts.PerPage))
 	queryParams.Set("sort", "-created_on")
 
+	if opts.Ascending {
+		queryParams.Set("sort", "created_on")
+	}
+
 	// Handle "skipped" status early — Bitbucket has no equivalent
 	if opts.Status != nil && *opts.Status == "skipped" {
 		return &models.PipelinesResponse{
@@ -554,9 +579,7 @@ func (b *BitbucketService) ListPipelines(
 	var queryParts []string
 
 	if opts.Ref != nil && *opts.Ref != "" {
-		sanitizedRef := strings.ReplaceAll(*opts.Ref, `\`, `\\`)
-		sanitizedRef = strings.ReplaceAll(sanitizedRef, `"`, `\"`)
-		queryParts = append(queryParts, fmt.Sprintf(`target.ref_name="%s"`, sanitizedRef))
+		queryParts = append(queryParts, "target.ref_name="+bitbucketQueryString(*opts.Ref))
 	}
 
 	if opts.Status != nil {
@@ -565,10 +588,133 @@ func (b *BitbucketService) ListPipelines(
 		}
 	}
 
+	if opts.Sha != nil {
+		queryParts = append(queryParts, "target.commit.hash="+bitbucketQueryString(*opts.Sha))
+	}
+
+	// A pipeline last updated before a time was created before it too, so the creation filter
+	// narrows the listing down for the update filter applied below.
+	if opts.UpdatedBefore != nil {
+		queryParts = append(queryParts, "created_on<="+opts.UpdatedBefore.UTC().Format(time.RFC3339))
+	}
+
 	if len(queryParts) > 0 {
 		queryParams.Set("q", strings.Join(queryParts, " AND "))
 	}
 
+	bbResp, err := b.listBitbucketPipelines(ctx, project, apiURL, username, password, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Pipeline, 0, len(bbResp.Values))
+
+	for i := range bbResp.Values {
+		pipeline, err := mapBitbucketPipeline(&bbResp.Values[i])
+		if err != nil {
+			return nil, err
+		}
+
+		if !matchesBitbucketPipelinePostFilters(&bbResp.Values[i], &pipeline, opts) {
+			continue
+		}
+
+		result = append(result, pipeline)
+	}
+
+	return &models.PipelinesResponse{
+		Data: result,
+		Pagination: models.Pagination{
+			Total:   bbResp.Size,
+			Page:    &opts.Page,
+			PerPage: &opts.PerPage,
+		},
+	}, nil
+}
+
+// ListPullRequestPipelines returns the pipelines on the source branch of a pull request, newest
+// first: the pipelines of pushes to the branch and those Bitbucket ran for the pull request.
+func (b *BitbucketService) ListPullRequestPipelines(
+	ctx context.Context,
+	project string,
+	pullRequest int,
+	settings krci.GitServerSettings,
+	opts models.PipelineListOptions,
+) (*models.PipelinesResponse, error) {
+	username, password, err := decodeBitbucketToken(settings.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bitbucket token: %w", err)
+	}
+
+	workspace, repoSlug, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	repoURL := fmt.Sprintf("%s/repositories/%s/%s",
+		defaultBitbucketAPIURL, url.PathEscape(workspace), url.PathEscape(repoSlug))
+
+	var pr bitbucketPR
+
+	resp, err := b.httpClient.R().
+		SetContext(ctx).
+		SetBasicAuth(username, password).
+		SetResult(&pr).
+		Get(fmt.Sprintf("%s/pullrequests/%d", repoURL, pullRequest))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pull request %d for %s: %w", pullRequest, project, err)
+	}
+
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		return nil, fmt.Errorf("project %s or pull request %d: %w", project, pullRequest, gferrors.ErrNotFound)
+	case resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden:
+		return nil, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case resp.IsError():
+		return nil, fmt.Errorf("failed to get pull request %d for %s: status %d, body: %s",
+			pullRequest, project, resp.StatusCode(), resp.String())
+	}
+
+	// target.branch matches both the ref of branch pipelines and the source branch of pull
+	// request pipelines, which have no ref.
+	queryParams := url.Values{}
+	queryParams.Set("page", strconv.Itoa(opts.Page))
+	queryParams.Set("pagelen", strconv.Itoa(opts.PerPage))
+	queryParams.Set("sort", "-created_on")
+	queryParams.Set("target.branch", pr.Source.Branch.Name)
+
+	bbResp, err := b.listBitbucketPipelines(ctx, project, repoURL+"/pipelines/", username, password, queryParams)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Pipeline, 0, len(bbResp.Values))
+
+	for i := range bbResp.Values {
+		pipeline, err := mapBitbucketPipeline(&bbResp.Values[i])
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, pipeline)
+	}
+
+	return &models.PipelinesResponse{
+		Data: result,
+		Pagination: models.Pagination{
+			Total:   bbResp.Size,
+			Page:    &opts.Page,
+			PerPage: &opts.PerPage,
+		},
+	}, nil
+}
+
+// listBitbucketPipelines requests a page of pipelines from apiURL with the given query parameters.
+func (b *BitbucketService) listBitbucketPipelines(
+	ctx context.Context,
+	project, apiURL, username, password string,
+	queryParams url.Values,
+) (*bitbucketPipelinesResponse, error) {
 	var bbResp bitbucketPipelinesResponse
 
 	resp, err := b.httpClient.R().
@@ -594,53 +740,147 @@ func (b *BitbucketService) ListPipelines(
 			project, resp.StatusCode(), resp.String())
 	}
 
-	result := make([]models.Pipeline, 0, len(bbResp.Values))
+	return &bbResp, nil
+}
 
-	for _, p := range bbResp.Values {
-		var resultName string
-		if p.State.Result != nil {
-			resultName = p.State.Result.Name
-		}
+// GetPipeline returns a single Bitbucket pipeline with its duration and creator. Bitbucket reports
+// neither queued time nor coverage, and the pipeline target carries only the commit hash, so the
+// commit title needs a second request; a failed commit lookup only leaves the title out.
+func (b *BitbucketService) GetPipeline(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+) (*models.Pipeline, error) {
+	username, password, err := decodeBitbucketToken(settings.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bitbucket token: %w", err)
+	}
 
-		createdAt, err := time.Parse(time.RFC3339Nano, p.CreatedOn)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse created_on time %q: %w", p.CreatedOn, err)
+	workspace, repoSlug, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if pipelineID == "" {
+		return nil, fmt.Errorf("pipeline ID is required: %w", gferrors.ErrBadRequest)
+	}
+
+	repoURL := fmt.Sprintf("%s/repositories/%s/%s",
+		defaultBitbucketAPIURL, url.PathEscape(workspace), url.PathEscape(repoSlug))
+
+	var bbPipeline bitbucketPipeline
+
+	resp, err := b.httpClient.R().
+		SetContext(ctx).
+		SetBasicAuth(username, password).
+		SetResult(&bbPipeline).
+		Get(repoURL + "/pipelines/" + url.PathEscape(bitbucketUUID(pipelineID)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pipeline %s for %s: %w", pipelineID, project, err)
+	}
+
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		return nil, fmt.Errorf("project %s or pipeline %s: %w", project, pipelineID, gferrors.ErrNotFound)
+	case resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden:
+		return nil, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case resp.IsError():
+		return nil, fmt.Errorf("failed to get pipeline %s for %s: status %d, body: %s",
+			pipelineID, project, resp.StatusCode(), resp.String())
+	}
+
+	pipeline, err := mapBitbucketPipeline(&bbPipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	if bbPipeline.CompletedOn != "" {
+		duration := float32(bbPipeline.DurationInSeconds)
+		pipeline.Duration = &duration
+	}
+
+	if bbPipeline.Creator.UUID != "" {
+		pipeline.TriggeredBy = &models.Owner{
+			Id:   bbPipeline.Creator.UUID,
+			Name: bbPipeline.Creator.DisplayName,
 		}
 
-		pipeline := models.Pipeline{
-			Id:        strings.Trim(p.UUID, "{}"),
-			Status:    normalizeBitbucketPipelineStatus(p.State.Name, resultName),
-			Ref:       p.Target.RefName,
-			Sha:       p.Target.Commit.Hash,
-			WebUrl:    p.Links.HTML.Href,
-			CreatedAt: createdAt,
+		if bbPipeline.Creator.Links.Avatar.Href != "" {
+			avatarURL := bbPipeline.Creator.Links.Avatar.Href
+			pipeline.TriggeredBy.AvatarUrl = &avatarURL
 		}
+	}
 
-		if p.CompletedOn != "" {
-			completedAt, err := time.Parse(time.RFC3339Nano, p.CompletedOn)
-			if err != nil {
-				return nil, fmt.Errorf("failed to parse completed_on time %q: %w", p.CompletedOn, err)
-			}
+	if title := b.commitTitle(ctx, repoURL, pipeline.Sha, username, password); title != "" {
+		pipeline.CommitTitle = &title
+	}
 
-			pipeline.UpdatedAt = &completedAt
-		}
+	return &pipeline, nil
+}
+
+// commitTitle returns the first line of a commit message, or "" if the commit cannot be read.
+func (b *BitbucketService) commitTitle(ctx context.Context, repoURL, hash, username, password string) string {
+	if hash == "" {
+		return ""
+	}
+
+	var commit struct {
+		Message string `json:"message"`
+	}
+
+	resp, err := b.httpClient.R().
+		SetContext(ctx).
+		SetBasicAuth(username, password).
+		SetResult(&commit).
+		Get(repoURL + "/commit/" + url.PathEscape(hash))
+	if err != nil || resp.IsError() {
+		slog.Warn("Failed to get pipeline commit title", "repository", repoURL, "sha", hash, "error", err)
+
+		return ""
+	}
+
+	title, _, _ := strings.Cut(commit.Message, "\n")
+
+	return title
+}
 
-		if p.Trigger.Name != "" {
-			source := normalizeBitbucketPipelineTrigger(p.Trigger.Name)
-			pipeline.Source = &source
+// mapBitbucketPipeline converts a Bitbucket pipeline to the unified Pipeline model.
+func mapBitbucketPipeline(p *bitbucketPipeline) (models.Pipeline, error) {
+	var resultName string
+	if p.State.Result != nil {
+		resultName = p.State.Result.Name
+	}
+
+	createdAt, err := time.Parse(time.RFC3339Nano, p.CreatedOn)
+	if err != nil {
+		return models.Pipeline{}, fmt.Errorf("failed to parse created_on time %q: %w", p.CreatedOn, err)
+	}
+
+	pipeline := models.Pipeline{
+		Id:        strings.Trim(p.UUID, "{}"),
+		Status:    normalizeBitbucketPipelineStatus(p.State.Name, resultName),
+		Ref:       p.Target.RefName,
+		Sha:       p.Target.Commit.Hash,
+		WebUrl:    p.Links.HTML.Href,
+		CreatedAt: createdAt,
+	}
+
+	if p.CompletedOn != "" {
+		completedAt, err := time.Parse(time.RFC3339Nano, p.CompletedOn)
+		if err != nil {
+			return models.Pipeline{}, fmt.Errorf("failed to parse completed_on time %q: %w", p.CompletedOn, err)
 		}
 
-		result = append(result, pipeline)
+		pipeline.UpdatedAt = &completedAt
 	}
 
-	return &models.PipelinesResponse{
-		Data: result,
-		Pagination: models.Pagination{
-			Total:   bbResp.Size,
-			Page:    &opts.Page,
-			PerPage: &opts.PerPage,
-		},
-	}, nil
+	if p.Trigger.Name != "" {
+		source := normalizeBitbucketPipelineTrigger(p.Trigger.Name)
+		pipeline.Source = &source
+	}
+
+	return pipeline, nil
 }
 
 // normalizeBitbucketPipelineStatus maps Bitbucket pipeline state and result to the unified status enum.
@@ -686,6 +926,43 @@ func normalizeBitbucketPipelineTrigger(triggerName string) models.PipelineSource
 	}
 }
 
+// bitbucketQueryString quotes a value for a Bitbucket query expression.
+func bitbucketQueryString(value string) string {
+	value = strings.ReplaceAll(value, `\`, `\\`)
+
+	return `"` + strings.ReplaceAll(value, `"`, `\"`) + `"`
+}
+
+// matchesBitbucketPipelinePostFilters reports whether a pipeline passes the list filters the
+// Bitbucket API cannot apply. The creator matches by nickname, account ID or UUID, with or without
+// braces. Bitbucket reports no update time, so the completion time stands in for it, or the
+// creation time for unfinished pipelines.
+func matchesBitbucketPipelinePostFilters(
+	p *bitbucketPipeline,
+	pipeline *models.Pipeline,
+	opts models.PipelineListOptions,
+) bool {
+	if opts.Source != nil && (pipeline.Source == nil || string(*pipeline.Source) != *opts.Source) {
+		return false
+	}
+
+	if user := opts.TriggeredBy; user != nil && *user != p.Creator.Nickname && *user != p.Creator.AccountID &&
+		strings.Trim(*user, "{}") != strings.Trim(p.Creator.UUID, "{}") {
+		return false
+	}
+
+	updatedAt := pipeline.CreatedAt
+	if pipeline.UpdatedAt != nil {
+		updatedAt = *pipeline.UpdatedAt
+	}
+
+	if opts.UpdatedAfter != nil && updatedAt.Before(*opts.UpdatedAfter) {
+		return false
+	}
+
+	return opts.UpdatedBefore == nil || !updatedAt.After(*opts.UpdatedBefore)
+}
+
 // mapPipelineStatusToBitbucketQuery maps a unified status filter to a Bitbucket query expression.
 // NOTE: Some unified statuses map from multiple Bitbucket states (e.g. "failed" normalizes both
 // FAILED and ERROR), but the Bitbucket query API does not support OR expressions. The most common
@@ -712,13 +989,855 @@ func mapPipelineStatusToBitbucketQuery(status string) string {
 	}
 }
 
-// TriggerPipeline is not supported for Bitbucket.
+// bitbucketCommitHashPattern matches a full commit hash; TriggerPipeline runs such refs as commit targets.
+var bitbucketCommitHashPattern = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+type bitbucketPipelineTriggerRequest struct {
+	Target    bitbucketPipelineTarget     `json:"target"`
+	Variables []bitbucketPipelineVariable `json:"variables,omitempty"`
+}
+
+type bitbucketPipelineTarget struct {
+	Type     string                     `json:"type"`
+	RefType  string                     `json:"ref_type,omitempty"`
+	RefName  string                     `json:"ref_name,omitempty"`
+	Commit   *bitbucketPipelineCommit   `json:"commit,omitempty"`
+	Selector *bitbucketPipelineSelector `json:"selector,omitempty"`
+}
+
+type bitbucketPipelineCommit struct {
+	Type string `json:"type"`
+	Hash string `json:"hash"`
+}
+
+type bitbucketPipelineSelector struct {
+	Type    string `json:"type"`
+	Pattern string `json:"pattern"`
+}
+
+type bitbucketPipelineVariable struct {
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+	Secured bool   `json:"secured"`
+}
+
+// TriggerPipeline runs a Bitbucket pipeline for a branch, or for a commit when ref is a full commit hash.
+// A named pipeline selects a custom pipeline from bitbucket-pipelines.yml instead of the default one.
+// The returned pipeline ID is the pipeline UUID without braces, as in the pipelines list.
 func (b *BitbucketService) TriggerPipeline(
-	_ context.Context,
-	_ string,
-	_ string,
-	_ []models.PipelineVariable,
-	_ krci.GitServerSettings,
+	ctx context.Context,
+	project string,
+	settings krci.GitServerSettings,
+	opts models.PipelineTriggerOptions,
 ) (*models.PipelineResponse, error) {
-	return nil, fmt.Errorf("trigger pipeline is not supported for Bitbucket")
+	username, password, err := decodeBitbucketToken(settings.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bitbucket token: %w", err)
+	}
+
+	workspace, repoSlug, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf("%s/repositories/%s/%s/pipelines/",
+		defaultBitbucketAPIURL, url.PathEscape(workspace), url.PathEscape(repoSlug))
+
+	var bbResp bitbucketPipeline
+
+	resp, err := b.httpClient.R().
+		SetContext(ctx).
+		SetBasicAuth(username, password).
+		SetBody(buildBitbucketPipelineTriggerRequest(opts)).
+		SetResult(&bbResp).
+		Post(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to trigger pipeline for %s ref %s: %w", project, opts.Ref, err)
+	}
+
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		return nil, fmt.Errorf("project %s or ref %s: %w", project, opts.Ref, gferrors.ErrNotFound)
+	case resp.StatusCode() == http.StatusUnauthorized:
+		return nil, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case resp.StatusCode() == http.StatusBadRequest:
+		// Bitbucket answers 400 for a missing bitbucket-pipelines.yml, an unknown custom pipeline,
+		// or pipelines being disabled for the repository.
+		return nil, fmt.Errorf("trigger pipeline for %s ref %s: %s: %w",
+			project, opts.Ref, resp.String(), gferrors.ErrBadRequest)
+	case resp.IsError():
+		return nil, fmt.Errorf("failed to trigger pipeline for %s ref %s: status %d, body: %s",
+			project, opts.Ref, resp.StatusCode(), resp.String())
+	}
+
+	var resultName string
+	if bbResp.State.Result != nil {
+		resultName = bbResp.State.Result.Name
+	}
+
+	result := &models.PipelineResponse{
+		Id:     strings.Trim(bbResp.UUID, "{}"),
+		WebUrl: bbResp.Links.HTML.Href,
+		Status: string(normalizeBitbucketPipelineStatus(bbResp.State.Name, resultName)),
+		Ref:    opts.Ref,
+	}
+
+	// The create response carries no html link, so fall back to the pipeline results page.
+	if result.WebUrl == "" {
+		result.WebUrl = fmt.Sprintf("%s/%s/%s/pipelines/results/%d",
+			bitbucketWebURL, url.PathEscape(workspace), url.PathEscape(repoSlug), bbResp.BuildNumber)
+	}
+
+	if bbResp.Target.RefName != "" {
+		result.Ref = bbResp.Target.RefName
+	}
+
+	if bbResp.Target.Commit.Hash != "" {
+		result.Sha = &bbResp.Target.Commit.Hash
+	}
+
+	return result, nil
+}
+
+// buildBitbucketPipelineTriggerRequest maps trigger options to a Bitbucket pipeline target.
+// Bitbucket has no file variables, so the variable type is ignored.
+func buildBitbucketPipelineTriggerRequest(opts models.PipelineTriggerOptions) bitbucketPipelineTriggerRequest {
+	target := bitbucketPipelineTarget{
+		Type:    "pipeline_ref_target",
+		RefType: "branch",
+		RefName: opts.Ref,
+	}
+
+	if bitbucketCommitHashPattern.MatchString(opts.Ref) {
+		target = bitbucketPipelineTarget{
+			Type:   "pipeline_commit_target",
+			Commit: &bitbucketPipelineCommit{Type: "commit", Hash: opts.Ref},
+		}
+	}
+
+	if opts.Pipeline != nil && *opts.Pipeline != "" {
+		target.Selector = &bitbucketPipelineSelector{Type: "custom", Pattern: *opts.Pipeline}
+	}
+
+	req := bitbucketPipelineTriggerRequest{Target: target}
+
+	for _, v := range opts.Variables {
+		req.Variables = append(req.Variables, bitbucketPipelineVariable{
+			Key:     v.Key,
+			Value:   v.Value,
+			Secured: v.Secured != nil && *v.Secured,
+		})
+	}
+
+	return req
+}
+
+// bitbucketJobIDSeparator joins the pipeline and step UUIDs into a job ID. Step logs are
+// addressed by both UUIDs, while GetJobTrace only receives the job ID.
+const bitbucketJobIDSeparator = "/"
+
+type bitbucketStepsResponse struct {
+	Next   string          `json:"next"`
+	Values []bitbucketStep `json:"values"`
+}
+
+type bitbucketStep struct {
+	UUID string `json:"uuid"`
+	Name string `json:"name"`
+
+	State struct {
+		Name   string `json:"name"`
+		Result *struct {
+			Name string `json:"name"`
+		} `json:"result,omitempty"`
+	} `json:"state"`
+
+	Trigger struct {
+		Type string `json:"type"`
+	} `json:"trigger"`
+
+	StartedOn         string `json:"started_on"`
+	CompletedOn       string `json:"completed_on"`
+	DurationInSeconds int    `json:"duration_in_seconds"`
+}
+
+// ListPipelineJobs lists the steps of a Bitbucket pipeline in execution order. Job IDs combine
+// the pipeline and step UUIDs so GetJobTrace can address the step log.
+func (b *BitbucketService) ListPipelineJobs(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+) ([]models.PipelineJob, error) {
+	username, password, err := decodeBitbucketToken(settings.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bitbucket token: %w", err)
+	}
+
+	workspace, repoSlug, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if pipelineID == "" {
+		return nil, fmt.Errorf("pipeline ID is required: %w", gferrors.ErrBadRequest)
+	}
+
+	apiURL := fmt.Sprintf("%s/repositories/%s/%s/pipelines/%s/steps/?pagelen=100",
+		defaultBitbucketAPIURL, url.PathEscape(workspace), url.PathEscape(repoSlug),
+		url.PathEscape(bitbucketUUID(pipelineID)))
+
+	result := make([]models.PipelineJob, 0)
+
+	// Bitbucket returns steps in execution order; follow "next" links until exhausted.
+	for apiURL != "" {
+		var bbResp bitbucketStepsResponse
+
+		resp, err := b.httpClient.R().
+			SetContext(ctx).
+			SetBasicAuth(username, password).
+			SetResult(&bbResp).
+			Get(apiURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list steps for %s pipeline %s: %w", project, pipelineID, err)
+		}
+
+		if err := mapBitbucketStepsStatus(resp, project, pipelineID); err != nil {
+			return nil, err
+		}
+
+		for i := range bbResp.Values {
+			job, err := mapBitbucketStep(pipelineID, &bbResp.Values[i])
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, job)
+		}
+
+		apiURL = bbResp.Next
+	}
+
+	return result, nil
+}
+
+// GetJobTrace returns the log of a Bitbucket pipeline step and whether it was truncated to
+// common.MaxTraceBytes. The log endpoint redirects to a download URL; the redirect is followed
+// and the body is streamed through an io.LimitReader, so at most common.MaxTraceBytes+1 bytes are read.
+func (b *BitbucketService) GetJobTrace(
+	ctx context.Context,
+	project string,
+	jobID string,
+	settings krci.GitServerSettings,
+) (string, bool, error) {
+	trace, err := b.readStepLog(ctx, project, jobID, settings, models.JobTraceWindow{})
+	if err != nil {
+		return "", false, err
+	}
+
+	return trace.Content, trace.Truncated, nil
+}
+
+// GetJobTraceWindow reads a window of a Bitbucket step log with a Range request, so any part of
+// a log larger than common.MaxTraceBytes can be reached.
+func (b *BitbucketService) GetJobTraceWindow(
+	ctx context.Context,
+	project string,
+	jobID string,
+	settings krci.GitServerSettings,
+	w models.JobTraceWindow,
+) (*models.JobTrace, error) {
+	return b.readStepLog(ctx, project, jobID, settings, w)
+}
+
+// readStepLog reads window w of a step log, at most common.MaxTraceBytes of it. Windows other than the
+// whole log are requested with a Range header; a server that ignores it answers with the whole
+// log, which is then windowed as it streams.
+func (b *BitbucketService) readStepLog(
+	ctx context.Context,
+	project string,
+	jobID string,
+	settings krci.GitServerSettings,
+	w models.JobTraceWindow,
+) (*models.JobTrace, error) {
+	username, password, err := decodeBitbucketToken(settings.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bitbucket token: %w", err)
+	}
+
+	workspace, repoSlug, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelineID, stepID, ok := strings.Cut(jobID, bitbucketJobIDSeparator)
+	if !ok || pipelineID == "" || stepID == "" {
+		return nil, fmt.Errorf("job ID %q must have the form <pipeline>%s<step>: %w",
+			jobID, bitbucketJobIDSeparator, gferrors.ErrBadRequest)
+	}
+
+	apiURL := fmt.Sprintf("%s/repositories/%s/%s/pipelines/%s/steps/%s/log",
+		defaultBitbucketAPIURL, url.PathEscape(workspace), url.PathEscape(repoSlug),
+		url.PathEscape(bitbucketUUID(pipelineID)), url.PathEscape(bitbucketUUID(stepID)))
+
+	ctx, cancel := context.WithTimeout(ctx, common.TraceRequestTimeout)
+	defer cancel()
+
+	req := b.httpClient.R().
+		SetContext(ctx).
+		SetBasicAuth(username, password).
+		SetDoNotParseResponse(true)
+
+	if rng := common.TraceRangeHeader(w, common.MaxTraceBytes); rng != "" {
+		req.SetHeader("Range", rng)
+	}
+
+	resp, err := req.Get(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch step log for %s job %s: %w", project, jobID, err)
+	}
+
+	body := resp.RawBody()
+	defer func() { _ = body.Close() }()
+
+	switch resp.StatusCode() {
+	case http.StatusOK, http.StatusPartialContent, http.StatusRequestedRangeNotSatisfiable:
+	case http.StatusNotFound:
+		// Also returned for steps that have not started yet.
+		return nil, fmt.Errorf("project %s or job %s: %w", project, jobID, gferrors.ErrNotFound)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return nil, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	default:
+		return nil, fmt.Errorf("bitbucket step log request failed for %s job %s: status %d",
+			project, jobID, resp.StatusCode())
+	}
+
+	trace, err := common.ReadTraceWindow(resp.RawResponse, w, common.MaxTraceBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read step log for %s job %s: %w", project, jobID, err)
+	}
+
+	return trace, nil
+}
+
+// mapBitbucketStepsStatus maps a steps-list HTTP status to a GitFusion sentinel error.
+func mapBitbucketStepsStatus(resp *resty.Response, project, pipelineID string) error {
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		return fmt.Errorf("project %s or pipeline %s: %w", project, pipelineID, gferrors.ErrNotFound)
+	case resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden:
+		return fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case resp.IsError():
+		return fmt.Errorf("failed to list steps for %s pipeline %s: status %d, body: %s",
+			project, pipelineID, resp.StatusCode(), resp.String())
+	default:
+		return nil
+	}
+}
+
+// mapBitbucketStep converts a Bitbucket pipeline step to the unified PipelineJob model.
+// Bitbucket does not group steps into named stages, so Stage is left empty.
+func mapBitbucketStep(pipelineID string, s *bitbucketStep) (models.PipelineJob, error) {
+	var resultName string
+	if s.State.Result != nil {
+		resultName = s.State.Result.Name
+	}
+
+	job := models.PipelineJob{
+		Id:     strings.Trim(pipelineID, "{}") + bitbucketJobIDSeparator + strings.Trim(s.UUID, "{}"),
+		Name:   s.Name,
+		Status: bitbucketStepStatus(s.State.Name, resultName, s.Trigger.Type),
+	}
+
+	if s.StartedOn != "" {
+		startedAt, err := time.Parse(time.RFC3339Nano, s.StartedOn)
+		if err != nil {
+			return models.PipelineJob{}, fmt.Errorf("failed to parse started_on time %q: %w", s.StartedOn, err)
+		}
+
+		job.StartedAt = &startedAt
+	}
+
+	if s.CompletedOn != "" {
+		completedAt, err := time.Parse(time.RFC3339Nano, s.CompletedOn)
+		if err != nil {
+			return models.PipelineJob{}, fmt.Errorf("failed to parse completed_on time %q: %w", s.CompletedOn, err)
+		}
+
+		job.FinishedAt = &completedAt
+	}
+
+	if s.DurationInSeconds != 0 {
+		duration := float32(s.DurationInSeconds)
+		job.Duration = &duration
+	}
+
+	return job, nil
+}
+
+// bitbucketStepStatus returns the provider-native step status in lower case: the result once the
+// step has completed (successful, failed, error, stopped, not_run), otherwise the state.
+// A pending step waiting for a manual trigger is reported as "manual".
+func bitbucketStepStatus(stateName, resultName, triggerType string) string {
+	if stateName == "COMPLETED" && resultName != "" {
+		return strings.ToLower(resultName)
+	}
+
+	if stateName == "PENDING" && triggerType == "pipeline_step_trigger_manual" {
+		return "manual"
+	}
+
+	return strings.ToLower(stateName)
+}
+
+// bitbucketUUID wraps a UUID in the braces Bitbucket expects in URL paths. IDs are exposed
+// without braces (see ListPipelines), but braced input is accepted as well.
+func bitbucketUUID(id string) string {
+	return "{" + strings.Trim(id, "{}") + "}"
+}
+
+// CancelPipeline stops a running Bitbucket pipeline. Bitbucket stops asynchronously and answers
+// without a body, so the response carries no status.
+func (b *BitbucketService) CancelPipeline(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineActionResponse, error) {
+	username, password, err := decodeBitbucketToken(settings.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bitbucket token: %w", err)
+	}
+
+	workspace, repoSlug, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if pipelineID == "" {
+		return nil, fmt.Errorf("pipeline ID is required: %w", gferrors.ErrBadRequest)
+	}
+
+	apiURL := fmt.Sprintf("%s/repositories/%s/%s/pipelines/%s/stopPipeline",
+		defaultBitbucketAPIURL, url.PathEscape(workspace), url.PathEscape(repoSlug),
+		url.PathEscape(bitbucketUUID(pipelineID)))
+
+	resp, err := b.httpClient.R().
+		SetContext(ctx).
+		SetBasicAuth(username, password).
+		Post(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to stop pipeline %s for %s: %w", pipelineID, project, err)
+	}
+
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		return nil, fmt.Errorf("project %s or pipeline %s: %w", project, pipelineID, gferrors.ErrNotFound)
+	case resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden:
+		return nil, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case resp.StatusCode() == http.StatusBadRequest:
+		// Returned for pipelines that have already completed.
+		return nil, fmt.Errorf("stop pipeline %s for %s: %s: %w", pipelineID, project, resp.String(), gferrors.ErrBadRequest)
+	case resp.IsError():
+		return nil, fmt.Errorf("failed to stop pipeline %s for %s: status %d, body: %s",
+			pipelineID, project, resp.StatusCode(), resp.String())
+	}
+
+	return &models.PipelineActionResponse{
+		PipelineId: strings.Trim(pipelineID, "{}"),
+		Action:     models.PipelineActionCancel,
+	}, nil
+}
+
+type bitbucketDownloadsResponse struct {
+	Values []bitbucketDownload `json:"values"`
+	Next   string              `json:"next"`
+}
+
+type bitbucketDownload struct {
+	Name      string `json:"name"`
+	Size      int64  `json:"size"`
+	CreatedOn string `json:"created_on"`
+}
+
+// ListPipelineArtifacts lists the files in the repository's Downloads. Bitbucket Pipelines keeps
+// artifacts only for passing them between steps and offers no API to download them; pipelines
+// publish their outputs to Downloads instead. Downloads are not linked to the pipeline that
+// uploaded them, so pipelineID is only checked for presence, every download is listed with the
+// repository scope and a job filter is rejected. The file name is the artifact ID.
+func (b *BitbucketService) ListPipelineArtifacts(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+	opts models.PipelineArtifactListOptions,
+) ([]models.PipelineArtifact, error) {
+	username, password, err := decodeBitbucketToken(settings.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bitbucket token: %w", err)
+	}
+
+	workspace, repoSlug, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if pipelineID == "" {
+		return nil, fmt.Errorf("pipeline ID is required: %w", gferrors.ErrBadRequest)
+	}
+
+	if opts.JobID != nil {
+		return nil, fmt.Errorf("bitbucket downloads are not linked to pipeline steps: %w", gferrors.ErrBadRequest)
+	}
+
+	apiURL := fmt.Sprintf("%s/repositories/%s/%s/downloads?pagelen=100",
+		defaultBitbucketAPIURL, url.PathEscape(workspace), url.PathEscape(repoSlug))
+
+	result := make([]models.PipelineArtifact, 0)
+
+	for apiURL != "" {
+		var bbResp bitbucketDownloadsResponse
+
+		resp, err := b.httpClient.R().
+			SetContext(ctx).
+			SetBasicAuth(username, password).
+			SetResult(&bbResp).
+			Get(apiURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list downloads for %s: %w", project, err)
+		}
+
+		switch {
+		case resp.StatusCode() == http.StatusNotFound:
+			return nil, fmt.Errorf("project %s: %w", project, gferrors.ErrNotFound)
+		case resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden:
+			return nil, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+		case resp.IsError():
+			return nil, fmt.Errorf("failed to list downloads for %s: status %d, body: %s",
+				project, resp.StatusCode(), resp.String())
+		}
+
+		for _, d := range bbResp.Values {
+			artifact, err := mapBitbucketDownload(d)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, artifact)
+		}
+
+		apiURL = bbResp.Next
+	}
+
+	return result, nil
+}
+
+// mapBitbucketDownload converts a repository download to the unified PipelineArtifact model.
+// Downloads do not expire.
+func mapBitbucketDownload(d bitbucketDownload) (models.PipelineArtifact, error) {
+	size := d.Size
+
+	artifact := models.PipelineArtifact{
+		Id:    d.Name,
+		Name:  d.Name,
+		Size:  &size,
+		Scope: models.PipelineArtifactScopeRepository,
+	}
+
+	if d.CreatedOn != "" {
+		createdAt, err := time.Parse(time.RFC3339Nano, d.CreatedOn)
+		if err != nil {
+			return models.PipelineArtifact{}, fmt.Errorf("failed to parse created_on time %q: %w", d.CreatedOn, err)
+		}
+
+		artifact.CreatedAt = &createdAt
+	}
+
+	return artifact, nil
+}
+
+// DownloadArtifact opens a file of the repository's Downloads. The download endpoint redirects to
+// a storage URL; the redirect is followed without the credentials, which net/http drops when the
+// host changes.
+func (b *BitbucketService) DownloadArtifact(
+	ctx context.Context,
+	project string,
+	artifactID string,
+	settings krci.GitServerSettings,
+) (*models.ArtifactDownload, error) {
+	username, password, err := decodeBitbucketToken(settings.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bitbucket token: %w", err)
+	}
+
+	workspace, repoSlug, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if artifactID == "" || strings.Contains(artifactID, "/") {
+		return nil, fmt.Errorf("artifact ID %q must be a download file name: %w", artifactID, gferrors.ErrBadRequest)
+	}
+
+	apiURL := fmt.Sprintf("%s/repositories/%s/%s/downloads/%s",
+		defaultBitbucketAPIURL, url.PathEscape(workspace), url.PathEscape(repoSlug), url.PathEscape(artifactID))
+
+	resp, err := b.httpClient.R().
+		SetContext(ctx).
+		SetBasicAuth(username, password).
+		SetDoNotParseResponse(true).
+		Get(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s for %s: %w", artifactID, project, err)
+	}
+
+	body := resp.RawBody()
+
+	if resp.StatusCode() != http.StatusOK {
+		_ = body.Close()
+
+		switch resp.StatusCode() {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("project %s or download %s: %w", project, artifactID, gferrors.ErrNotFound)
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return nil, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+		default:
+			return nil, fmt.Errorf("bitbucket download request failed for %s file %s: status %d",
+				project, artifactID, resp.StatusCode())
+		}
+	}
+
+	return &models.ArtifactDownload{
+		Body:        body,
+		Name:        artifactID,
+		Size:        resp.RawResponse.ContentLength,
+		ContentType: resp.Header().Get("Content-Type"),
+	}, nil
+}
+
+type bitbucketCommitStatusesResponse struct {
+	Next   string                  `json:"next"`
+	Values []bitbucketCommitStatus `json:"values"`
+}
+
+type bitbucketCommitStatus struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	State       string `json:"state"`
+	Description string `json:"description"`
+	URL         string `json:"url"`
+	CreatedOn   string `json:"created_on"`
+	UpdatedOn   string `json:"updated_on"`
+}
+
+// ListCommitStatuses returns the build statuses reported for a commit. Bitbucket keeps one
+// status per key, so the key identifies the context.
+func (b *BitbucketService) ListCommitStatuses(
+	ctx context.Context,
+	project string,
+	sha string,
+	settings krci.GitServerSettings,
+) ([]models.CommitStatus, error) {
+	username, password, err := decodeBitbucketToken(settings.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bitbucket token: %w", err)
+	}
+
+	workspace, repoSlug, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf("%s/repositories/%s/%s/commit/%s/statuses?pagelen=100",
+		defaultBitbucketAPIURL, url.PathEscape(workspace), url.PathEscape(repoSlug), url.PathEscape(sha))
+
+	result := make([]models.CommitStatus, 0)
+
+	for apiURL != "" {
+		var bbResp bitbucketCommitStatusesResponse
+
+		resp, err := b.httpClient.R().
+			SetContext(ctx).
+			SetBasicAuth(username, password).
+			SetResult(&bbResp).
+			Get(apiURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list statuses of commit %s for %s: %w", sha, project, err)
+		}
+
+		switch {
+		case resp.StatusCode() == http.StatusNotFound:
+			return nil, fmt.Errorf("project %s or commit %s: %w", project, sha, gferrors.ErrNotFound)
+		case resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden:
+			return nil, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+		case resp.IsError():
+			return nil, fmt.Errorf("failed to list statuses of commit %s for %s: status %d, body: %s",
+				sha, project, resp.StatusCode(), resp.String())
+		}
+
+		for i := range bbResp.Values {
+			status, err := mapBitbucketCommitStatus(&bbResp.Values[i])
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, status)
+		}
+
+		apiURL = bbResp.Next
+	}
+
+	return result, nil
+}
+
+// bitbucketCommitStatusRequest is the body of a Bitbucket build status write.
+type bitbucketCommitStatusRequest struct {
+	Key         string `json:"key"`
+	State       string `json:"state"`
+	URL         string `json:"url"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateCommitStatus writes a build status for a commit, keyed by the status context. Bitbucket
+// has no pending state, so pending is written as in progress, and requires a target URL.
+func (b *BitbucketService) CreateCommitStatus(
+	ctx context.Context,
+	project string,
+	sha string,
+	settings krci.GitServerSettings,
+	opts models.CommitStatusOptions,
+) (*models.CommitStatus, error) {
+	username, password, err := decodeBitbucketToken(settings.Token)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode bitbucket token: %w", err)
+	}
+
+	workspace, repoSlug, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.TargetURL == nil || *opts.TargetURL == "" {
+		return nil, fmt.Errorf("bitbucket commit statuses require a target URL: %w", gferrors.ErrBadRequest)
+	}
+
+	state, err := mapCommitStateToBitbucket(opts.State)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf("%s/repositories/%s/%s/commit/%s/statuses/build",
+		defaultBitbucketAPIURL, url.PathEscape(workspace), url.PathEscape(repoSlug), url.PathEscape(sha))
+
+	var bbResp bitbucketCommitStatus
+
+	resp, err := b.httpClient.R().
+		SetContext(ctx).
+		SetBasicAuth(username, password).
+		SetBody(bitbucketCommitStatusRequest{
+			Key:         opts.Context,
+			State:       state,
+			URL:         *opts.TargetURL,
+			Description: pointer.ValueOrEmpty(opts.Description),
+		}).
+		SetResult(&bbResp).
+		Post(apiURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create status of commit %s for %s: %w", sha, project, err)
+	}
+
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		return nil, fmt.Errorf("project %s or commit %s: %w", project, sha, gferrors.ErrNotFound)
+	case resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden:
+		return nil, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case resp.StatusCode() == http.StatusBadRequest:
+		return nil, fmt.Errorf("create status of commit %s for %s: %s: %w",
+			sha, project, resp.String(), gferrors.ErrBadRequest)
+	case resp.IsError():
+		return nil, fmt.Errorf("failed to create status of commit %s for %s: status %d, body: %s",
+			sha, project, resp.StatusCode(), resp.String())
+	}
+
+	status, err := mapBitbucketCommitStatus(&bbResp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &status, nil
+}
+
+// mapCommitStateToBitbucket maps a unified commit state to the state of a Bitbucket build status.
+func mapCommitStateToBitbucket(state models.CommitState) (string, error) {
+	switch state {
+	case models.CommitStatePending, models.CommitStateRunning:
+		return "INPROGRESS", nil
+	case models.CommitStateSuccess:
+		return "SUCCESSFUL", nil
+	case models.CommitStateFailed:
+		return "FAILED", nil
+	case models.CommitStateCancelled:
+		return "STOPPED", nil
+	default:
+		return "", fmt.Errorf("commit state %q cannot be written to Bitbucket: %w", state, gferrors.ErrBadRequest)
+	}
+}
+
+// mapBitbucketCommitStatus converts a Bitbucket build status to the unified CommitStatus model.
+// The name, when set, is more readable than the key and is used as the description fallback.
+func mapBitbucketCommitStatus(s *bitbucketCommitStatus) (models.CommitStatus, error) {
+	status := models.CommitStatus{
+		Kind:    models.CommitStatusKindStatus,
+		Context: s.Key,
+		State:   normalizeBitbucketCommitState(s.State),
+	}
+
+	description := s.Description
+	if description == "" {
+		description = s.Name
+	}
+
+	if description != "" {
+		status.Description = &description
+	}
+
+	if s.URL != "" {
+		status.TargetUrl = &s.URL
+	}
+
+	if s.CreatedOn != "" {
+		createdAt, err := time.Parse(time.RFC3339Nano, s.CreatedOn)
+		if err != nil {
+			return models.CommitStatus{}, fmt.Errorf("failed to parse created_on time %q: %w", s.CreatedOn, err)
+		}
+
+		status.CreatedAt = &createdAt
+	}
+
+	if s.UpdatedOn != "" {
+		updatedAt, err := time.Parse(time.RFC3339Nano, s.UpdatedOn)
+		if err != nil {
+			return models.CommitStatus{}, fmt.Errorf("failed to parse updated_on time %q: %w", s.UpdatedOn, err)
+		}
+
+		status.UpdatedAt = &updatedAt
+	}
+
+	return status, nil
+}
+
+// normalizeBitbucketCommitState maps a Bitbucket build status state to the unified commit state.
+func normalizeBitbucketCommitState(state string) models.CommitState {
+	switch state {
+	case "SUCCESSFUL":
+		return models.CommitStateSuccess
+	case "FAILED":
+		return models.CommitStateFailed
+	case "STOPPED":
+		return models.CommitStateCancelled
+	case "INPROGRESS":
+		return models.CommitStateRunning
+	default:
+		return models.CommitStatePending
+	}
 }
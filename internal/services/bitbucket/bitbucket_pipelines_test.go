@@ -3,8 +3,10 @@ package bitbucket
 import (
 	"context"
 	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -12,8 +14,11 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
 )
 
 func TestNormalizeBitbucketPipelineStatus(t *testing.T) {
@@ -396,6 +401,68 @@ func TestBitbucketServiceListPipelinesRefBackslashSanitization(t *testing.T) {
 	assert.Contains(t, q, `target.ref_name="main\\"`)
 }
 
+func TestBitbucketServiceListPipelinesCommitUserAndDateFilters(t *testing.T) {
+	var capturedReq *http.Request
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedReq = r
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"size": 4, "page": 1, "pagelen": 20, "values": [
+			{"uuid": "{p1}", "state": {"name": "COMPLETED", "result": {"name": "SUCCESSFUL"}},
+				"trigger": {"name": "SCHEDULE"}, "creator": {"nickname": "jdoe"},
+				"created_on": "2026-01-10T10:00:00Z", "completed_on": "2026-01-10T10:05:00Z"},
+			{"uuid": "{p2}", "state": {"name": "COMPLETED", "result": {"name": "SUCCESSFUL"}},
+				"trigger": {"name": "PUSH"}, "creator": {"nickname": "jdoe"},
+				"created_on": "2026-01-10T10:00:00Z", "completed_on": "2026-01-10T10:05:00Z"},
+			{"uuid": "{p3}", "state": {"name": "COMPLETED", "result": {"name": "SUCCESSFUL"}},
+				"trigger": {"name": "SCHEDULE"}, "creator": {"nickname": "other"},
+				"created_on": "2026-01-10T10:00:00Z", "completed_on": "2026-01-10T10:05:00Z"},
+			{"uuid": "{p4}", "state": {"name": "IN_PROGRESS"},
+				"trigger": {"name": "SCHEDULE"}, "creator": {"nickname": "jdoe"},
+				"created_on": "2025-12-10T10:00:00Z"}]}`))
+	}))
+	defer server.Close()
+
+	svc := &BitbucketService{
+		httpClient: resty.New().SetTransport(&redirectTransport{
+			target:  server.URL,
+			wrapped: http.DefaultTransport,
+		}),
+	}
+
+	sha, source, user := "abc123", "schedule", "jdoe"
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	result, err := svc.ListPipelines(
+		context.Background(),
+		"owner/repo",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+		models.PipelineListOptions{
+			Sha:           &sha,
+			Source:        &source,
+			TriggeredBy:   &user,
+			UpdatedAfter:  &after,
+			UpdatedBefore: &before,
+			Ascending:     true,
+			Page:          1,
+			PerPage:       20,
+		},
+	)
+
+	require.NoError(t, err)
+	require.NotNil(t, capturedReq)
+
+	q := capturedReq.URL.Query().Get("q")
+	assert.Contains(t, q, `target.commit.hash="abc123"`)
+	assert.Contains(t, q, `created_on<=2026-01-31T00:00:00Z`)
+	assert.Equal(t, "created_on", capturedReq.URL.Query().Get("sort"))
+
+	require.Len(t, result.Data, 1, "source, creator and last update are filtered after listing")
+	assert.Equal(t, "p1", result.Data[0].Id)
+}
+
 func TestBitbucketServiceListPipelinesNotFound(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -585,18 +652,722 @@ func TestBitbucketServiceListPipelinesInvalidTimestamp(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to parse created_on time")
 }
 
-func TestBitbucketServiceTriggerPipelineUnsupported(t *testing.T) {
-	svc := NewBitbucketProvider()
+func TestBuildBitbucketPipelineTriggerRequest(t *testing.T) {
+	const sha = "0123456789abcdef0123456789abcdef01234567"
+
+	tests := []struct {
+		name string
+		opts models.PipelineTriggerOptions
+		want bitbucketPipelineTriggerRequest
+	}{
+		{
+			name: "branch target",
+			opts: models.PipelineTriggerOptions{Ref: "main"},
+			want: bitbucketPipelineTriggerRequest{
+				Target: bitbucketPipelineTarget{Type: "pipeline_ref_target", RefType: "branch", RefName: "main"},
+			},
+		},
+		{
+			name: "branch target with custom selector",
+			opts: models.PipelineTriggerOptions{Ref: "main", Pipeline: pointer.To("deploy")},
+			want: bitbucketPipelineTriggerRequest{
+				Target: bitbucketPipelineTarget{
+					Type:     "pipeline_ref_target",
+					RefType:  "branch",
+					RefName:  "main",
+					Selector: &bitbucketPipelineSelector{Type: "custom", Pattern: "deploy"},
+				},
+			},
+		},
+		{
+			name: "full commit hash becomes a commit target",
+			opts: models.PipelineTriggerOptions{Ref: sha, Pipeline: pointer.To("deploy")},
+			want: bitbucketPipelineTriggerRequest{
+				Target: bitbucketPipelineTarget{
+					Type:     "pipeline_commit_target",
+					Commit:   &bitbucketPipelineCommit{Type: "commit", Hash: sha},
+					Selector: &bitbucketPipelineSelector{Type: "custom", Pattern: "deploy"},
+				},
+			},
+		},
+		{
+			name: "variables keep the secured flag",
+			opts: models.PipelineTriggerOptions{
+				Ref: "main",
+				Variables: []models.PipelineVariable{
+					{Key: "ENV", Value: "prod"},
+					{Key: "TOKEN", Value: "s3cr3t", Secured: pointer.To(true)},
+				},
+			},
+			want: bitbucketPipelineTriggerRequest{
+				Target: bitbucketPipelineTarget{Type: "pipeline_ref_target", RefType: "branch", RefName: "main"},
+				Variables: []bitbucketPipelineVariable{
+					{Key: "ENV", Value: "prod"},
+					{Key: "TOKEN", Value: "s3cr3t", Secured: true},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, buildBitbucketPipelineTriggerRequest(tt.opts))
+		})
+	}
+}
+
+func TestBitbucketServiceTriggerPipeline(t *testing.T) {
+	var gotBody map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/2.0/repositories/owner/repo/pipelines/", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{
+			"uuid": "{abc-123}",
+			"build_number": 42,
+			"state": {"name": "PENDING"},
+			"target": {"ref_type": "branch", "ref_name": "main", "commit": {"hash": "deadbeef"}}
+		}`))
+	}))
+	defer server.Close()
+
+	svc := &BitbucketService{
+		httpClient: resty.New().SetTransport(&redirectTransport{
+			target:  server.URL,
+			wrapped: http.DefaultTransport,
+		}),
+	}
 
 	result, err := svc.TriggerPipeline(
 		context.Background(),
 		"owner/repo",
-		"main",
-		nil,
 		krci.GitServerSettings{Token: testBitbucketToken()},
+		models.PipelineTriggerOptions{
+			Ref:       "main",
+			Pipeline:  pointer.To("deploy"),
+			Variables: []models.PipelineVariable{{Key: "TOKEN", Value: "s3cr3t", Secured: pointer.To(true)}},
+		},
+	)
+
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	assert.Equal(t, map[string]any{
+		"type":     "pipeline_ref_target",
+		"ref_type": "branch",
+		"ref_name": "main",
+		"selector": map[string]any{"type": "custom", "pattern": "deploy"},
+	}, gotBody["target"])
+	assert.Equal(t, []any{
+		map[string]any{"key": "TOKEN", "value": "s3cr3t", "secured": true},
+	}, gotBody["variables"])
+
+	assert.Equal(t, "abc-123", result.Id)
+	assert.Equal(t, string(models.PipelineStatusPending), result.Status)
+	assert.Equal(t, "main", result.Ref)
+	assert.Equal(t, "https://bitbucket.org/owner/repo/pipelines/results/42", result.WebUrl)
+	require.NotNil(t, result.Sha)
+	assert.Equal(t, "deadbeef", *result.Sha)
+}
+
+func TestBitbucketServiceTriggeredPipelineIDDrillsDown(t *testing.T) {
+	var gotPipeline string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /2.0/repositories/owner/repo/pipelines/", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"uuid": "{abc-123}", "build_number": 42, "state": {"name": "PENDING"}}`))
+	})
+	mux.HandleFunc("GET /2.0/repositories/owner/repo/pipelines/{pipeline}/steps/",
+		func(w http.ResponseWriter, r *http.Request) {
+			gotPipeline = r.PathValue("pipeline")
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"values": [{"uuid": "{step-1}", "name": "Build", "state": {"name": "PENDING"}}]}`))
+		},
+	)
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	svc := newTestBitbucketService(server.URL)
+	settings := krci.GitServerSettings{Token: testBitbucketToken()}
+
+	triggered, err := svc.TriggerPipeline(context.Background(), "owner/repo", settings,
+		models.PipelineTriggerOptions{Ref: "main"})
+	require.NoError(t, err)
+
+	jobs, err := svc.ListPipelineJobs(context.Background(), "owner/repo", triggered.Id, settings)
+	require.NoError(t, err)
+
+	assert.Equal(t, "{abc-123}", gotPipeline)
+	require.Len(t, jobs, 1)
+	assert.Equal(t, "abc-123/step-1", jobs[0].Id)
+}
+
+func TestBitbucketServiceTriggerPipelineErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr error
+	}{
+		{
+			name:    "unknown custom pipeline maps to bad request",
+			status:  http.StatusBadRequest,
+			body:    `{"type": "error", "error": {"message": "Could not find a custom pipeline named deploy"}}`,
+			wantErr: gferrors.ErrBadRequest,
+		},
+		{
+			name:    "missing repository maps to not found",
+			status:  http.StatusNotFound,
+			body:    `{"type": "error", "error": {"message": "Repository not found"}}`,
+			wantErr: gferrors.ErrNotFound,
+		},
+		{
+			name:    "bad credentials map to unauthorized",
+			status:  http.StatusUnauthorized,
+			body:    `{"type": "error", "error": {"message": "Unauthorized"}}`,
+			wantErr: gferrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			svc := &BitbucketService{
+				httpClient: resty.New().SetTransport(&redirectTransport{
+					target:  server.URL,
+					wrapped: http.DefaultTransport,
+				}),
+			}
+
+			result, err := svc.TriggerPipeline(
+				context.Background(),
+				"owner/repo",
+				krci.GitServerSettings{Token: testBitbucketToken()},
+				models.PipelineTriggerOptions{Ref: "main", Pipeline: pointer.To("deploy")},
+			)
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestBitbucketServiceTriggerPipelineInvalidToken(t *testing.T) {
+	svc := NewBitbucketProvider()
+
+	result, err := svc.TriggerPipeline(
+		context.Background(),
+		"owner/repo",
+		krci.GitServerSettings{Token: "not-base64!"},
+		models.PipelineTriggerOptions{Ref: "main"},
 	)
 
 	assert.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "not supported for Bitbucket")
+	assert.Contains(t, err.Error(), "failed to decode bitbucket token")
+}
+
+func TestBitbucketStepStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		stateName   string
+		resultName  string
+		triggerType string
+		want        string
+	}{
+		{name: "completed uses result", stateName: "COMPLETED", resultName: "SUCCESSFUL", want: "successful"},
+		{name: "not run result", stateName: "COMPLETED", resultName: "NOT_RUN", want: "not_run"},
+		{name: "in progress keeps state", stateName: "IN_PROGRESS", want: "in_progress"},
+		{
+			name: "pending manual step", stateName: "PENDING",
+			triggerType: "pipeline_step_trigger_manual", want: "manual",
+		},
+		{
+			name: "pending automatic step", stateName: "PENDING",
+			triggerType: "pipeline_step_trigger_automatic", want: "pending",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, bitbucketStepStatus(tt.stateName, tt.resultName, tt.triggerType))
+		})
+	}
+}
+
+func newTestBitbucketService(serverURL string) *BitbucketService {
+	return &BitbucketService{
+		httpClient: resty.New().SetTransport(&redirectTransport{
+			target:  serverURL,
+			wrapped: http.DefaultTransport,
+		}),
+	}
+}
+
+func TestBitbucketServiceListPipelineJobs(t *testing.T) {
+	var serverURL string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.0/repositories/owner/repo/pipelines/{pipeline}/steps/",
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "{pipe-1}", r.PathValue("pipeline"))
+
+			w.Header().Set("Content-Type", "application/json")
+
+			if r.URL.Query().Get("page") == "2" {
+				_, _ = w.Write([]byte(`{"values": [{
+					"uuid": "{step-2}",
+					"name": "Deploy",
+					"state": {"name": "PENDING"},
+					"trigger": {"type": "pipeline_step_trigger_manual"}
+				}]}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{
+				"next": "` + serverURL + `/2.0/repositories/owner/repo/pipelines/%7Bpipe-1%7D/steps/?page=2",
+				"values": [{
+					"uuid": "{step-1}",
+					"name": "Build",
+					"state": {"name": "COMPLETED", "result": {"name": "FAILED"}},
+					"trigger": {"type": "pipeline_step_trigger_automatic"},
+					"started_on": "2024-06-01T10:00:00.000Z",
+					"completed_on": "2024-06-01T10:01:00.000Z",
+					"duration_in_seconds": 60
+				}]
+			}`))
+		},
+	)
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	serverURL = server.URL
+
+	jobs, err := newTestBitbucketService(server.URL).ListPipelineJobs(
+		context.Background(),
+		"owner/repo",
+		"pipe-1",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+	)
+
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+
+	assert.Equal(t, "pipe-1/step-1", jobs[0].Id)
+	assert.Equal(t, "Build", jobs[0].Name)
+	assert.Equal(t, "failed", jobs[0].Status)
+	require.NotNil(t, jobs[0].StartedAt)
+	require.NotNil(t, jobs[0].FinishedAt)
+	require.NotNil(t, jobs[0].Duration)
+	assert.InDelta(t, 60, *jobs[0].Duration, 0.001)
+
+	assert.Equal(t, "pipe-1/step-2", jobs[1].Id)
+	assert.Equal(t, "manual", jobs[1].Status)
+	assert.Nil(t, jobs[1].StartedAt)
+}
+
+func TestBitbucketServiceListPipelineJobsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	jobs, err := newTestBitbucketService(server.URL).ListPipelineJobs(
+		context.Background(),
+		"owner/repo",
+		"pipe-1",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+	)
+
+	require.Error(t, err)
+	assert.Nil(t, jobs)
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+func TestBitbucketServiceGetJobTrace(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.0/repositories/owner/repo/pipelines/{pipeline}/steps/{step}/log",
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "{pipe-1}", r.PathValue("pipeline"))
+			assert.Equal(t, "{step-1}", r.PathValue("step"))
+			http.Redirect(w, r, "/logs/step-1", http.StatusTemporaryRedirect)
+		},
+	)
+	mux.HandleFunc("/logs/step-1", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("+ make build\nok\n"))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	content, truncated, err := newTestBitbucketService(server.URL).GetJobTrace(
+		context.Background(),
+		"owner/repo",
+		"pipe-1/step-1",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+	)
+
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, "+ make build\nok\n", content)
+}
+
+func TestBitbucketServiceGetJobTraceTruncated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("x", common.MaxTraceBytes+10)))
+	}))
+	defer server.Close()
+
+	content, truncated, err := newTestBitbucketService(server.URL).GetJobTrace(
+		context.Background(),
+		"owner/repo",
+		"pipe-1/step-1",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+	)
+
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Len(t, content, common.MaxTraceBytes)
+}
+
+func TestBitbucketServiceGetJobTraceErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		jobID   string
+		status  int
+		wantErr error
+	}{
+		{name: "malformed job ID", jobID: "step-1", status: http.StatusOK, wantErr: gferrors.ErrBadRequest},
+		{name: "missing log", jobID: "pipe-1/step-1", status: http.StatusNotFound, wantErr: gferrors.ErrNotFound},
+		{
+			name: "bad credentials", jobID: "pipe-1/step-1",
+			status: http.StatusUnauthorized, wantErr: gferrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			content, truncated, err := newTestBitbucketService(server.URL).GetJobTrace(
+				context.Background(),
+				"owner/repo",
+				tt.jobID,
+				krci.GitServerSettings{Token: testBitbucketToken()},
+			)
+
+			require.Error(t, err)
+			assert.Empty(t, content)
+			assert.False(t, truncated)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestBitbucketServiceGetJobTraceWindow(t *testing.T) {
+	log := "+ make build\nstep 1\nstep 2\nFAILED\n"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/2.0/repositories/owner/repo/pipelines/{pipeline}/steps/{step}/log",
+		func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "/logs/step-1", http.StatusTemporaryRedirect)
+		},
+	)
+	mux.HandleFunc("/logs/step-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "bytes=-4194304", r.Header.Get("Range"), "the Range header must survive the redirect")
+		http.ServeContent(w, r, "", time.Time{}, strings.NewReader(log))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	trace, err := newTestBitbucketService(server.URL).GetJobTraceWindow(
+		context.Background(),
+		"owner/repo",
+		"pipe-1/step-1",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+		models.JobTraceWindow{TailLines: 2},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "step 2\nFAILED\n", trace.Content)
+	assert.Equal(t, int64(20), trace.Offset)
+	require.NotNil(t, trace.TotalSize)
+	assert.Equal(t, int64(len(log)), *trace.TotalSize)
+}
+
+func TestBitbucketServiceGetPipeline(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2.0/repositories/owner/repo/pipelines/{pipeline}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "{pipe-1}", r.PathValue("pipeline"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"uuid": "{pipe-1}",
+			"state": {"name": "COMPLETED", "result": {"name": "SUCCESSFUL"}},
+			"target": {"ref_name": "main", "commit": {"hash": "abc123"}},
+			"trigger": {"name": "PUSH"},
+			"creator": {"uuid": "{user-1}", "display_name": "Jane", "links": {"avatar": {"href": "https://avatar"}}},
+			"created_on": "2026-01-15T10:00:00.000Z",
+			"completed_on": "2026-01-15T10:05:00.000Z",
+			"duration_in_seconds": 280
+		}`))
+	})
+	mux.HandleFunc("GET /2.0/repositories/owner/repo/commit/abc123", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"hash": "abc123", "message": "Fix the build\n\nDetails"}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	p, err := newTestBitbucketService(server.URL).GetPipeline(context.Background(), "owner/repo", "pipe-1",
+		krci.GitServerSettings{Token: testBitbucketToken()})
+
+	require.NoError(t, err)
+	assert.Equal(t, "pipe-1", p.Id)
+	assert.Equal(t, models.PipelineStatusSuccess, p.Status)
+	require.NotNil(t, p.Duration)
+	assert.InDelta(t, 280, *p.Duration, 0)
+	assert.Nil(t, p.QueuedDuration)
+	require.NotNil(t, p.TriggeredBy)
+	assert.Equal(t, "Jane", p.TriggeredBy.Name)
+	require.NotNil(t, p.TriggeredBy.AvatarUrl)
+	require.NotNil(t, p.CommitTitle)
+	assert.Equal(t, "Fix the build", *p.CommitTitle)
+}
+
+func TestBitbucketServiceListPullRequestPipelines(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2.0/repositories/owner/repo/pullrequests/7", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 7, "source": {"branch": {"name": "feature/x"}, "commit": {"hash": "abc123"}}}`))
+	})
+	mux.HandleFunc("GET /2.0/repositories/owner/repo/pipelines/", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "feature/x", r.URL.Query().Get("target.branch"))
+		assert.Equal(t, "-created_on", r.URL.Query().Get("sort"))
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"size": 11, "values": [{"uuid": "{pipe-1}",
+			"state": {"name": "IN_PROGRESS"}, "target": {"commit": {"hash": "abc123"}},
+			"trigger": {"name": "PULL_REQUEST"}, "created_on": "2026-01-15T10:00:00.000Z"}]}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	result, err := newTestBitbucketService(server.URL).ListPullRequestPipelines(context.Background(),
+		"owner/repo", 7, krci.GitServerSettings{Token: testBitbucketToken()},
+		models.PipelineListOptions{Page: 2, PerPage: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, 11, result.Pagination.Total)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, "pipe-1", result.Data[0].Id)
+	assert.Equal(t, models.PipelineSourceMergeRequest, *result.Data[0].Source)
+}
+
+func TestBitbucketServiceListPullRequestPipelinesMissingPullRequest(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := newTestBitbucketService(server.URL).ListPullRequestPipelines(context.Background(),
+		"owner/repo", 7, krci.GitServerSettings{Token: testBitbucketToken()},
+		models.PipelineListOptions{Page: 1, PerPage: 20})
+
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+func TestBitbucketServiceGetPipelineErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "missing pipeline", status: http.StatusNotFound, wantErr: gferrors.ErrNotFound},
+		{name: "bad credentials", status: http.StatusUnauthorized, wantErr: gferrors.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			p, err := newTestBitbucketService(server.URL).GetPipeline(context.Background(), "owner/repo", "pipe-1",
+				krci.GitServerSettings{Token: testBitbucketToken()})
+
+			assert.Nil(t, p)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestBitbucketServiceCancelPipeline(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /2.0/repositories/owner/repo/pipelines/{pipeline}/stopPipeline",
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "{pipe-1}", r.PathValue("pipeline"))
+			w.WriteHeader(http.StatusNoContent)
+		},
+	)
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	result, err := newTestBitbucketService(server.URL).CancelPipeline(
+		context.Background(),
+		"owner/repo",
+		"pipe-1",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "pipe-1", result.PipelineId)
+	assert.Equal(t, models.PipelineActionCancel, result.Action)
+}
+
+func TestBitbucketServiceCancelPipelineErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "completed pipeline", status: http.StatusBadRequest, wantErr: gferrors.ErrBadRequest},
+		{name: "missing pipeline", status: http.StatusNotFound, wantErr: gferrors.ErrNotFound},
+		{name: "bad credentials", status: http.StatusUnauthorized, wantErr: gferrors.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			result, err := newTestBitbucketService(server.URL).CancelPipeline(
+				context.Background(),
+				"owner/repo",
+				"pipe-1",
+				krci.GitServerSettings{Token: testBitbucketToken()},
+			)
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestBitbucketServiceListPipelineArtifacts(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2.0/repositories/owner/repo/downloads", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"values": [
+			{"name": "app-1.0.tar.gz", "size": 4096, "created_on": "2024-06-01T10:00:00.000000+00:00"},
+			{"name": "report.html", "size": 10}
+		]}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	artifacts, err := newTestBitbucketService(server.URL).ListPipelineArtifacts(
+		context.Background(),
+		"owner/repo",
+		"pipe-1",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+		models.PipelineArtifactListOptions{},
+	)
+
+	require.NoError(t, err)
+	require.Len(t, artifacts, 2)
+	assert.Equal(t, "app-1.0.tar.gz", artifacts[0].Id)
+	assert.Equal(t, "app-1.0.tar.gz", artifacts[0].Name)
+	require.NotNil(t, artifacts[0].Size)
+	assert.Equal(t, int64(4096), *artifacts[0].Size)
+	require.NotNil(t, artifacts[0].CreatedAt)
+	assert.Equal(t, models.PipelineArtifactScopeRepository, artifacts[0].Scope, "downloads are not pipeline-scoped")
+	assert.Nil(t, artifacts[1].CreatedAt)
+
+	_, err = newTestBitbucketService(server.URL).ListPipelineArtifacts(
+		context.Background(),
+		"owner/repo",
+		"pipe-1",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+		models.PipelineArtifactListOptions{JobID: pointer.To("pipe-1/step-1")},
+	)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
+
+func TestBitbucketServiceDownloadArtifact(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2.0/repositories/owner/repo/downloads/{name}", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "app 1.0.tar.gz", r.PathValue("name"))
+		http.Redirect(w, r, "/storage/app.tar.gz", http.StatusFound)
+	})
+	mux.HandleFunc("GET /storage/app.tar.gz", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/gzip")
+		_, _ = w.Write([]byte("tarball"))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	download, err := newTestBitbucketService(server.URL).DownloadArtifact(
+		context.Background(),
+		"owner/repo",
+		"app 1.0.tar.gz",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+	)
+	require.NoError(t, err)
+
+	defer func() { _ = download.Body.Close() }()
+
+	content, err := io.ReadAll(download.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "tarball", string(content))
+	assert.Equal(t, "app 1.0.tar.gz", download.Name)
+	assert.Equal(t, int64(7), download.Size)
+	assert.Equal(t, "application/gzip", download.ContentType)
+}
+
+func TestBitbucketServiceDownloadArtifactErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := newTestBitbucketService(server.URL).DownloadArtifact(
+		context.Background(), "owner/repo", "missing.zip", krci.GitServerSettings{Token: testBitbucketToken()},
+	)
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+
+	_, err = newTestBitbucketService(server.URL).DownloadArtifact(
+		context.Background(), "owner/repo", "dir/file.zip", krci.GitServerSettings{Token: testBitbucketToken()},
+	)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
 }
@@ -0,0 +1,161 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+)
+
+func TestBitbucketServiceListCommitStatuses(t *testing.T) {
+	var serverURL string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /2.0/repositories/owner/repo/commit/abc123/statuses",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+
+			if r.URL.Query().Get("page") == "2" {
+				_, _ = w.Write([]byte(`{"values": [
+					{"key": "deploy", "state": "STOPPED", "created_on": "2024-06-01T10:00:00.000000+00:00"}
+				]}`))
+
+				return
+			}
+
+			_, _ = w.Write([]byte(`{
+				"next": "` + serverURL + `/2.0/repositories/owner/repo/commit/abc123/statuses?page=2",
+				"values": [
+					{"key": "jenkins-build", "name": "Jenkins build", "state": "FAILED",
+					 "description": "2 tests failed", "url": "https://jenkins.example.com/job/7",
+					 "created_on": "2024-06-01T10:00:00.000000+00:00",
+					 "updated_on": "2024-06-01T10:05:00.000000+00:00"},
+					{"key": "sonar", "name": "SonarQube", "state": "INPROGRESS"}
+				]
+			}`))
+		},
+	)
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	serverURL = server.URL
+
+	statuses, err := newTestBitbucketService(server.URL).ListCommitStatuses(
+		context.Background(),
+		"owner/repo",
+		"abc123",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+	)
+
+	require.NoError(t, err)
+	require.Len(t, statuses, 3)
+
+	assert.Equal(t, models.CommitStatusKindStatus, statuses[0].Kind)
+	assert.Equal(t, "jenkins-build", statuses[0].Context)
+	assert.Equal(t, models.CommitStateFailed, statuses[0].State)
+	assert.Equal(t, "2 tests failed", *statuses[0].Description)
+	assert.Equal(t, "https://jenkins.example.com/job/7", *statuses[0].TargetUrl)
+	require.NotNil(t, statuses[0].UpdatedAt)
+
+	assert.Equal(t, models.CommitStateRunning, statuses[1].State)
+	assert.Equal(t, "SonarQube", *statuses[1].Description)
+	assert.Nil(t, statuses[1].CreatedAt)
+
+	assert.Equal(t, "deploy", statuses[2].Context)
+	assert.Equal(t, models.CommitStateCancelled, statuses[2].State)
+}
+
+func TestBitbucketServiceListCommitStatusesErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   error
+	}{
+		{name: "not found", status: http.StatusNotFound, want: gferrors.ErrNotFound},
+		{name: "unauthorized", status: http.StatusUnauthorized, want: gferrors.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			statuses, err := newTestBitbucketService(server.URL).ListCommitStatuses(
+				context.Background(),
+				"owner/repo",
+				"abc123",
+				krci.GitServerSettings{Token: testBitbucketToken()},
+			)
+
+			require.Error(t, err)
+			assert.Nil(t, statuses)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestBitbucketServiceCreateCommitStatus(t *testing.T) {
+	var got bitbucketCommitStatusRequest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /2.0/repositories/owner/repo/commit/abc123/statuses/build",
+		func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key": "tekton-build", "state": "INPROGRESS",
+				"url": "https://tekton.example.com/runs/1", "created_on": "2024-06-01T10:00:00.000000+00:00"}`))
+		},
+	)
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	status, err := newTestBitbucketService(server.URL).CreateCommitStatus(
+		context.Background(),
+		"owner/repo",
+		"abc123",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+		models.CommitStatusOptions{
+			State:     models.CommitStatePending,
+			Context:   "tekton-build",
+			TargetURL: pointer.To("https://tekton.example.com/runs/1"),
+		},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, bitbucketCommitStatusRequest{
+		Key:   "tekton-build",
+		State: "INPROGRESS",
+		URL:   "https://tekton.example.com/runs/1",
+	}, got)
+	assert.Equal(t, models.CommitStateRunning, status.State)
+	assert.Equal(t, "tekton-build", status.Context)
+}
+
+func TestBitbucketServiceCreateCommitStatusRequiresTargetURL(t *testing.T) {
+	status, err := newTestBitbucketService("http://127.0.0.1:0").CreateCommitStatus(
+		context.Background(),
+		"owner/repo",
+		"abc123",
+		krci.GitServerSettings{Token: testBitbucketToken()},
+		models.CommitStatusOptions{State: models.CommitStateSuccess, Context: "tekton-build"},
+	)
+
+	require.Error(t, err)
+	assert.Nil(t, status)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
@@ -0,0 +1,437 @@
+package bitbucketdc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+	"github.com/KubeRocketCI/gitfusion/pkg/xiter"
+)
+
+// restAPIPath is the path of the Bitbucket Data Center REST API relative to the server URL.
+const restAPIPath = "/rest/api/1.0"
+
+// pageLimit is the page size used when scanning all pages of a collection.
+const pageLimit = 100
+
+// BitbucketDataCenterProvider implements the repository, organization, branch and pull request
+// providers for self-hosted Bitbucket Data Center (formerly Bitbucket Server).
+//
+// Organizations map to Bitbucket projects: the owner of a repository is its project key
+// (or ~username for personal repositories), and the repository name is its slug.
+// The GitServer token must be an HTTP access token, which is sent as a Bearer token.
+type BitbucketDataCenterProvider struct {
+	httpClient *resty.Client
+}
+
+func NewBitbucketDataCenterProvider() *BitbucketDataCenterProvider {
+	return &BitbucketDataCenterProvider{
+		httpClient: resty.New(),
+	}
+}
+
+// pagedResponse is the envelope of every paged collection in the Bitbucket Data Center REST API.
+// See https://developer.atlassian.com/server/bitbucket/rest/v906/intro/#paged-apis
+type pagedResponse[T any] struct {
+	Size          int  `json:"size"`
+	Limit         int  `json:"limit"`
+	Start         int  `json:"start"`
+	IsLastPage    bool `json:"isLastPage"`
+	NextPageStart int  `json:"nextPageStart"`
+	Values        []T  `json:"values"`
+}
+
+type bitbucketLink struct {
+	Href string `json:"href"`
+	Name string `json:"name"`
+}
+
+type bitbucketProject struct {
+	ID   int    `json:"id"`
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type bitbucketRepository struct {
+	ID          int              `json:"id"`
+	Slug        string           `json:"slug"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Public      bool             `json:"public"`
+	Project     bitbucketProject `json:"project"`
+	Links       struct {
+		Self []bitbucketLink `json:"self"`
+	} `json:"links"`
+}
+
+type bitbucketBranch struct {
+	ID        string `json:"id"`
+	DisplayID string `json:"displayId"`
+	IsDefault bool   `json:"isDefault"`
+}
+
+type bitbucketRef struct {
+	DisplayID    string `json:"displayId"`
+	LatestCommit string `json:"latestCommit"`
+}
+
+type bitbucketPullRequest struct {
+	ID          int          `json:"id"`
+	Title       string       `json:"title"`
+	Description string       `json:"description"`
+	State       string       `json:"state"`
+	Draft       *bool        `json:"draft,omitempty"`
+	CreatedDate int64        `json:"createdDate"`
+	UpdatedDate int64        `json:"updatedDate"`
+	FromRef     bitbucketRef `json:"fromRef"`
+	ToRef       bitbucketRef `json:"toRef"`
+	Author      struct {
+		User struct {
+			ID          int    `json:"id"`
+			Name        string `json:"name"`
+			DisplayName string `json:"displayName"`
+		} `json:"user"`
+	} `json:"author"`
+	Links struct {
+		Self []bitbucketLink `json:"self"`
+	} `json:"links"`
+}
+
+func (b *BitbucketDataCenterProvider) GetRepository(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+) (*models.Repository, error) {
+	var bbRepo bitbucketRepository
+
+	resp, err := b.request(ctx, settings).
+		SetResult(&bbRepo).
+		Get(repositoryURL(settings, owner, repo))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository %s/%s: %w", owner, repo, err)
+	}
+
+	if err := checkResponse(resp, fmt.Sprintf("repository %s/%s", owner, repo)); err != nil {
+		return nil, err
+	}
+
+	result := convertRepository(bbRepo)
+
+	// The default branch is not part of the repository resource. It is resolved on a best-effort
+	// basis because empty repositories have no default branch yet.
+	var defaultBranch bitbucketBranch
+
+	resp, err = b.request(ctx, settings).
+		SetResult(&defaultBranch).
+		Get(repositoryURL(settings, owner, repo) + "/branches/default")
+	if err == nil && resp.StatusCode() == http.StatusOK && defaultBranch.DisplayID != "" {
+		result.DefaultBranch = &defaultBranch.DisplayID
+	}
+
+	return &result, nil
+}
+
+func (b *BitbucketDataCenterProvider) ListRepositories(
+	ctx context.Context,
+	owner string,
+	settings krci.GitServerSettings,
+	listOptions models.ListOptions,
+) ([]models.Repository, error) {
+	apiURL := fmt.Sprintf("%s/projects/%s/repos", apiBaseURL(settings), url.PathEscape(owner))
+	nameFilter := strings.ToLower(pointer.ValueOrEmpty(listOptions.Name))
+
+	result := make([]models.Repository, 0)
+
+	for repo, err := range scanPages[bitbucketRepository](ctx, b, settings, apiURL, nil, "project "+owner) {
+		if err != nil {
+			return nil, err
+		}
+
+		if nameFilter != "" &&
+			!strings.Contains(strings.ToLower(repo.Name), nameFilter) &&
+			!strings.Contains(strings.ToLower(repo.Slug), nameFilter) {
+			continue
+		}
+
+		result = append(result, convertRepository(repo))
+	}
+
+	return result, nil
+}
+
+// ListUserOrganizations returns the projects visible to the token owner.
+// The organization name is the project key, which is what repository calls expect as the owner.
+func (b *BitbucketDataCenterProvider) ListUserOrganizations(
+	ctx context.Context,
+	settings krci.GitServerSettings,
+) ([]models.Organization, error) {
+	apiURL := apiBaseURL(settings) + "/projects"
+
+	result := make([]models.Organization, 0)
+
+	for project, err := range scanPages[bitbucketProject](ctx, b, settings, apiURL, nil, "projects") {
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, models.Organization{
+			Id:   strconv.Itoa(project.ID),
+			Name: project.Key,
+		})
+	}
+
+	return result, nil
+}
+
+func (b *BitbucketDataCenterProvider) ListBranches(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	opts models.ListOptions,
+) ([]models.Branch, error) {
+	query := url.Values{}
+	if opts.Name != nil && *opts.Name != "" {
+		query.Set("filterText", *opts.Name)
+	}
+
+	apiURL := repositoryURL(settings, owner, repo) + "/branches"
+	result := make([]models.Branch, 0)
+
+	for branch, err := range scanPages[bitbucketBranch](
+		ctx, b, settings, apiURL, query, fmt.Sprintf("repository %s/%s", owner, repo),
+	) {
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, models.Branch{
+			Name: branch.DisplayID,
+		})
+	}
+
+	return result, nil
+}
+
+// ListPullRequests returns a single page of pull requests. Bitbucket Data Center pages by
+// start/limit and does not report a total count, so page/perPage are translated into an offset
+// and, while further pages exist, the total is reported as one past the current page.
+func (b *BitbucketDataCenterProvider) ListPullRequests(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	opts models.PullRequestListOptions,
+) (*models.PullRequestsResponse, error) {
+	query := url.Values{}
+	query.Set("state", convertPRStateFilter(opts.State))
+	query.Set("start", strconv.Itoa((opts.Page-1)*opts.PerPage))
+	query.Set("limit", strconv.Itoa(opts.PerPage))
+
+	var page pagedResponse[bitbucketPullRequest]
+
+	resp, err := b.request(ctx, settings).
+		SetQueryParamsFromValues(query).
+		SetResult(&page).
+		Get(repositoryURL(settings, owner, repo) + "/pull-requests")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pull requests for %s/%s: %w", owner, repo, err)
+	}
+
+	if err := checkResponse(resp, fmt.Sprintf("repository %s/%s", owner, repo)); err != nil {
+		return nil, err
+	}
+
+	result := make([]models.PullRequest, 0, len(page.Values))
+	for _, pr := range page.Values {
+		result = append(result, convertPullRequest(pr))
+	}
+
+	total := page.Start + len(result)
+	if !page.IsLastPage {
+		total = opts.Page*opts.PerPage + 1
+	}
+
+	return &models.PullRequestsResponse{
+		Data: result,
+		Pagination: models.Pagination{
+			Total:   total,
+			Page:    &opts.Page,
+			PerPage: &opts.PerPage,
+		},
+	}, nil
+}
+
+// scanPages iterates over every item of a paged collection, following nextPageStart.
+// subject names the requested resource in not-found errors.
+func scanPages[T any](
+	ctx context.Context,
+	b *BitbucketDataCenterProvider,
+	settings krci.GitServerSettings,
+	apiURL string,
+	query url.Values,
+	subject string,
+) xiter.Scan[T] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		start := 0
+
+		for {
+			var page pagedResponse[T]
+
+			resp, err := b.request(ctx, settings).
+				SetQueryParamsFromValues(query).
+				SetQueryParam("start", strconv.Itoa(start)).
+				SetQueryParam("limit", strconv.Itoa(pageLimit)).
+				SetResult(&page).
+				Get(apiURL)
+			if err != nil {
+				yield(zero, fmt.Errorf("failed to list %s: %w", subject, err))
+
+				return
+			}
+
+			if err := checkResponse(resp, subject); err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range page.Values {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if page.IsLastPage || page.NextPageStart <= start {
+				return
+			}
+
+			start = page.NextPageStart
+		}
+	}
+}
+
+func (b *BitbucketDataCenterProvider) request(ctx context.Context, settings krci.GitServerSettings) *resty.Request {
+	return b.httpClient.R().
+		SetContext(ctx).
+		SetAuthToken(settings.Token).
+		SetHeader("Accept", "application/json")
+}
+
+// checkResponse maps Bitbucket Data Center error statuses to gitfusion error sentinels.
+func checkResponse(resp *resty.Response, subject string) error {
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		return fmt.Errorf("%s: %w", subject, gferrors.ErrNotFound)
+	case resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden:
+		return fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case resp.IsError():
+		return fmt.Errorf("request for %s failed: status %d, body: %s", subject, resp.StatusCode(), resp.String())
+	default:
+		return nil
+	}
+}
+
+func apiBaseURL(settings krci.GitServerSettings) string {
+	return strings.TrimRight(settings.Url, "/") + restAPIPath
+}
+
+func repositoryURL(settings krci.GitServerSettings, owner, repo string) string {
+	return fmt.Sprintf("%s/projects/%s/repos/%s", apiBaseURL(settings), url.PathEscape(owner), url.PathEscape(repo))
+}
+
+func convertRepository(repo bitbucketRepository) models.Repository {
+	visibility := models.RepositoryVisibilityPrivate
+	if repo.Public {
+		visibility = models.RepositoryVisibilityPublic
+	}
+
+	result := models.Repository{
+		Id:         strconv.Itoa(repo.ID),
+		Name:       repo.Slug,
+		Owner:      &repo.Project.Key,
+		Visibility: &visibility,
+	}
+
+	if repo.Description != "" {
+		result.Description = &repo.Description
+	}
+
+	if len(repo.Links.Self) > 0 {
+		result.Url = &repo.Links.Self[0].Href
+	}
+
+	return result
+}
+
+func convertPullRequest(pr bitbucketPullRequest) models.PullRequest {
+	author := &models.Owner{
+		Id:   strconv.Itoa(pr.Author.User.ID),
+		Name: pr.Author.User.DisplayName,
+	}
+
+	if author.Name == "" {
+		author.Name = pr.Author.User.Name
+	}
+
+	result := models.PullRequest{
+		Id:           strconv.Itoa(pr.ID),
+		Number:       pr.ID,
+		Title:        pr.Title,
+		State:        convertPRState(pr.State),
+		SourceBranch: pr.FromRef.DisplayID,
+		TargetBranch: pr.ToRef.DisplayID,
+		Author:       author,
+		CreatedAt:    time.UnixMilli(pr.CreatedDate).UTC(),
+		UpdatedAt:    time.UnixMilli(pr.UpdatedDate).UTC(),
+		Draft:        pr.Draft,
+	}
+
+	if len(pr.Links.Self) > 0 {
+		result.Url = pr.Links.Self[0].Href
+	}
+
+	if pr.Description != "" {
+		result.Description = &pr.Description
+	}
+
+	if pr.FromRef.LatestCommit != "" {
+		result.CommitSha = &pr.FromRef.LatestCommit
+	}
+
+	return result
+}
+
+func convertPRState(state string) models.PullRequestState {
+	switch state {
+	case "MERGED":
+		return models.PullRequestStateMerged
+	case "DECLINED":
+		return models.PullRequestStateClosed
+	default:
+		return models.PullRequestStateOpen
+	}
+}
+
+func convertPRStateFilter(state string) string {
+	switch state {
+	case "closed":
+		return "DECLINED"
+	case "merged":
+		return "MERGED"
+	case "all":
+		return "ALL"
+	default:
+		return "OPEN"
+	}
+}
@@ -0,0 +1,236 @@
+package bitbucketdc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+)
+
+func testSettings(serverURL string) krci.GitServerSettings {
+	return krci.GitServerSettings{
+		Url:           serverURL,
+		Token:         "test-token",
+		GitProvider:   "bitbucketdc",
+		GitServerName: "bitbucket-dc",
+	}
+}
+
+func writeJSON(w http.ResponseWriter, body string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte(body))
+}
+
+func TestBitbucketDataCenterProviderGetRepository(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/api/1.0/projects/PRJ/repos/my-repo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		writeJSON(w, `{
+			"id": 7, "slug": "my-repo", "name": "My Repo", "description": "demo", "public": false,
+			"project": {"id": 1, "key": "PRJ", "name": "Project"},
+			"links": {"self": [{"href": "https://bb.example.com/projects/PRJ/repos/my-repo/browse"}]}
+		}`)
+	})
+	mux.HandleFunc("/rest/api/1.0/projects/PRJ/repos/my-repo/branches/default",
+		func(w http.ResponseWriter, _ *http.Request) {
+			writeJSON(w, `{"id": "refs/heads/main", "displayId": "main", "isDefault": true}`)
+		})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	repo, err := NewBitbucketDataCenterProvider().GetRepository(
+		context.Background(), "PRJ", "my-repo", testSettings(server.URL))
+
+	require.NoError(t, err)
+	assert.Equal(t, "7", repo.Id)
+	assert.Equal(t, "my-repo", repo.Name)
+	assert.Equal(t, "PRJ", pointer.ValueOrEmpty(repo.Owner))
+	assert.Equal(t, "main", pointer.ValueOrEmpty(repo.DefaultBranch))
+	assert.Equal(t, "demo", pointer.ValueOrEmpty(repo.Description))
+	assert.Equal(t, "https://bb.example.com/projects/PRJ/repos/my-repo/browse", pointer.ValueOrEmpty(repo.Url))
+	require.NotNil(t, repo.Visibility)
+	assert.Equal(t, models.RepositoryVisibilityPrivate, *repo.Visibility)
+}
+
+func TestBitbucketDataCenterProviderGetRepositoryErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "not found", status: http.StatusNotFound, wantErr: gferrors.ErrNotFound},
+		{name: "unauthorized", status: http.StatusUnauthorized, wantErr: gferrors.ErrUnauthorized},
+		{name: "forbidden", status: http.StatusForbidden, wantErr: gferrors.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			_, err := NewBitbucketDataCenterProvider().GetRepository(
+				context.Background(), "PRJ", "missing", testSettings(server.URL))
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.wantErr))
+		})
+	}
+}
+
+func TestBitbucketDataCenterProviderListRepositoriesPaginatesAndFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/1.0/projects/PRJ/repos", r.URL.Path)
+		assert.Equal(t, "100", r.URL.Query().Get("limit"))
+
+		switch r.URL.Query().Get("start") {
+		case "0":
+			writeJSON(w, `{"size": 2, "start": 0, "isLastPage": false, "nextPageStart": 2, "values": [
+				{"id": 1, "slug": "api-service", "name": "API Service", "project": {"key": "PRJ"}},
+				{"id": 2, "slug": "web", "name": "Web", "project": {"key": "PRJ"}, "public": true}
+			]}`)
+		case "2":
+			writeJSON(w, `{"size": 1, "start": 2, "isLastPage": true, "values": [
+				{"id": 3, "slug": "service-mesh", "name": "Service Mesh", "project": {"key": "PRJ"}}
+			]}`)
+		default:
+			t.Errorf("unexpected start %q", r.URL.Query().Get("start"))
+		}
+	}))
+	defer server.Close()
+
+	provider := NewBitbucketDataCenterProvider()
+
+	all, err := provider.ListRepositories(context.Background(), "PRJ", testSettings(server.URL), models.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, models.RepositoryVisibilityPublic, *all[1].Visibility)
+
+	filtered, err := provider.ListRepositories(context.Background(), "PRJ", testSettings(server.URL), models.ListOptions{
+		Name: pointer.To("service"),
+	})
+	require.NoError(t, err)
+	require.Len(t, filtered, 2)
+	assert.Equal(t, "api-service", filtered[0].Name)
+	assert.Equal(t, "service-mesh", filtered[1].Name)
+}
+
+func TestBitbucketDataCenterProviderListUserOrganizations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/1.0/projects", r.URL.Path)
+		writeJSON(w, `{"size": 2, "start": 0, "isLastPage": true, "values": [
+			{"id": 1, "key": "PRJ", "name": "Project"},
+			{"id": 2, "key": "OPS", "name": "Operations"}
+		]}`)
+	}))
+	defer server.Close()
+
+	orgs, err := NewBitbucketDataCenterProvider().ListUserOrganizations(context.Background(), testSettings(server.URL))
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Organization{
+		{Id: "1", Name: "PRJ"},
+		{Id: "2", Name: "OPS"},
+	}, orgs)
+}
+
+func TestBitbucketDataCenterProviderListBranches(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/1.0/projects/PRJ/repos/my-repo/branches", r.URL.Path)
+		assert.Equal(t, "feat", r.URL.Query().Get("filterText"))
+		writeJSON(w, `{"size": 2, "start": 0, "isLastPage": true, "values": [
+			{"id": "refs/heads/feat/a", "displayId": "feat/a"},
+			{"id": "refs/heads/feat/b", "displayId": "feat/b"}
+		]}`)
+	}))
+	defer server.Close()
+
+	branches, err := NewBitbucketDataCenterProvider().ListBranches(
+		context.Background(), "PRJ", "my-repo", testSettings(server.URL), models.ListOptions{Name: pointer.To("feat")})
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Branch{{Name: "feat/a"}, {Name: "feat/b"}}, branches)
+}
+
+func TestBitbucketDataCenterProviderListPullRequests(t *testing.T) {
+	tests := []struct {
+		name      string
+		state     string
+		wantState string
+		lastPage  bool
+		wantTotal int
+	}{
+		{name: "open, more pages", state: "open", wantState: "OPEN", lastPage: false, wantTotal: 5},
+		{name: "closed maps to declined", state: "closed", wantState: "DECLINED", lastPage: true, wantTotal: 3},
+		{name: "merged", state: "merged", wantState: "MERGED", lastPage: true, wantTotal: 3},
+		{name: "all", state: "all", wantState: "ALL", lastPage: true, wantTotal: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/rest/api/1.0/projects/PRJ/repos/my-repo/pull-requests", r.URL.Path)
+				assert.Equal(t, tt.wantState, r.URL.Query().Get("state"))
+				assert.Equal(t, "2", r.URL.Query().Get("start"))
+				assert.Equal(t, "2", r.URL.Query().Get("limit"))
+
+				isLastPage := "false"
+				if tt.lastPage {
+					isLastPage = "true"
+				}
+
+				writeJSON(w, `{"size": 1, "start": 2, "isLastPage": `+isLastPage+`, "values": [{
+					"id": 42, "title": "Add feature", "description": "details", "state": "MERGED", "draft": false,
+					"createdDate": 1700000000000, "updatedDate": 1700000360000,
+					"fromRef": {"displayId": "feature", "latestCommit": "abc123"},
+					"toRef": {"displayId": "main"},
+					"author": {"user": {"id": 5, "name": "jdoe", "displayName": "Jane Doe"}},
+					"links": {"self": [{"href": "https://bb.example.com/projects/PRJ/repos/my-repo/pull-requests/42"}]}
+				}]}`)
+			}))
+			defer server.Close()
+
+			resp, err := NewBitbucketDataCenterProvider().ListPullRequests(
+				context.Background(), "PRJ", "my-repo", testSettings(server.URL),
+				models.PullRequestListOptions{State: tt.state, Page: 2, PerPage: 2},
+			)
+
+			require.NoError(t, err)
+			require.Len(t, resp.Data, 1)
+			assert.Equal(t, tt.wantTotal, resp.Pagination.Total)
+
+			pr := resp.Data[0]
+			assert.Equal(t, "42", pr.Id)
+			assert.Equal(t, 42, pr.Number)
+			assert.Equal(t, models.PullRequestStateMerged, pr.State)
+			assert.Equal(t, "feature", pr.SourceBranch)
+			assert.Equal(t, "main", pr.TargetBranch)
+			assert.Equal(t, "https://bb.example.com/projects/PRJ/repos/my-repo/pull-requests/42", pr.Url)
+			assert.Equal(t, "Jane Doe", pr.Author.Name)
+			assert.Equal(t, "5", pr.Author.Id)
+			assert.Equal(t, "abc123", pointer.ValueOrEmpty(pr.CommitSha))
+			assert.Equal(t, "details", pointer.ValueOrEmpty(pr.Description))
+			assert.Equal(t, time.UnixMilli(1700000000000).UTC(), pr.CreatedAt)
+			require.NotNil(t, pr.Draft)
+			assert.False(t, *pr.Draft)
+		})
+	}
+}
+
+func TestConvertPRState(t *testing.T) {
+	assert.Equal(t, models.PullRequestStateOpen, convertPRState("OPEN"))
+	assert.Equal(t, models.PullRequestStateMerged, convertPRState("MERGED"))
+	assert.Equal(t, models.PullRequestStateClosed, convertPRState("DECLINED"))
+}
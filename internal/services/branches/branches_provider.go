@@ -8,10 +8,8 @@ import (
 
 	"github.com/KubeRocketCI/gitfusion/internal/cache"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
-	"github.com/KubeRocketCI/gitfusion/internal/services/bitbucket"
-	"github.com/KubeRocketCI/gitfusion/internal/services/github"
-	"github.com/KubeRocketCI/gitfusion/internal/services/gitlab"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
 	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
 )
 
@@ -29,14 +27,10 @@ type MultiProviderBranchesService struct {
 	cache     *sturdyc.Client[[]models.Branch]
 }
 
-func NewMultiProviderBranchesService() *MultiProviderBranchesService {
+func NewMultiProviderBranchesService(reg *registry.Registry) *MultiProviderBranchesService {
 	return &MultiProviderBranchesService{
-		providers: map[string]BranchesProvider{
-			"github":    github.NewGitHubProvider(),
-			"gitlab":    gitlab.NewGitlabProvider(),
-			"bitbucket": bitbucket.NewBitbucketProvider(),
-		},
-		cache: cache.NewBranchCache(),
+		providers: registry.Providers[BranchesProvider](reg, registry.CapabilityBranches),
+		cache:     cache.NewBranchCache(),
 	}
 }
 
@@ -0,0 +1,120 @@
+package commitstatuses
+
+import (
+	"context"
+	"fmt"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+)
+
+// CommitStatusesProvider reads the statuses external systems reported for a commit.
+type CommitStatusesProvider interface {
+	// ListCommitStatuses returns the latest status of each context reported for the commit.
+	ListCommitStatuses(
+		ctx context.Context,
+		project string,
+		sha string,
+		settings krci.GitServerSettings,
+	) ([]models.CommitStatus, error)
+}
+
+// CommitStatusCreateProvider is an optional capability for reporting statuses for a commit.
+type CommitStatusCreateProvider interface {
+	// CreateCommitStatus writes a status for the commit, replacing the previous status of the
+	// same context, and returns the written status.
+	CreateCommitStatus(
+		ctx context.Context,
+		project string,
+		sha string,
+		settings krci.GitServerSettings,
+		opts models.CommitStatusOptions,
+	) (*models.CommitStatus, error)
+}
+
+// MultiProviderCommitStatusesService dispatches commit status requests to the configured
+// provider. Statuses are not cached: they change while the builds reporting them run.
+type MultiProviderCommitStatusesService struct {
+	providers map[string]CommitStatusesProvider
+}
+
+func NewMultiProviderCommitStatusesService(reg *registry.Registry) *MultiProviderCommitStatusesService {
+	return &MultiProviderCommitStatusesService{
+		providers: registry.Providers[CommitStatusesProvider](reg, registry.CapabilityCommitStatuses),
+	}
+}
+
+// ListCommitStatuses returns the statuses of a commit with their combined state.
+func (m *MultiProviderCommitStatusesService) ListCommitStatuses(
+	ctx context.Context,
+	project string,
+	sha string,
+	settings krci.GitServerSettings,
+) (*models.CommitStatusesResponse, error) {
+	provider, ok := m.providers[settings.GitProvider]
+	if !ok {
+		return nil, fmt.Errorf("provider %s does not support commit statuses: %w", settings.GitProvider,
+			gferrors.ErrBadRequest)
+	}
+
+	statuses, err := provider.ListCommitStatuses(ctx, project, sha, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.CommitStatusesResponse{
+		Sha:      sha,
+		State:    combineCommitStates(statuses),
+		Statuses: statuses,
+	}, nil
+}
+
+// CreateCommitStatus writes a status for a commit.
+func (m *MultiProviderCommitStatusesService) CreateCommitStatus(
+	ctx context.Context,
+	project string,
+	sha string,
+	settings krci.GitServerSettings,
+	opts models.CommitStatusOptions,
+) (*models.CommitStatus, error) {
+	provider, ok := m.providers[settings.GitProvider]
+	if !ok {
+		return nil, fmt.Errorf("provider %s does not support commit statuses: %w", settings.GitProvider,
+			gferrors.ErrBadRequest)
+	}
+
+	createProvider, ok := provider.(CommitStatusCreateProvider)
+	if !ok {
+		return nil, fmt.Errorf("provider %s does not support writing commit statuses: %w", settings.GitProvider,
+			gferrors.ErrBadRequest)
+	}
+
+	return createProvider.CreateCommitStatus(ctx, project, sha, settings, opts)
+}
+
+// combineCommitStates reduces the states of a commit's statuses to one: failed when any failed,
+// otherwise cancelled, running or pending when any is, in that order; pending when there are no
+// statuses yet, skipped when every status was skipped and success otherwise.
+func combineCommitStates(statuses []models.CommitStatus) models.CommitState {
+	counts := make(map[models.CommitState]int, len(statuses))
+	for _, s := range statuses {
+		counts[s.State]++
+	}
+
+	switch {
+	case counts[models.CommitStateFailed] > 0:
+		return models.CommitStateFailed
+	case counts[models.CommitStateCancelled] > 0:
+		return models.CommitStateCancelled
+	case counts[models.CommitStateRunning] > 0:
+		return models.CommitStateRunning
+	case counts[models.CommitStatePending] > 0 || len(statuses) == 0:
+		return models.CommitStatePending
+	case counts[models.CommitStateSkipped] == len(statuses):
+		return models.CommitStateSkipped
+	default:
+		return models.CommitStateSuccess
+	}
+}
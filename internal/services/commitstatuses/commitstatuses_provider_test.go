@@ -0,0 +1,126 @@
+package commitstatuses
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+)
+
+type fakeCommitStatusesProvider struct {
+	statuses []models.CommitStatus
+}
+
+func (f *fakeCommitStatusesProvider) ListCommitStatuses(
+	_ context.Context,
+	_ string,
+	_ string,
+	_ krci.GitServerSettings,
+) ([]models.CommitStatus, error) {
+	return f.statuses, nil
+}
+
+func TestNewMultiProviderCommitStatusesService(t *testing.T) {
+	service := NewMultiProviderCommitStatusesService(registry.NewDefault())
+
+	for _, provider := range []string{"github", "gitlab", "bitbucket"} {
+		_, ok := service.providers[provider]
+		assert.True(t, ok, "%s provider should support commit statuses", provider)
+	}
+
+	_, ok := service.providers["gitea"]
+	assert.False(t, ok, "gitea provider should not support commit statuses")
+}
+
+func TestMultiProviderCommitStatusesService_ListCommitStatuses(t *testing.T) {
+	service := &MultiProviderCommitStatusesService{
+		providers: map[string]CommitStatusesProvider{
+			"github": &fakeCommitStatusesProvider{statuses: []models.CommitStatus{
+				{Kind: models.CommitStatusKindStatus, Context: "ci", State: models.CommitStateSuccess},
+				{Kind: models.CommitStatusKindCheckRun, Context: "build", State: models.CommitStateRunning},
+			}},
+		},
+	}
+
+	resp, err := service.ListCommitStatuses(context.Background(), "owner/repo", "abc123",
+		krci.GitServerSettings{GitProvider: "github"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "abc123", resp.Sha)
+	assert.Equal(t, models.CommitStateRunning, resp.State)
+	assert.Len(t, resp.Statuses, 2)
+}
+
+func TestMultiProviderCommitStatusesService_ListCommitStatuses_UnsupportedProvider(t *testing.T) {
+	service := NewMultiProviderCommitStatusesService(registry.NewDefault())
+
+	resp, err := service.ListCommitStatuses(context.Background(), "owner/repo", "abc123",
+		krci.GitServerSettings{GitProvider: "gitea"})
+
+	require.Error(t, err)
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+	assert.Contains(t, err.Error(), "does not support commit statuses")
+}
+
+func TestMultiProviderCommitStatusesService_CreateCommitStatus_ReadOnlyProvider(t *testing.T) {
+	service := &MultiProviderCommitStatusesService{
+		providers: map[string]CommitStatusesProvider{"github": &fakeCommitStatusesProvider{}},
+	}
+
+	status, err := service.CreateCommitStatus(context.Background(), "owner/repo", "abc123",
+		krci.GitServerSettings{GitProvider: "github"},
+		models.CommitStatusOptions{State: models.CommitStateSuccess, Context: "ci"})
+
+	require.Error(t, err)
+	assert.Nil(t, status)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+	assert.Contains(t, err.Error(), "does not support writing commit statuses")
+}
+
+func TestCommitStatusCreateCapabilityDeclarations(t *testing.T) {
+	reg := registry.NewDefault()
+
+	for _, name := range []string{"github", "gitlab", "bitbucket"} {
+		require.True(t, reg.Supports(name, registry.CapabilityCommitStatusCreate))
+
+		_, ok := NewMultiProviderCommitStatusesService(reg).providers[name].(CommitStatusCreateProvider)
+		assert.True(t, ok, "%s declares commitStatusCreate but does not implement it", name)
+	}
+}
+
+func TestCombineCommitStates(t *testing.T) {
+	tests := []struct {
+		name   string
+		states []models.CommitState
+		want   models.CommitState
+	}{
+		{name: "no statuses", states: nil, want: models.CommitStatePending},
+		{name: "all succeeded", states: []models.CommitState{"success", "success"}, want: models.CommitStateSuccess},
+		{name: "success and skipped", states: []models.CommitState{"success", "skipped"}, want: models.CommitStateSuccess},
+		{name: "all skipped", states: []models.CommitState{"skipped", "skipped"}, want: models.CommitStateSkipped},
+		{name: "pending", states: []models.CommitState{"success", "pending"}, want: models.CommitStatePending},
+		{name: "running over pending", states: []models.CommitState{"pending", "running"}, want: models.CommitStateRunning},
+		{name: "cancelled over running", states: []models.CommitState{"running", "cancelled"},
+			want: models.CommitStateCancelled},
+		{name: "failed over everything", states: []models.CommitState{"cancelled", "failed", "running"},
+			want: models.CommitStateFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statuses := make([]models.CommitStatus, 0, len(tt.states))
+			for _, state := range tt.states {
+				statuses = append(statuses, models.CommitStatus{State: state})
+			}
+
+			assert.Equal(t, tt.want, combineCommitStates(statuses))
+		})
+	}
+}
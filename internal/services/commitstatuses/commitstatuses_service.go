@@ -0,0 +1,51 @@
+package commitstatuses
+
+import (
+	"context"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+type CommitStatusesService struct {
+	commitStatusesProvider *MultiProviderCommitStatusesService
+	gitServerService       *krci.GitServerService
+}
+
+func NewCommitStatusesService(
+	commitStatusesProvider *MultiProviderCommitStatusesService,
+	gitServerService *krci.GitServerService,
+) *CommitStatusesService {
+	return &CommitStatusesService{
+		commitStatusesProvider: commitStatusesProvider,
+		gitServerService:       gitServerService,
+	}
+}
+
+// ListCommitStatuses lists the statuses of a commit of the specified git server and project.
+func (s *CommitStatusesService) ListCommitStatuses(
+	ctx context.Context,
+	gitServerName, project, sha string,
+) (*models.CommitStatusesResponse, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.commitStatusesProvider.ListCommitStatuses(ctx, project, sha, settings)
+}
+
+// CreateCommitStatus writes a status for a commit of the specified git server and project with
+// the git server's token.
+func (s *CommitStatusesService) CreateCommitStatus(
+	ctx context.Context,
+	gitServerName, project, sha string,
+	opts models.CommitStatusOptions,
+) (*models.CommitStatus, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.commitStatusesProvider.CreateCommitStatus(ctx, project, sha, settings, opts)
+}
@@ -0,0 +1,221 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+)
+
+// WorkflowLookup describes the search for the GitHub Actions style workflow a trigger dispatches.
+type WorkflowLookup[W any] struct {
+	// Repository names the repository in errors, e.g. "owner/repo".
+	Repository string
+	// Ref is the branch or tag whose workflow definitions are read.
+	Ref string
+	// Name restricts the search to the workflow with that file name or path when not empty.
+	Name string
+	// ListWorkflows returns the workflows of the repository.
+	ListWorkflows func(ctx context.Context) ([]W, error)
+	// Path returns the path of a workflow definition.
+	Path func(workflow W) string
+	// Active reports whether a workflow is enabled.
+	Active func(workflow W) bool
+	// ReadFile returns a workflow definition at Ref, or nil if it does not exist there.
+	ReadFile func(ctx context.Context, path string) ([]byte, error)
+}
+
+// FindDispatchableWorkflow returns the first active workflow (ordered by path) whose definition
+// at the lookup ref declares a workflow_dispatch trigger.
+func FindDispatchableWorkflow[W any](ctx context.Context, l WorkflowLookup[W]) (W, error) {
+	var found W
+
+	workflows, err := l.ListWorkflows(ctx)
+	if err != nil {
+		return found, err
+	}
+
+	active := make([]W, 0, len(workflows))
+
+	for _, workflow := range workflows {
+		if !l.Active(workflow) {
+			continue
+		}
+
+		if l.Name != "" && l.Path(workflow) != l.Name && path.Base(l.Path(workflow)) != l.Name {
+			continue
+		}
+
+		active = append(active, workflow)
+	}
+
+	sort.Slice(active, func(i, k int) bool {
+		return l.Path(active[i]) < l.Path(active[k])
+	})
+
+	for _, workflow := range active {
+		content, err := l.ReadFile(ctx, l.Path(workflow))
+		if err != nil {
+			return found, err
+		}
+
+		if WorkflowHasTrigger(content, "workflow_dispatch") {
+			return workflow, nil
+		}
+	}
+
+	if l.Name != "" {
+		return found, fmt.Errorf("workflow %s with a workflow_dispatch trigger in %s at %s: %w",
+			l.Name, l.Repository, l.Ref, gferrors.ErrNotFound)
+	}
+
+	return found, fmt.Errorf("no workflow with a workflow_dispatch trigger in %s at %s: %w",
+		l.Repository, l.Ref, gferrors.ErrNotFound)
+}
+
+// WorkflowDispatch describes a workflow_dispatch of a GitHub Actions style workflow (GitHub,
+// Gitea and Forgejo Actions) whose API does not return the run the dispatch creates.
+type WorkflowDispatch[R any] struct {
+	// Key identifies the repository, workflow and ref; dispatches with the same key are serialised.
+	Key string
+	// ListRuns returns the recent workflow_dispatch runs of the workflow on the ref.
+	ListRuns func(ctx context.Context) ([]R, error)
+	// RunID returns the ID of a run. Run IDs grow monotonically.
+	RunID func(run R) int64
+	// Dispatch dispatches the workflow.
+	Dispatch func(ctx context.Context) error
+	// Attempts bounds how many times the runs are listed to find the created run.
+	Attempts int
+	// PollInterval is the delay between those lookups.
+	PollInterval time.Duration
+}
+
+// dispatchLock is a one-slot semaphore shared by the dispatches of one WorkflowDispatch.Key.
+// refs counts the dispatches holding or awaiting it; the last one to leave forgets the key.
+type dispatchLock struct {
+	slot chan struct{}
+	refs int
+}
+
+var (
+	dispatchLocksMu sync.Mutex
+	dispatchLocks   = make(map[string]*dispatchLock)
+)
+
+// lockDispatch waits for the dispatch lock of key and returns its release function.
+func lockDispatch(ctx context.Context, key string) (release func(), err error) {
+	dispatchLocksMu.Lock()
+
+	lock, ok := dispatchLocks[key]
+	if !ok {
+		lock = &dispatchLock{slot: make(chan struct{}, 1)}
+		dispatchLocks[key] = lock
+	}
+
+	lock.refs++
+	dispatchLocksMu.Unlock()
+
+	leave := func() {
+		dispatchLocksMu.Lock()
+		defer dispatchLocksMu.Unlock()
+
+		lock.refs--
+		if lock.refs == 0 {
+			delete(dispatchLocks, key)
+		}
+	}
+
+	select {
+	case lock.slot <- struct{}{}:
+	case <-ctx.Done():
+		leave()
+
+		return nil, ctx.Err()
+	}
+
+	return func() {
+		<-lock.slot
+		leave()
+	}, nil
+}
+
+// DispatchWorkflow dispatches a workflow and returns the run the dispatch created: the single run
+// above the newest run listed before dispatching. Dispatches with the same key are serialised, so
+// concurrent triggers through one GitFusion instance never claim the same run; the next dispatch
+// starts as soon as the previous one has identified its run (usually on the first lookup) or given
+// up on it.
+//
+// Once the dispatch has succeeded DispatchWorkflow never fails, since a caller retrying it would
+// create another run. It reports found as false instead when the created run cannot be identified:
+// when it did not appear in time, when the runs could not be listed, or when several new runs
+// appeared because the workflow was also dispatched elsewhere (e.g. from the web UI).
+func DispatchWorkflow[R any](ctx context.Context, d WorkflowDispatch[R]) (run R, found bool, err error) {
+	release, err := lockDispatch(ctx, d.Key)
+	if err != nil {
+		return run, false, err
+	}
+
+	defer release()
+
+	runs, err := d.ListRuns(ctx)
+	if err != nil {
+		return run, false, err
+	}
+
+	var baseline int64
+	for _, listed := range runs {
+		baseline = max(baseline, d.RunID(listed))
+	}
+
+	if err := d.Dispatch(ctx); err != nil {
+		return run, false, err
+	}
+
+	for attempt := range d.Attempts {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				slog.Warn("Stopped looking up the dispatched workflow run", "dispatch", d.Key, "error", ctx.Err())
+
+				return run, false, nil
+			case <-time.After(d.PollInterval):
+			}
+		}
+
+		runs, err := d.ListRuns(ctx)
+		if err != nil {
+			slog.Warn("Failed to look up the dispatched workflow run", "dispatch", d.Key, "error", err)
+
+			return run, false, nil
+		}
+
+		created := make([]R, 0, 1)
+
+		for _, listed := range runs {
+			if d.RunID(listed) > baseline {
+				created = append(created, listed)
+			}
+		}
+
+		switch len(created) {
+		case 0:
+			continue
+		case 1:
+			return created[0], true, nil
+		default:
+			slog.Warn("Several workflow runs appeared after the dispatch; the created one is unknown",
+				"dispatch", d.Key, "runs", len(created))
+
+			return run, false, nil
+		}
+	}
+
+	slog.Warn("The dispatched workflow run did not appear in time", "dispatch", d.Key, "attempts", d.Attempts)
+
+	return run, false, nil
+}
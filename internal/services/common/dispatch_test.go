@@ -0,0 +1,193 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+)
+
+// fakeWorkflowRuns is a workflow whose dispatches each create perDispatch new runs.
+type fakeWorkflowRuns struct {
+	mu          sync.Mutex
+	runs        []int64
+	perDispatch int
+	listErr     error
+	listed      int
+}
+
+func (f *fakeWorkflowRuns) dispatch(key string) WorkflowDispatch[int64] {
+	return WorkflowDispatch[int64]{
+		Key: key,
+		ListRuns: func(context.Context) ([]int64, error) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			f.listed++
+			if f.listErr != nil && f.listed > 1 {
+				return nil, f.listErr
+			}
+
+			return append([]int64(nil), f.runs...), nil
+		},
+		RunID: func(run int64) int64 { return run },
+		Dispatch: func(context.Context) error {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			for range f.perDispatch {
+				f.runs = append(f.runs, int64(len(f.runs)+1))
+			}
+
+			return nil
+		},
+		Attempts: 3,
+	}
+}
+
+func TestDispatchWorkflow(t *testing.T) {
+	workflow := &fakeWorkflowRuns{runs: []int64{1, 2}, perDispatch: 1}
+
+	run, found, err := DispatchWorkflow(context.Background(), workflow.dispatch("repo|ci|main"))
+
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, int64(3), run)
+}
+
+func TestDispatchWorkflowSerialisesDispatches(t *testing.T) {
+	workflow := &fakeWorkflowRuns{perDispatch: 1}
+
+	const dispatches = 5
+
+	var wg sync.WaitGroup
+
+	got := make([]int64, dispatches)
+
+	for i := range dispatches {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			run, found, err := DispatchWorkflow(context.Background(), workflow.dispatch("repo|ci|serial"))
+			assert.NoError(t, err)
+			assert.True(t, found)
+
+			got[i] = run
+		}()
+	}
+
+	wg.Wait()
+
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4, 5}, got, "each dispatch should claim its own run")
+
+	dispatchLocksMu.Lock()
+	defer dispatchLocksMu.Unlock()
+
+	assert.Empty(t, dispatchLocks, "the last dispatch of a key should forget its lock")
+}
+
+func TestDispatchWorkflowCancelledWhileWaiting(t *testing.T) {
+	release, err := lockDispatch(context.Background(), "repo|ci|busy")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, found, err := DispatchWorkflow(ctx, (&fakeWorkflowRuns{perDispatch: 1}).dispatch("repo|ci|busy"))
+	require.ErrorIs(t, err, context.Canceled)
+	assert.False(t, found)
+
+	release()
+
+	dispatchLocksMu.Lock()
+	defer dispatchLocksMu.Unlock()
+
+	assert.NotContains(t, dispatchLocks, "repo|ci|busy")
+}
+
+func TestDispatchWorkflowUnidentifiedRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow *fakeWorkflowRuns
+	}{
+		{name: "run did not appear", workflow: &fakeWorkflowRuns{}},
+		{name: "several runs appeared", workflow: &fakeWorkflowRuns{perDispatch: 2}},
+		{name: "runs could not be listed", workflow: &fakeWorkflowRuns{perDispatch: 1, listErr: errors.New("boom")}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, found, err := DispatchWorkflow(context.Background(), tt.workflow.dispatch("repo|ci|"+tt.name))
+
+			require.NoError(t, err)
+			assert.False(t, found)
+		})
+	}
+}
+
+func TestDispatchWorkflowDispatchError(t *testing.T) {
+	d := (&fakeWorkflowRuns{}).dispatch("repo|ci|rejected")
+	d.Dispatch = func(context.Context) error { return errors.New("rejected") }
+
+	_, found, err := DispatchWorkflow(context.Background(), d)
+
+	require.EqualError(t, err, "rejected")
+	assert.False(t, found)
+}
+
+func TestFindDispatchableWorkflow(t *testing.T) {
+	type workflow struct {
+		path   string
+		active bool
+	}
+
+	files := map[string]string{
+		".github/workflows/a-push.yaml": "on: push\n",
+		".github/workflows/b-off.yaml":  "on: workflow_dispatch\n",
+		".github/workflows/c-run.yaml":  "on: [push, workflow_dispatch]\n",
+		".github/workflows/d-run.yaml":  "on: workflow_dispatch\n",
+	}
+
+	lookup := func(name string) WorkflowLookup[workflow] {
+		return WorkflowLookup[workflow]{
+			Repository: "owner/repo",
+			Ref:        "main",
+			Name:       name,
+			ListWorkflows: func(context.Context) ([]workflow, error) {
+				return []workflow{
+					{path: ".github/workflows/d-run.yaml", active: true},
+					{path: ".github/workflows/c-run.yaml", active: true},
+					{path: ".github/workflows/b-off.yaml"},
+					{path: ".github/workflows/a-push.yaml", active: true},
+				}, nil
+			},
+			Path:   func(w workflow) string { return w.path },
+			Active: func(w workflow) bool { return w.active },
+			ReadFile: func(_ context.Context, path string) ([]byte, error) {
+				return []byte(files[path]), nil
+			},
+		}
+	}
+
+	found, err := FindDispatchableWorkflow(context.Background(), lookup(""))
+	require.NoError(t, err)
+	assert.Equal(t, ".github/workflows/c-run.yaml", found.path)
+
+	found, err = FindDispatchableWorkflow(context.Background(), lookup("d-run.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, ".github/workflows/d-run.yaml", found.path)
+
+	_, err = FindDispatchableWorkflow(context.Background(), lookup("b-off.yaml"))
+	require.ErrorIs(t, err, gferrors.ErrNotFound)
+	assert.Contains(t, err.Error(), "b-off.yaml")
+
+	_, err = FindDispatchableWorkflow(context.Background(), lookup("a-push.yaml"))
+	require.ErrorIs(t, err, gferrors.ErrNotFound)
+}
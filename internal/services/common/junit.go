@@ -0,0 +1,190 @@
+package common
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+// ErrNotJUnit is returned by ParseJUnit for XML documents that are not JUnit reports.
+var ErrNotJUnit = errors.New("not a JUnit report")
+
+type junitSuites struct {
+	Suites []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name   string       `xml:"name,attr"`
+	Cases  []junitCase  `xml:"testcase"`
+	Suites []junitSuite `xml:"testsuite"`
+}
+
+type junitCase struct {
+	Name      string       `xml:"name,attr"`
+	Classname string       `xml:"classname,attr"`
+	File      string       `xml:"file,attr"`
+	Time      string       `xml:"time,attr"`
+	Failure   *junitResult `xml:"failure"`
+	Error     *junitResult `xml:"error"`
+	Skipped   *junitResult `xml:"skipped"`
+	SystemOut string       `xml:"system-out"`
+}
+
+type junitResult struct {
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// ParseJUnit parses a JUnit XML report, rooted at <testsuites> or a single <testsuite>, into test
+// suites. Nested suites are flattened and totals are counted from the cases rather than taken
+// from the suite attributes, which not every tool fills in. Documents with another root element
+// return ErrNotJUnit.
+func ParseJUnit(r io.Reader) ([]models.PipelineTestSuite, error) {
+	dec := xml.NewDecoder(r)
+
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, ErrNotJUnit
+			}
+
+			return nil, fmt.Errorf("failed to parse JUnit report: %w", err)
+		}
+
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		var suites []junitSuite
+
+		switch start.Name.Local {
+		case "testsuites":
+			var root junitSuites
+			if err := dec.DecodeElement(&root, &start); err != nil {
+				return nil, fmt.Errorf("failed to parse JUnit report: %w", err)
+			}
+
+			suites = root.Suites
+		case "testsuite":
+			var suite junitSuite
+			if err := dec.DecodeElement(&suite, &start); err != nil {
+				return nil, fmt.Errorf("failed to parse JUnit report: %w", err)
+			}
+
+			suites = []junitSuite{suite}
+		default:
+			return nil, ErrNotJUnit
+		}
+
+		return flattenJUnitSuites(suites, nil), nil
+	}
+}
+
+// flattenJUnitSuites appends suites and every suite nested in them that has cases to result.
+func flattenJUnitSuites(suites []junitSuite, result []models.PipelineTestSuite) []models.PipelineTestSuite {
+	for i := range suites {
+		if len(suites[i].Cases) > 0 || len(suites[i].Suites) == 0 {
+			cases := make([]models.PipelineTestCase, 0, len(suites[i].Cases))
+			for k := range suites[i].Cases {
+				cases = append(cases, suites[i].Cases[k].testCase())
+			}
+
+			result = append(result, NewPipelineTestSuite(suites[i].Name, cases))
+		}
+
+		result = flattenJUnitSuites(suites[i].Suites, result)
+	}
+
+	return result
+}
+
+func (c *junitCase) testCase() models.PipelineTestCase {
+	tc := models.PipelineTestCase{
+		Name:      c.Name,
+		Status:    models.PipelineTestCaseStatusSuccess,
+		Classname: optionalString(c.Classname),
+		File:      optionalString(c.File),
+	}
+
+	// Some tools write thousands separators ("1,234.5").
+	if d, err := strconv.ParseFloat(strings.ReplaceAll(c.Time, ",", ""), 64); err == nil {
+		tc.Duration = &d
+	}
+
+	var result *junitResult
+
+	switch {
+	case c.Error != nil:
+		tc.Status, result = models.PipelineTestCaseStatusError, c.Error
+	case c.Failure != nil:
+		tc.Status, result = models.PipelineTestCaseStatusFailed, c.Failure
+	case c.Skipped != nil:
+		tc.Status, result = models.PipelineTestCaseStatusSkipped, c.Skipped
+	}
+
+	if result != nil {
+		tc.Message = optionalString(strings.TrimSpace(result.Message))
+		tc.StackTrace = optionalString(strings.TrimSpace(result.Text))
+	}
+
+	tc.SystemOutput = optionalString(strings.TrimSpace(c.SystemOut))
+
+	return tc
+}
+
+// NewPipelineTestSuite returns a test suite with its totals counted from cases.
+func NewPipelineTestSuite(name string, cases []models.PipelineTestCase) models.PipelineTestSuite {
+	var totals models.PipelineTestTotals
+
+	for i := range cases {
+		totals.Count++
+
+		switch cases[i].Status {
+		case models.PipelineTestCaseStatusSuccess:
+			totals.Success++
+		case models.PipelineTestCaseStatusFailed:
+			totals.Failed++
+		case models.PipelineTestCaseStatusSkipped:
+			totals.Skipped++
+		case models.PipelineTestCaseStatusError:
+			totals.Error++
+		}
+
+		if cases[i].Duration != nil {
+			totals.Duration += *cases[i].Duration
+		}
+	}
+
+	return models.PipelineTestSuite{Name: name, Totals: totals, Cases: cases}
+}
+
+// SumPipelineTestTotals adds up the totals of suites.
+func SumPipelineTestTotals(suites []models.PipelineTestSuite) models.PipelineTestTotals {
+	var totals models.PipelineTestTotals
+
+	for i := range suites {
+		totals.Count += suites[i].Totals.Count
+		totals.Success += suites[i].Totals.Success
+		totals.Failed += suites[i].Totals.Failed
+		totals.Skipped += suites[i].Totals.Skipped
+		totals.Error += suites[i].Totals.Error
+		totals.Duration += suites[i].Totals.Duration
+	}
+
+	return totals
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return &s
+}
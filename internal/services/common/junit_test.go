@@ -0,0 +1,85 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+func TestParseJUnit(t *testing.T) {
+	report := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="all" tests="5">
+  <testsuite name="outer">
+    <testsuite name="inner">
+      <testcase name="passes" classname="pkg.Inner" time="1,000.5"/>
+    </testsuite>
+  </testsuite>
+  <testsuite name="unit">
+    <testcase name="fails" classname="pkg.Unit" file="unit_test.go" time="0.5">
+      <failure message="boom" type="AssertionError">
+        at unit_test.go:12
+      </failure>
+    </testcase>
+    <testcase name="errors" classname="pkg.Unit">
+      <error message="panic"/>
+    </testcase>
+    <testcase name="skipped" classname="pkg.Unit">
+      <skipped message="flaky"/>
+    </testcase>
+    <testcase name="prints" classname="pkg.Unit">
+      <system-out>hello</system-out>
+    </testcase>
+  </testsuite>
+</testsuites>`
+
+	suites, err := ParseJUnit(strings.NewReader(report))
+	require.NoError(t, err)
+	require.Len(t, suites, 2, "the outer suite has no cases of its own")
+
+	assert.Equal(t, "inner", suites[0].Name)
+	assert.Equal(t, models.PipelineTestTotals{Count: 1, Success: 1, Duration: 1000.5}, suites[0].Totals)
+
+	unit := suites[1]
+	assert.Equal(t, models.PipelineTestTotals{Count: 4, Success: 1, Failed: 1, Skipped: 1, Error: 1, Duration: 0.5},
+		unit.Totals)
+
+	fails := unit.Cases[0]
+	assert.Equal(t, models.PipelineTestCaseStatusFailed, fails.Status)
+	assert.Equal(t, "boom", *fails.Message)
+	assert.Equal(t, "at unit_test.go:12", *fails.StackTrace)
+	assert.Equal(t, "unit_test.go", *fails.File)
+
+	assert.Equal(t, models.PipelineTestCaseStatusError, unit.Cases[1].Status)
+	assert.Nil(t, unit.Cases[1].StackTrace)
+	assert.Equal(t, models.PipelineTestCaseStatusSkipped, unit.Cases[2].Status)
+	assert.Equal(t, "flaky", *unit.Cases[2].Message)
+	assert.Equal(t, "hello", *unit.Cases[3].SystemOutput)
+	assert.Nil(t, unit.Cases[3].Duration)
+
+	assert.Equal(t, models.PipelineTestTotals{Count: 5, Success: 2, Failed: 1, Skipped: 1, Error: 1, Duration: 1001},
+		SumPipelineTestTotals(suites))
+}
+
+func TestParseJUnitSingleSuite(t *testing.T) {
+	suites, err := ParseJUnit(strings.NewReader(`<testsuite name="solo"><testcase name="a"/></testsuite>`))
+	require.NoError(t, err)
+	require.Len(t, suites, 1)
+	assert.Equal(t, "solo", suites[0].Name)
+	assert.Equal(t, 1, suites[0].Totals.Success)
+}
+
+func TestParseJUnitNotJUnit(t *testing.T) {
+	_, err := ParseJUnit(strings.NewReader(`<?xml version="1.0"?><coverage/>`))
+	assert.ErrorIs(t, err, ErrNotJUnit)
+
+	_, err = ParseJUnit(strings.NewReader(""))
+	assert.ErrorIs(t, err, ErrNotJUnit)
+
+	_, err = ParseJUnit(strings.NewReader(`<testsuite name="cut"><testcase`))
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, ErrNotJUnit)
+}
@@ -0,0 +1,47 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+// RejectPipelineListFilters returns an error wrapping gferrors.ErrBadRequest when opts sets any
+// pipeline list filter beyond ref and status. Providers that cannot apply those filters call it
+// so a filtered request is not answered with unfiltered pipelines.
+func RejectPipelineListFilters(provider string, opts models.PipelineListOptions) error {
+	var set []string
+
+	if opts.Sha != nil {
+		set = append(set, "sha")
+	}
+
+	if opts.Source != nil {
+		set = append(set, "source")
+	}
+
+	if opts.TriggeredBy != nil {
+		set = append(set, "triggeredBy")
+	}
+
+	if opts.UpdatedAfter != nil {
+		set = append(set, "updatedAfter")
+	}
+
+	if opts.UpdatedBefore != nil {
+		set = append(set, "updatedBefore")
+	}
+
+	if opts.Ascending {
+		set = append(set, "sort")
+	}
+
+	if len(set) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("provider %s does not support the pipeline list filters %s: %w",
+		provider, strings.Join(set, ", "), gferrors.ErrBadRequest)
+}
@@ -0,0 +1,31 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+)
+
+func TestRejectPipelineListFilters(t *testing.T) {
+	assert.NoError(t, RejectPipelineListFilters("gitea", models.PipelineListOptions{
+		Ref:    pointer.To("main"),
+		Status: pointer.To("failed"),
+		Page:   1,
+	}))
+
+	err := RejectPipelineListFilters("gitea", models.PipelineListOptions{
+		Sha:          pointer.To("abc123"),
+		UpdatedAfter: pointer.To(time.Now()),
+		Ascending:    true,
+	})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+	assert.Contains(t, err.Error(), "sha, updatedAfter, sort")
+}
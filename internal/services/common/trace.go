@@ -0,0 +1,221 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+// MaxTraceBytes caps a single job trace read to prevent OOM on runaway logs (4 MiB).
+const MaxTraceBytes = 4 * 1024 * 1024
+
+// TraceRequestTimeout bounds a single job trace fetch. The read itself is capped at
+// MaxTraceBytes, so this only guards against a hung or extremely slow connection.
+const TraceRequestTimeout = 30 * time.Second
+
+// MaxJobsTotal is the pagination cap for listing the jobs of a pipeline (5 pages × 100).
+const MaxJobsTotal = 500
+
+// TraceRangeHeader returns the Range header value that requests window w of a trace reading at
+// most maxBytes, or "" when the whole trace is requested. A tail is requested as the last
+// maxBytes bytes, in which ReadTraceWindow then finds the lines.
+func TraceRangeHeader(w models.JobTraceWindow, maxBytes int64) string {
+	if w.TailLines > 0 {
+		return fmt.Sprintf("bytes=-%d", maxBytes)
+	}
+
+	if w.Offset == 0 && w.Limit == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("bytes=%d-%d", w.Offset, w.Offset+traceWindowLimit(w, maxBytes)-1)
+}
+
+// ReadTraceWindow reads window w of a trace from resp, the answer to a request carrying
+// TraceRangeHeader(w, maxBytes); the caller handles statuses other than 200, 206 and 416. A server
+// that ignores the Range header answers 200 with the whole trace, which is then windowed as it
+// streams, so at most maxBytes (twice that for a tail) are held in memory.
+func ReadTraceWindow(resp *http.Response, w models.JobTraceWindow, maxBytes int64) (*models.JobTrace, error) {
+	switch resp.StatusCode {
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The trace has no bytes in the window, or none at all yet.
+		_, total := parseContentRange(resp.Header.Get("Content-Range"))
+
+		return &models.JobTrace{Offset: w.Offset, TotalSize: total}, nil
+	case http.StatusPartialContent:
+		return readPartialTrace(resp, w, maxBytes)
+	default:
+		return readWholeTrace(resp, w, maxBytes)
+	}
+}
+
+func readPartialTrace(resp *http.Response, w models.JobTraceWindow, maxBytes int64) (*models.JobTrace, error) {
+	start, total := parseContentRange(resp.Header.Get("Content-Range"))
+	if start < 0 {
+		// Without a Content-Range only an offset read can tell where the bytes start.
+		if w.TailLines > 0 {
+			return nil, fmt.Errorf("partial trace response has an invalid Content-Range %q",
+				resp.Header.Get("Content-Range"))
+		}
+
+		start = w.Offset
+	}
+
+	limit := traceWindowLimit(w, maxBytes)
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trace: %w", err)
+	}
+
+	if w.TailLines > 0 {
+		return tailTrace(string(data), start, total, w.TailLines), nil
+	}
+
+	end := start + int64(len(data))
+
+	truncated := int64(len(data)) == limit
+	if total != nil {
+		truncated = end < *total
+	}
+
+	return &models.JobTrace{Content: string(data), Offset: start, TotalSize: total, Truncated: truncated}, nil
+}
+
+func readWholeTrace(resp *http.Response, w models.JobTraceWindow, maxBytes int64) (*models.JobTrace, error) {
+	if w.TailLines > 0 {
+		tail := &tailWriter{max: int(maxBytes)}
+
+		n, err := io.Copy(tail, resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read trace: %w", err)
+		}
+
+		return tailTrace(string(tail.buf), n-int64(len(tail.buf)), &n, w.TailLines), nil
+	}
+
+	var total *int64
+	if resp.ContentLength >= 0 {
+		total = &resp.ContentLength
+	}
+
+	if w.Offset > 0 {
+		skipped, err := io.CopyN(io.Discard, resp.Body, w.Offset)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return &models.JobTrace{Offset: w.Offset, TotalSize: &skipped}, nil
+			}
+
+			return nil, fmt.Errorf("failed to read trace: %w", err)
+		}
+	}
+
+	limit := traceWindowLimit(w, maxBytes)
+
+	// resp.Body streams, so the LimitReader stops the socket read at the limit; the extra byte
+	// tells whether more follows. The remainder is not drained: closing the body aborts the
+	// connection instead of downloading the rest.
+	data, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trace: %w", err)
+	}
+
+	truncated := int64(len(data)) > limit
+	if truncated {
+		data = data[:limit]
+	} else if total == nil {
+		size := w.Offset + int64(len(data))
+		total = &size
+	}
+
+	return &models.JobTrace{Content: string(data), Offset: w.Offset, TotalSize: total, Truncated: truncated}, nil
+}
+
+// tailTrace returns the last n lines of content, which starts at offset start of the trace. When
+// content holds fewer lines and does not start the trace, its first line may be cut, so the window
+// starts at its second line instead.
+func tailTrace(content string, start int64, total *int64, n int) *models.JobTrace {
+	tail, skipped := TailLines(content, n)
+	if skipped == 0 && start > 0 {
+		if i := strings.IndexByte(content, '\n'); i >= 0 && i < len(content)-1 {
+			skipped = i + 1
+			tail = content[skipped:]
+		}
+	}
+
+	return &models.JobTrace{Content: tail, Offset: start + int64(skipped), TotalSize: total}
+}
+
+// TailLines returns the last n lines of s and the number of bytes before them; s itself is
+// returned when it has no more than n lines. A trailing newline ends the last line rather than
+// starting a new, empty one.
+func TailLines(s string, n int) (tail string, skipped int) {
+	i := len(strings.TrimSuffix(s, "\n"))
+
+	for ; n > 0; n-- {
+		i = strings.LastIndexByte(s[:i], '\n')
+		if i < 0 {
+			return s, 0
+		}
+	}
+
+	return s[i+1:], i + 1
+}
+
+// traceWindowLimit is the number of bytes to read for window w: its limit, capped at maxBytes.
+func traceWindowLimit(w models.JobTraceWindow, maxBytes int64) int64 {
+	if w.TailLines > 0 || w.Limit <= 0 || w.Limit > maxBytes {
+		return maxBytes
+	}
+
+	return w.Limit
+}
+
+// parseContentRange parses a Content-Range header such as "bytes 0-99/1234" or "bytes */1234".
+// start is -1 when the header names no range and total is nil when the size is unknown ("*").
+func parseContentRange(header string) (start int64, total *int64) {
+	start = -1
+
+	spec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return start, nil
+	}
+
+	rng, size, ok := strings.Cut(spec, "/")
+	if !ok {
+		return start, nil
+	}
+
+	if first, _, ok := strings.Cut(rng, "-"); ok {
+		if n, err := strconv.ParseInt(first, 10, 64); err == nil && n >= 0 {
+			start = n
+		}
+	}
+
+	if n, err := strconv.ParseInt(size, 10, 64); err == nil && n >= 0 {
+		total = &n
+	}
+
+	return start, total
+}
+
+// tailWriter keeps the last max bytes written to it.
+type tailWriter struct {
+	buf []byte
+	max int
+}
+
+func (t *tailWriter) Write(p []byte) (int, error) {
+	t.buf = append(t.buf, p...)
+	if over := len(t.buf) - t.max; over > 0 {
+		t.buf = t.buf[over:]
+	}
+
+	return len(p), nil
+}
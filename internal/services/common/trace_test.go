@@ -0,0 +1,167 @@
+package common
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+func TestTailLines(t *testing.T) {
+	tests := []struct {
+		name        string
+		s           string
+		n           int
+		wantTail    string
+		wantSkipped int
+	}{
+		{name: "trailing newline", s: "a\nb\nc\n", n: 2, wantTail: "b\nc\n", wantSkipped: 2},
+		{name: "no trailing newline", s: "a\nb\nc", n: 1, wantTail: "c", wantSkipped: 4},
+		{name: "fewer lines than requested", s: "a\nb\n", n: 5, wantTail: "a\nb\n", wantSkipped: 0},
+		{name: "exactly n lines", s: "a\nb\n", n: 2, wantTail: "a\nb\n", wantSkipped: 0},
+		{name: "empty", s: "", n: 3, wantTail: "", wantSkipped: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tail, skipped := TailLines(tt.s, tt.n)
+			assert.Equal(t, tt.wantTail, tail)
+			assert.Equal(t, tt.wantSkipped, skipped)
+		})
+	}
+}
+
+func TestTraceRangeHeader(t *testing.T) {
+	assert.Empty(t, TraceRangeHeader(models.JobTraceWindow{}, 100))
+	assert.Equal(t, "bytes=10-109", TraceRangeHeader(models.JobTraceWindow{Offset: 10}, 100))
+	assert.Equal(t, "bytes=0-19", TraceRangeHeader(models.JobTraceWindow{Limit: 20}, 100))
+	assert.Equal(t, "bytes=10-109", TraceRangeHeader(models.JobTraceWindow{Offset: 10, Limit: 500}, 100))
+	assert.Equal(t, "bytes=-100", TraceRangeHeader(models.JobTraceWindow{TailLines: 5}, 100))
+}
+
+// getTraceWindow requests window w of trace from a server that honors Range headers, or that
+// ignores them when honorRange is false, and reads the answer with ReadTraceWindow.
+func getTraceWindow(
+	t *testing.T, trace string, honorRange bool, w models.JobTraceWindow, maxBytes int64,
+) *models.JobTrace {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if !honorRange {
+			r.Header.Del("Range")
+		}
+
+		http.ServeContent(rw, r, "", time.Time{}, strings.NewReader(trace))
+	}))
+	defer server.Close()
+
+	req, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	if rng := TraceRangeHeader(w, maxBytes); rng != "" {
+		req.Header.Set("Range", rng)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+
+	defer func() { _ = resp.Body.Close() }()
+
+	result, err := ReadTraceWindow(resp, w, maxBytes)
+	require.NoError(t, err)
+
+	return result
+}
+
+func TestReadTraceWindow(t *testing.T) {
+	trace := "line 1\nline 2\nline 3\nline 4\n"
+	size := int64(len(trace))
+
+	tests := []struct {
+		name     string
+		w        models.JobTraceWindow
+		maxBytes int64
+		want     models.JobTrace
+	}{
+		{
+			name:     "whole trace",
+			maxBytes: 100,
+			want:     models.JobTrace{Content: trace, TotalSize: &size},
+		},
+		{
+			name:     "whole trace over the cap",
+			maxBytes: 10,
+			want:     models.JobTrace{Content: "line 1\nlin", TotalSize: &size, Truncated: true},
+		},
+		{
+			name:     "offset and limit",
+			w:        models.JobTraceWindow{Offset: 7, Limit: 7},
+			maxBytes: 100,
+			want:     models.JobTrace{Content: "line 2\n", Offset: 7, TotalSize: &size, Truncated: true},
+		},
+		{
+			name:     "offset to the end",
+			w:        models.JobTraceWindow{Offset: 21},
+			maxBytes: 100,
+			want:     models.JobTrace{Content: "line 4\n", Offset: 21, TotalSize: &size},
+		},
+		{
+			name:     "offset past the end",
+			w:        models.JobTraceWindow{Offset: 50},
+			maxBytes: 100,
+			want:     models.JobTrace{Offset: 50, TotalSize: &size},
+		},
+		{
+			name:     "tail",
+			w:        models.JobTraceWindow{TailLines: 2},
+			maxBytes: 100,
+			want:     models.JobTrace{Content: "line 3\nline 4\n", Offset: 14, TotalSize: &size},
+		},
+		{
+			name:     "tail beyond the cap starts at a whole line",
+			w:        models.JobTraceWindow{TailLines: 3},
+			maxBytes: 17,
+			want:     models.JobTrace{Content: "line 3\nline 4\n", Offset: 14, TotalSize: &size},
+		},
+	}
+
+	for _, tt := range tests {
+		for _, honorRange := range []bool{true, false} {
+			name := tt.name
+			if !honorRange {
+				name += " (range ignored)"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				got := getTraceWindow(t, trace, honorRange, tt.w, tt.maxBytes)
+				assert.Equal(t, tt.want, *got)
+			})
+		}
+	}
+}
+
+func TestParseContentRange(t *testing.T) {
+	start, total := parseContentRange("bytes 10-19/1234")
+	assert.Equal(t, int64(10), start)
+	require.NotNil(t, total)
+	assert.Equal(t, int64(1234), *total)
+
+	start, total = parseContentRange("bytes */42")
+	assert.Equal(t, int64(-1), start)
+	require.NotNil(t, total)
+	assert.Equal(t, int64(42), *total)
+
+	start, total = parseContentRange("bytes 0-9/*")
+	assert.Equal(t, int64(0), start)
+	assert.Nil(t, total)
+
+	start, total = parseContentRange("")
+	assert.Equal(t, int64(-1), start)
+	assert.Nil(t, total)
+}
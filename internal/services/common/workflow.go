@@ -0,0 +1,125 @@
+package common
+
+import (
+	"gopkg.in/yaml.v3"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+// WorkflowHasTrigger reports whether a GitHub Actions style workflow definition (also used by
+// Gitea and Forgejo Actions) lists the given event under "on", which may be a single event,
+// a list of events or a map of event configurations.
+func WorkflowHasTrigger(content []byte, event string) bool {
+	var def struct {
+		On yaml.Node `yaml:"on"`
+	}
+
+	if err := yaml.Unmarshal(content, &def); err != nil {
+		return false
+	}
+
+	switch def.On.Kind {
+	case yaml.ScalarNode:
+		return def.On.Value == event
+	case yaml.SequenceNode:
+		for _, n := range def.On.Content {
+			if n.Value == event {
+				return true
+			}
+		}
+	case yaml.MappingNode:
+		// Mapping content alternates key and value nodes.
+		for i := 0; i < len(def.On.Content); i += 2 {
+			if def.On.Content[i].Value == event {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// WorkflowSchedules returns the cron expressions a GitHub Actions style workflow definition lists
+// under on.schedule, in order. Definitions without a schedule trigger, and invalid ones, yield none.
+func WorkflowSchedules(content []byte) []string {
+	var def struct {
+		On yaml.Node `yaml:"on"`
+	}
+
+	if err := yaml.Unmarshal(content, &def); err != nil || def.On.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	var crons []string
+
+	for i := 0; i+1 < len(def.On.Content); i += 2 {
+		if def.On.Content[i].Value != "schedule" {
+			continue
+		}
+
+		var entries []struct {
+			Cron string `yaml:"cron"`
+		}
+
+		if err := def.On.Content[i+1].Decode(&entries); err != nil {
+			return nil
+		}
+
+		for _, e := range entries {
+			if e.Cron != "" {
+				crons = append(crons, e.Cron)
+			}
+		}
+	}
+
+	return crons
+}
+
+// WorkflowJobCalls maps the jobs of a GitHub Actions style workflow definition that call a
+// reusable workflow to the workflow they call (their "uses"). Jobs are keyed by their name, or by
+// their ID when they have none, as GitHub names the jobs of the call after it. Definitions
+// without such jobs, and invalid ones, yield none.
+func WorkflowJobCalls(content []byte) map[string]string {
+	var def struct {
+		Jobs map[string]struct {
+			Name string `yaml:"name"`
+			Uses string `yaml:"uses"`
+		} `yaml:"jobs"`
+	}
+
+	if err := yaml.Unmarshal(content, &def); err != nil {
+		return nil
+	}
+
+	calls := make(map[string]string)
+
+	for id, job := range def.Jobs {
+		if job.Uses == "" {
+			continue
+		}
+
+		name := job.Name
+		if name == "" {
+			name = id
+		}
+
+		calls[name] = job.Uses
+	}
+
+	return calls
+}
+
+// WorkflowInputs maps pipeline variables to workflow_dispatch inputs. Workflow inputs are
+// untyped strings, so the variable type is ignored.
+func WorkflowInputs(variables []models.PipelineVariable) map[string]any {
+	if len(variables) == 0 {
+		return nil
+	}
+
+	inputs := make(map[string]any, len(variables))
+	for _, v := range variables {
+		inputs[v.Key] = v.Value
+	}
+
+	return inputs
+}
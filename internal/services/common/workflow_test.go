@@ -0,0 +1,89 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+func TestWorkflowHasTrigger(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    bool
+	}{
+		{name: "scalar trigger", content: "on: workflow_dispatch\n", want: true},
+		{name: "list of triggers", content: "on: [push, workflow_dispatch]\n", want: true},
+		{
+			name:    "map of triggers",
+			content: "on:\n  push:\n    branches: [main]\n  workflow_dispatch:\n    inputs:\n      env: {}\n",
+			want:    true,
+		},
+		{name: "other triggers only", content: "on:\n  push:\n  pull_request:\n", want: false},
+		{name: "input named like the trigger", content: "on:\n  push:\n    workflow_dispatch: x\n", want: false},
+		{name: "no on key", content: "name: ci\n", want: false},
+		{name: "invalid yaml", content: "on: [unclosed\n", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, WorkflowHasTrigger([]byte(tt.content), "workflow_dispatch"))
+		})
+	}
+}
+
+func TestWorkflowSchedules(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "schedule entries in order",
+			content: "on:\n  push:\n  schedule:\n    - cron: '0 1 * * *'\n    - cron: '30 5 * * 1-5'\n",
+			want:    []string{"0 1 * * *", "30 5 * * 1-5"},
+		},
+		{name: "no schedule trigger", content: "on: [push, workflow_dispatch]\n"},
+		{name: "malformed schedule", content: "on:\n  schedule: nightly\n"},
+		{name: "invalid yaml", content: "on: [unclosed\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, WorkflowSchedules([]byte(tt.content)))
+		})
+	}
+}
+
+func TestWorkflowJobCalls(t *testing.T) {
+	content := `
+jobs:
+  build:
+    runs-on: ubuntu-latest
+  deploy:
+    name: Deploy
+    uses: ./.github/workflows/deploy.yaml
+  scan:
+    uses: org/shared/.github/workflows/scan.yaml@v1
+`
+
+	assert.Equal(t, map[string]string{
+		"Deploy": "./.github/workflows/deploy.yaml",
+		"scan":   "org/shared/.github/workflows/scan.yaml@v1",
+	}, WorkflowJobCalls([]byte(content)))
+	assert.Nil(t, WorkflowJobCalls([]byte("jobs: [unclosed\n")))
+}
+
+func TestWorkflowInputs(t *testing.T) {
+	assert.Nil(t, WorkflowInputs(nil))
+
+	fileType := models.File
+	inputs := WorkflowInputs([]models.PipelineVariable{
+		{Key: "env", Value: "prod"},
+		{Key: "config", Value: "a=b", VariableType: &fileType},
+	})
+
+	assert.Equal(t, map[string]any{"env": "prod", "config": "a=b"}, inputs)
+}
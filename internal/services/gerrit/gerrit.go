@@ -0,0 +1,583 @@
+package gerrit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+	"github.com/KubeRocketCI/gitfusion/pkg/xiter"
+)
+
+// authPrefix is prepended to REST API paths to make Gerrit authenticate the request.
+const authPrefix = "/a"
+
+// xssiPrefix is the magic prefix Gerrit puts in front of every JSON response to prevent XSSI.
+// See https://gerrit-review.googlesource.com/Documentation/rest-api.html#output
+const xssiPrefix = ")]}'"
+
+// pageSize is the page size (n) used when scanning list APIs.
+const pageSize = 100
+
+// timeLayout is the layout of Gerrit timestamps, which are always in UTC.
+const timeLayout = "2006-01-02 15:04:05.000000000"
+
+const branchRefPrefix = "refs/heads/"
+
+const (
+	changeStatusMerged    = "MERGED"
+	changeStatusAbandoned = "ABANDONED"
+)
+
+// GerritProvider implements the repository, organization, branch and pull request providers for
+// Gerrit Code Review.
+//
+// Gerrit has no organizations, so parent projects (the projects other projects inherit access
+// rights from, All-Projects by default) are exposed as organizations and used as repository
+// owners. Changes are exposed as pull requests. Requests authenticate with the GitServer user
+// name (spec.gitUser) and the token as HTTP password, so spec.gitUser must name the Gerrit
+// account owning the token's HTTP password, even where it is also used as the SSH user.
+type GerritProvider struct {
+	httpClient *resty.Client
+}
+
+func NewGerritProvider() *GerritProvider {
+	return &GerritProvider{
+		httpClient: resty.New().SetJSONUnmarshaler(unmarshalJSON),
+	}
+}
+
+// gerritTime is a Gerrit timestamp ("2025-03-01 10:00:00.000000000", UTC).
+type gerritTime struct {
+	time.Time
+}
+
+func (t *gerritTime) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed, err := time.ParseInLocation(timeLayout, s, time.UTC)
+	if err != nil {
+		return fmt.Errorf("failed to parse Gerrit timestamp %q: %w", s, err)
+	}
+
+	t.Time = parsed
+
+	return nil
+}
+
+type gerritWebLink struct {
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+type gerritProject struct {
+	ID          string          `json:"id"`
+	Name        string          `json:"name"`
+	Parent      string          `json:"parent"`
+	Description string          `json:"description"`
+	State       string          `json:"state"`
+	WebLinks    []gerritWebLink `json:"web_links"`
+}
+
+type gerritBranch struct {
+	Ref      string `json:"ref"`
+	Revision string `json:"revision"`
+}
+
+type gerritAccount struct {
+	AccountID int    `json:"_account_id"`
+	Name      string `json:"name"`
+	Username  string `json:"username"`
+	Avatars   []struct {
+		URL    string `json:"url"`
+		Height int    `json:"height"`
+	} `json:"avatars"`
+}
+
+type gerritRevision struct {
+	Ref    string `json:"ref"`
+	Commit *struct {
+		Message string `json:"message"`
+	} `json:"commit,omitempty"`
+}
+
+type gerritChange struct {
+	ID              string                    `json:"id"`
+	Project         string                    `json:"project"`
+	Branch          string                    `json:"branch"`
+	Subject         string                    `json:"subject"`
+	Status          string                    `json:"status"`
+	Created         gerritTime                `json:"created"`
+	Updated         gerritTime                `json:"updated"`
+	Number          int                       `json:"_number"`
+	Owner           gerritAccount             `json:"owner"`
+	WorkInProgress  bool                      `json:"work_in_progress"`
+	CurrentRevision string                    `json:"current_revision"`
+	Revisions       map[string]gerritRevision `json:"revisions"`
+	MoreChanges     bool                      `json:"_more_changes"`
+}
+
+// GetRepository returns a Gerrit project. The owner must be the project's parent.
+func (g *GerritProvider) GetRepository(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+) (*models.Repository, error) {
+	var project gerritProject
+
+	req, err := g.request(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.
+		SetResult(&project).
+		Get(projectURL(settings.Url, repo))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project %s: %w", repo, err)
+	}
+
+	if err := checkResponse(resp, "project "+repo); err != nil {
+		return nil, err
+	}
+
+	if project.Parent != owner {
+		return nil, fmt.Errorf("project %s with parent %s: %w", repo, owner, gferrors.ErrNotFound)
+	}
+
+	result := convertProject(settings.Url, project)
+
+	// The default branch is best-effort: HEAD may be unreadable for the user.
+	var head string
+
+	if req, err = g.request(ctx, settings); err != nil {
+		return result, nil
+	}
+
+	resp, err = req.
+		SetResult(&head).
+		Get(projectURL(settings.Url, repo) + "/HEAD")
+	if err == nil && resp.IsSuccess() && strings.HasPrefix(head, branchRefPrefix) {
+		defaultBranch := strings.TrimPrefix(head, branchRefPrefix)
+		result.DefaultBranch = &defaultBranch
+	}
+
+	return result, nil
+}
+
+// ListRepositories lists the code projects whose parent is owner.
+func (g *GerritProvider) ListRepositories(
+	ctx context.Context,
+	owner string,
+	settings krci.GitServerSettings,
+	listOptions models.ListOptions,
+) ([]models.Repository, error) {
+	result := make([]models.Repository, 0)
+
+	for project, err := range g.scanProjects(ctx, settings, pointer.ValueOrEmpty(listOptions.Name)) {
+		if err != nil {
+			return nil, err
+		}
+
+		if project.Parent != owner {
+			continue
+		}
+
+		result = append(result, *convertProject(settings.Url, project))
+	}
+
+	return result, nil
+}
+
+// ListUserOrganizations returns the parent projects of the code projects visible to the user.
+// Parents are collected from the full project list, which Gerrit cannot filter by parent.
+func (g *GerritProvider) ListUserOrganizations(
+	ctx context.Context,
+	settings krci.GitServerSettings,
+) ([]models.Organization, error) {
+	parents := make(map[string]bool)
+
+	for project, err := range g.scanProjects(ctx, settings, "") {
+		if err != nil {
+			return nil, err
+		}
+
+		if project.Parent != "" {
+			parents[project.Parent] = true
+		}
+	}
+
+	names := make([]string, 0, len(parents))
+	for name := range parents {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	result := make([]models.Organization, 0, len(names))
+	for _, name := range names {
+		result = append(result, models.Organization{
+			Id:   name,
+			Name: name,
+		})
+	}
+
+	return result, nil
+}
+
+// ListBranches returns the branches (refs/heads/*) of a project.
+func (g *GerritProvider) ListBranches(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	opts models.ListOptions,
+) ([]models.Branch, error) {
+	query := url.Values{}
+	if opts.Name != nil && *opts.Name != "" {
+		query.Set("m", *opts.Name)
+	}
+
+	result := make([]models.Branch, 0)
+
+	branchesURL := projectURL(settings.Url, repo) + "/branches/"
+
+	for branch, err := range scanList[gerritBranch](ctx, g, settings, branchesURL, query, "project "+repo) {
+		if err != nil {
+			return nil, err
+		}
+
+		// The list also contains HEAD and refs/meta/config.
+		if !strings.HasPrefix(branch.Ref, branchRefPrefix) {
+			continue
+		}
+
+		result = append(result, models.Branch{
+			Name: strings.TrimPrefix(branch.Ref, branchRefPrefix),
+		})
+	}
+
+	return result, nil
+}
+
+// ListPullRequests returns a single page of the project's changes. Gerrit does not report a
+// total count, so while further pages exist the total is reported as one past the current page.
+func (g *GerritProvider) ListPullRequests(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	opts models.PullRequestListOptions,
+) (*models.PullRequestsResponse, error) {
+	offset := (opts.Page - 1) * opts.PerPage
+
+	var changes []gerritChange
+
+	req, err := g.request(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.
+		SetQueryParam("q", changeQuery(repo, opts.State)).
+		SetQueryParam("n", strconv.Itoa(opts.PerPage)).
+		SetQueryParam("S", strconv.Itoa(offset)).
+		SetQueryParamsFromValues(url.Values{"o": {"DETAILED_ACCOUNTS", "CURRENT_REVISION", "CURRENT_COMMIT"}}).
+		SetResult(&changes).
+		Get(strings.TrimRight(settings.Url, "/") + authPrefix + "/changes/")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list changes for project %s: %w", repo, err)
+	}
+
+	if err := checkResponse(resp, "project "+repo); err != nil {
+		return nil, err
+	}
+
+	result := make([]models.PullRequest, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, convertChange(settings.Url, change))
+	}
+
+	total := offset + len(result)
+	if len(changes) > 0 && changes[len(changes)-1].MoreChanges {
+		total = opts.Page*opts.PerPage + 1
+	}
+
+	return &models.PullRequestsResponse{
+		Data: result,
+		Pagination: models.Pagination{
+			Total:   total,
+			Page:    &opts.Page,
+			PerPage: &opts.PerPage,
+		},
+	}, nil
+}
+
+// scanProjects iterates over the code projects visible to the user, optionally filtered by a
+// substring of their name. The list API returns a map keyed by name, so every page is sorted.
+func (g *GerritProvider) scanProjects(
+	ctx context.Context,
+	settings krci.GitServerSettings,
+	nameFilter string,
+) xiter.Scan[gerritProject] {
+	return func(yield func(gerritProject, error) bool) {
+		for skip := 0; ; skip += pageSize {
+			var page map[string]gerritProject
+
+			req, err := g.request(ctx, settings)
+			if err != nil {
+				yield(gerritProject{}, err)
+
+				return
+			}
+
+			req.
+				SetQueryParam("type", "CODE").
+				SetQueryParam("n", strconv.Itoa(pageSize)).
+				SetQueryParam("S", strconv.Itoa(skip)).
+				SetResult(&page)
+
+			if nameFilter != "" {
+				req.SetQueryParam("m", nameFilter)
+			}
+
+			// Descriptions (d) and parents (t) are flags without a value.
+			resp, err := req.Get(strings.TrimRight(settings.Url, "/") + authPrefix + "/projects/?d&t")
+			if err != nil {
+				yield(gerritProject{}, fmt.Errorf("failed to list projects: %w", err))
+
+				return
+			}
+
+			if err := checkResponse(resp, "projects"); err != nil {
+				yield(gerritProject{}, err)
+
+				return
+			}
+
+			names := make([]string, 0, len(page))
+			for name := range page {
+				names = append(names, name)
+			}
+
+			sort.Strings(names)
+
+			for _, name := range names {
+				project := page[name]
+				project.Name = name
+
+				if !yield(project, nil) {
+					return
+				}
+			}
+
+			if len(page) < pageSize {
+				return
+			}
+		}
+	}
+}
+
+// scanList iterates over every item of a list API that pages by limit (n) and skip (S).
+// subject names the requested resource in not-found errors.
+func scanList[T any](
+	ctx context.Context,
+	g *GerritProvider,
+	settings krci.GitServerSettings,
+	apiURL string,
+	query url.Values,
+	subject string,
+) xiter.Scan[T] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		for skip := 0; ; skip += pageSize {
+			var page []T
+
+			req, err := g.request(ctx, settings)
+			if err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			resp, err := req.
+				SetQueryParamsFromValues(query).
+				SetQueryParam("n", strconv.Itoa(pageSize)).
+				SetQueryParam("S", strconv.Itoa(skip)).
+				SetResult(&page).
+				Get(apiURL)
+			if err != nil {
+				yield(zero, fmt.Errorf("failed to list %s: %w", subject, err))
+
+				return
+			}
+
+			if err := checkResponse(resp, subject); err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(page) < pageSize {
+				return
+			}
+		}
+	}
+}
+
+func (g *GerritProvider) request(ctx context.Context, settings krci.GitServerSettings) (*resty.Request, error) {
+	if settings.GitUser == "" {
+		return nil, fmt.Errorf("GitServer %s has no gitUser, which Gerrit needs as the HTTP user name of the token: %w",
+			settings.GitServerName, gferrors.ErrUnauthorized)
+	}
+
+	return g.httpClient.R().
+		SetContext(ctx).
+		SetBasicAuth(settings.GitUser, settings.Token).
+		SetHeader("Accept", "application/json"), nil
+}
+
+// unmarshalJSON decodes a Gerrit JSON response, dropping the XSSI prefix line.
+func unmarshalJSON(data []byte, v any) error {
+	data = bytes.TrimPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte(xssiPrefix))
+
+	return json.Unmarshal(data, v)
+}
+
+// checkResponse maps Gerrit error statuses to gitfusion error sentinels. Gerrit error bodies are
+// plain text.
+func checkResponse(resp *resty.Response, subject string) error {
+	switch resp.StatusCode() {
+	case http.StatusNotFound:
+		return fmt.Errorf("%s: %w", subject, gferrors.ErrNotFound)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case http.StatusBadRequest:
+		return fmt.Errorf("%s: %s: %w", subject, strings.TrimSpace(resp.String()), gferrors.ErrBadRequest)
+	}
+
+	if resp.IsError() {
+		return fmt.Errorf("request for %s failed: status %d, body: %s", subject, resp.StatusCode(), resp.String())
+	}
+
+	return nil
+}
+
+// projectURL returns the REST API URL of a project; slashes in the project name are encoded.
+func projectURL(serverURL, name string) string {
+	return strings.TrimRight(serverURL, "/") + authPrefix + "/projects/" + url.PathEscape(name)
+}
+
+// changeQuery returns the change search query for a project and a unified pull request state.
+func changeQuery(project, state string) string {
+	query := fmt.Sprintf("project:{%s}", project)
+
+	switch state {
+	case "merged":
+		return query + " status:merged"
+	case "closed":
+		return query + " status:abandoned"
+	case "all":
+		return query
+	default:
+		return query + " status:open"
+	}
+}
+
+func convertProject(serverURL string, project gerritProject) *models.Repository {
+	webURL := strings.TrimRight(serverURL, "/") + "/admin/repos/" + url.PathEscape(project.Name)
+	if len(project.WebLinks) > 0 && project.WebLinks[0].URL != "" {
+		webURL = project.WebLinks[0].URL
+	}
+
+	result := &models.Repository{
+		Id:    project.Name,
+		Name:  project.Name,
+		Owner: &project.Parent,
+		Url:   &webURL,
+	}
+
+	if project.Description != "" {
+		result.Description = &project.Description
+	}
+
+	return result
+}
+
+func convertChange(serverURL string, change gerritChange) models.PullRequest {
+	author := &models.Owner{
+		Id:   strconv.Itoa(change.Owner.AccountID),
+		Name: change.Owner.Name,
+	}
+
+	if author.Name == "" {
+		author.Name = change.Owner.Username
+	}
+
+	if len(change.Owner.Avatars) > 0 {
+		author.AvatarUrl = &change.Owner.Avatars[len(change.Owner.Avatars)-1].URL
+	}
+
+	result := models.PullRequest{
+		Id:           change.ID,
+		Number:       change.Number,
+		Title:        change.Subject,
+		State:        convertChangeStatus(change.Status),
+		TargetBranch: change.Branch,
+		Url: fmt.Sprintf("%s/c/%s/+/%d", strings.TrimRight(serverURL, "/"),
+			url.PathEscape(change.Project), change.Number),
+		Author:    author,
+		CreatedAt: change.Created.Time,
+		UpdatedAt: change.Updated.Time,
+		Draft:     &change.WorkInProgress,
+	}
+
+	// A change has no source branch; its current patch set ref (refs/changes/NN/N/P) is what
+	// gets fetched to check it out.
+	if revision, ok := change.Revisions[change.CurrentRevision]; ok {
+		result.SourceBranch = revision.Ref
+
+		if revision.Commit != nil && revision.Commit.Message != "" {
+			result.Description = &revision.Commit.Message
+		}
+	}
+
+	if change.CurrentRevision != "" {
+		result.CommitSha = &change.CurrentRevision
+	}
+
+	return result
+}
+
+// convertChangeStatus maps a Gerrit change status to the unified state:
+// NEW is open, MERGED is merged and ABANDONED is closed.
+func convertChangeStatus(status string) models.PullRequestState {
+	switch status {
+	case changeStatusMerged:
+		return models.PullRequestStateMerged
+	case changeStatusAbandoned:
+		return models.PullRequestStateClosed
+	default:
+		return models.PullRequestStateOpen
+	}
+}
@@ -0,0 +1,244 @@
+package gerrit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+)
+
+// recorded is a response captured from a Gerrit 3.10 instance and stored under testdata,
+// including the XSSI prefix.
+type recorded struct {
+	fixture string
+	status  int
+	query   map[string]string
+}
+
+// newRecordedServer replays recorded responses keyed by ServeMux pattern
+// (e.g. "GET /a/projects/"). Every request must carry the test user's HTTP credentials.
+func newRecordedServer(t *testing.T, routes map[string]recorded) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	for pattern, rec := range routes {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			user, password, ok := r.BasicAuth()
+			assert.True(t, ok, "request must use basic auth")
+			assert.Equal(t, "git", user)
+			assert.Equal(t, "http-password", password)
+
+			for k, v := range rec.query {
+				assert.Equal(t, v, r.URL.Query().Get(k), "query parameter %s", k)
+			}
+
+			if filepath.Ext(rec.fixture) == ".json" {
+				w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+			}
+
+			if rec.status != 0 {
+				w.WriteHeader(rec.status)
+			}
+
+			if rec.fixture != "" {
+				body, err := os.ReadFile(filepath.Join("testdata", rec.fixture))
+				require.NoError(t, err)
+
+				_, _ = w.Write(body)
+			}
+		})
+	}
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func testSettings(serverURL string) krci.GitServerSettings {
+	return krci.GitServerSettings{
+		Url:           serverURL,
+		Token:         "http-password",
+		GitUser:       "git",
+		GitProvider:   "gerrit",
+		GitServerName: "gerrit",
+	}
+}
+
+func TestGerritProviderGetRepository(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /a/projects/platform%2Fapi":      {fixture: "project.json"},
+		"GET /a/projects/platform%2Fapi/HEAD": {fixture: "head.json"},
+	})
+
+	repo, err := NewGerritProvider().GetRepository(context.Background(), "platform", "platform/api",
+		testSettings(server.URL))
+
+	require.NoError(t, err)
+	assert.Equal(t, "platform/api", repo.Id)
+	assert.Equal(t, "platform/api", repo.Name)
+	assert.Equal(t, "platform", pointer.ValueOrEmpty(repo.Owner))
+	assert.Equal(t, "master", pointer.ValueOrEmpty(repo.DefaultBranch))
+	assert.Equal(t, "Public API service", pointer.ValueOrEmpty(repo.Description))
+	assert.Equal(t, "https://gerrit.example.com/plugins/gitiles/platform/api", pointer.ValueOrEmpty(repo.Url))
+}
+
+func TestGerritProviderGetRepositoryErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		owner   string
+		status  int
+		wantErr error
+	}{
+		{name: "not found", owner: "platform", status: http.StatusNotFound, wantErr: gferrors.ErrNotFound},
+		{name: "unauthorized", owner: "platform", status: http.StatusUnauthorized, wantErr: gferrors.ErrUnauthorized},
+		{name: "other parent", owner: "All-Projects", wantErr: gferrors.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := recorded{status: tt.status}
+			if tt.status == 0 {
+				rec.fixture = "project.json"
+			}
+
+			server := newRecordedServer(t, map[string]recorded{
+				"GET /a/projects/platform%2Fapi": rec,
+			})
+
+			_, err := NewGerritProvider().GetRepository(context.Background(), tt.owner, "platform/api",
+				testSettings(server.URL))
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.wantErr))
+		})
+	}
+}
+
+func TestGerritProviderRequiresGitUser(t *testing.T) {
+	settings := testSettings("http://127.0.0.1:0")
+	settings.GitUser = ""
+
+	_, err := NewGerritProvider().GetRepository(context.Background(), "platform", "platform/api", settings)
+	require.ErrorIs(t, err, gferrors.ErrUnauthorized)
+	assert.Contains(t, err.Error(), "gitUser")
+
+	_, err = NewGerritProvider().ListRepositories(context.Background(), "platform", settings, models.ListOptions{})
+	require.ErrorIs(t, err, gferrors.ErrUnauthorized)
+}
+
+func TestGerritProviderListRepositories(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /a/projects/": {fixture: "projects.json", query: map[string]string{"type": "CODE", "m": "platform"}},
+	})
+
+	repos, err := NewGerritProvider().ListRepositories(context.Background(), "platform", testSettings(server.URL),
+		models.ListOptions{Name: pointer.To("platform")})
+
+	require.NoError(t, err)
+	require.Len(t, repos, 2)
+	assert.Equal(t, "platform/api", repos[0].Name)
+	assert.Equal(t, "Public API service", pointer.ValueOrEmpty(repos[0].Description))
+	assert.Equal(t, "platform/web", repos[1].Name)
+	assert.Equal(t, server.URL+"/admin/repos/platform%2Fweb", pointer.ValueOrEmpty(repos[1].Url))
+}
+
+func TestGerritProviderListUserOrganizations(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /a/projects/": {fixture: "projects.json"},
+	})
+
+	orgs, err := NewGerritProvider().ListUserOrganizations(context.Background(), testSettings(server.URL))
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Organization{
+		{Id: "All-Projects", Name: "All-Projects"},
+		{Id: "platform", Name: "platform"},
+	}, orgs)
+}
+
+func TestGerritProviderListBranches(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /a/projects/platform%2Fapi/branches/": {fixture: "branches.json"},
+	})
+
+	branches, err := NewGerritProvider().ListBranches(context.Background(), "platform", "platform/api",
+		testSettings(server.URL), models.ListOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Branch{{Name: "master"}, {Name: "stable-3.10"}}, branches)
+}
+
+func TestGerritProviderListPullRequests(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /a/changes/": {
+			fixture: "changes.json",
+			query:   map[string]string{"q": "project:{platform/api} status:open", "n": "2", "S": "2"},
+		},
+	})
+
+	resp, err := NewGerritProvider().ListPullRequests(context.Background(), "platform", "platform/api",
+		testSettings(server.URL), models.PullRequestListOptions{State: "open", Page: 2, PerPage: 2})
+
+	require.NoError(t, err)
+	assert.Equal(t, 5, resp.Pagination.Total)
+	require.Len(t, resp.Data, 2)
+
+	pr := resp.Data[0]
+	assert.Equal(t, "platform%2Fapi~master~I8473b95934b5732ac55d26311a706c9c2bde9940", pr.Id)
+	assert.Equal(t, 3965, pr.Number)
+	assert.Equal(t, "Add health endpoint", pr.Title)
+	assert.Equal(t, models.PullRequestStateOpen, pr.State)
+	assert.Equal(t, "refs/changes/65/3965/2", pr.SourceBranch)
+	assert.Equal(t, "master", pr.TargetBranch)
+	assert.Equal(t, server.URL+"/c/platform%2Fapi/+/3965", pr.Url)
+	assert.Equal(t, "Jamie Doe", pr.Author.Name)
+	assert.Equal(t, "1000096", pr.Author.Id)
+	assert.Equal(t, "https://gerrit.example.com/avatar/jdoe?s=32", pointer.ValueOrEmpty(pr.Author.AvatarUrl))
+	assert.Contains(t, pointer.ValueOrEmpty(pr.Description), "Adds /healthz.")
+	assert.Equal(t, "184ebe53805e102605d11f6b143486d15c23a09c", pointer.ValueOrEmpty(pr.CommitSha))
+	assert.True(t, pointer.ValueOrEmpty(pr.Draft))
+	assert.Equal(t, time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC), pr.CreatedAt)
+	assert.Equal(t, time.Date(2025, 3, 2, 8, 30, 15, 123000000, time.UTC), pr.UpdatedAt)
+
+	abandoned := resp.Data[1]
+	assert.Equal(t, models.PullRequestStateClosed, abandoned.State)
+	assert.Equal(t, "ci-bot", abandoned.Author.Name)
+	assert.Nil(t, abandoned.CommitSha)
+}
+
+func TestChangeQuery(t *testing.T) {
+	assert.Equal(t, "project:{platform/api} status:open", changeQuery("platform/api", "open"))
+	assert.Equal(t, "project:{platform/api} status:merged", changeQuery("platform/api", "merged"))
+	assert.Equal(t, "project:{platform/api} status:abandoned", changeQuery("platform/api", "closed"))
+	assert.Equal(t, "project:{platform/api}", changeQuery("platform/api", "all"))
+}
+
+func TestConvertChangeStatus(t *testing.T) {
+	assert.Equal(t, models.PullRequestStateOpen, convertChangeStatus("NEW"))
+	assert.Equal(t, models.PullRequestStateMerged, convertChangeStatus("MERGED"))
+	assert.Equal(t, models.PullRequestStateClosed, convertChangeStatus("ABANDONED"))
+}
+
+func TestUnmarshalJSONStripsXSSIPrefix(t *testing.T) {
+	var v map[string]string
+
+	require.NoError(t, unmarshalJSON([]byte(")]}'\n{\"a\": \"b\"}"), &v))
+	assert.Equal(t, map[string]string{"a": "b"}, v)
+
+	require.NoError(t, unmarshalJSON([]byte(`{"c": "d"}`), &v))
+	assert.Equal(t, "d", v["c"])
+}
@@ -0,0 +1,508 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+	"github.com/KubeRocketCI/gitfusion/pkg/xiter"
+)
+
+// apiPath is the path of the Gitea REST API relative to the server URL.
+const apiPath = "/api/v1"
+
+// pageSize matches Gitea's default MAX_RESPONSE_ITEMS, the largest page the API returns.
+const pageSize = 50
+
+// postFilterMaxPages bounds how many pages ListPullRequests scans when it has to tell merged
+// pull requests from closed ones on the client side.
+const postFilterMaxPages = 10
+
+const (
+	stateOpen   = "open"
+	stateClosed = "closed"
+	stateMerged = "merged"
+	stateAll    = "all"
+)
+
+// GiteaProvider implements every provider interface for self-hosted Gitea, including Gitea Actions
+// for pipelines. Forgejo serves the same repository, organization, branch and pull request API.
+// The GitServer token is a Gitea access token.
+type GiteaProvider struct {
+	httpClient *resty.Client
+
+	// dispatchPollInterval is the delay between lookups of the run created by a workflow dispatch.
+	dispatchPollInterval time.Duration
+}
+
+func NewGiteaProvider() *GiteaProvider {
+	return &GiteaProvider{
+		httpClient:           resty.New(),
+		dispatchPollInterval: time.Second,
+	}
+}
+
+type giteaUser struct {
+	ID        int64  `json:"id"`
+	Login     string `json:"login"`
+	UserName  string `json:"username"`
+	FullName  string `json:"full_name"`
+	AvatarURL string `json:"avatar_url"`
+}
+
+type giteaRepository struct {
+	ID            int64     `json:"id"`
+	Name          string    `json:"name"`
+	FullName      string    `json:"full_name"`
+	Description   string    `json:"description"`
+	HTMLURL       string    `json:"html_url"`
+	DefaultBranch string    `json:"default_branch"`
+	Private       bool      `json:"private"`
+	Owner         giteaUser `json:"owner"`
+}
+
+type giteaBranch struct {
+	Name string `json:"name"`
+}
+
+type giteaPRBranch struct {
+	Ref string `json:"ref"`
+	Sha string `json:"sha"`
+}
+
+type giteaPullRequest struct {
+	ID        int64         `json:"id"`
+	Number    int           `json:"number"`
+	Title     string        `json:"title"`
+	Body      string        `json:"body"`
+	State     string        `json:"state"`
+	Merged    bool          `json:"merged"`
+	Draft     *bool         `json:"draft,omitempty"`
+	HTMLURL   string        `json:"html_url"`
+	User      giteaUser     `json:"user"`
+	Head      giteaPRBranch `json:"head"`
+	Base      giteaPRBranch `json:"base"`
+	CreatedAt time.Time     `json:"created_at"`
+	UpdatedAt time.Time     `json:"updated_at"`
+}
+
+func (g *GiteaProvider) GetRepository(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+) (*models.Repository, error) {
+	var giteaRepo giteaRepository
+
+	resp, err := g.request(ctx, settings).
+		SetResult(&giteaRepo).
+		Get(repositoryURL(settings, owner, repo))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository %s/%s: %w", owner, repo, err)
+	}
+
+	if err := checkResponse(resp, fmt.Sprintf("repository %s/%s", owner, repo)); err != nil {
+		return nil, err
+	}
+
+	return convertRepository(giteaRepo), nil
+}
+
+// ListRepositories lists the repositories of an organization, or of a user when no organization
+// with that name exists.
+func (g *GiteaProvider) ListRepositories(
+	ctx context.Context,
+	owner string,
+	settings krci.GitServerSettings,
+	listOptions models.ListOptions,
+) ([]models.Repository, error) {
+	resp, err := g.request(ctx, settings).Get(apiBaseURL(settings) + "/orgs/" + url.PathEscape(owner))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get organization %s: %w", owner, err)
+	}
+
+	apiURL := apiBaseURL(settings) + "/orgs/" + url.PathEscape(owner) + "/repos"
+
+	if resp.StatusCode() == http.StatusNotFound {
+		apiURL = apiBaseURL(settings) + "/users/" + url.PathEscape(owner) + "/repos"
+	} else if err := checkResponse(resp, "organization "+owner); err != nil {
+		return nil, err
+	}
+
+	nameFilter := strings.ToLower(pointer.ValueOrEmpty(listOptions.Name))
+	result := make([]models.Repository, 0)
+
+	for repo, err := range scanPages[giteaRepository](ctx, g, settings, apiURL, nil, "organization or user "+owner) {
+		if err != nil {
+			return nil, err
+		}
+
+		if nameFilter != "" && !strings.Contains(strings.ToLower(repo.Name), nameFilter) {
+			continue
+		}
+
+		result = append(result, *convertRepository(repo))
+	}
+
+	return result, nil
+}
+
+// ListUserOrganizations returns the authenticated user followed by the organizations they belong to.
+func (g *GiteaProvider) ListUserOrganizations(
+	ctx context.Context,
+	settings krci.GitServerSettings,
+) ([]models.Organization, error) {
+	var user giteaUser
+
+	resp, err := g.request(ctx, settings).
+		SetResult(&user).
+		Get(apiBaseURL(settings) + "/user")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get current user: %w", err)
+	}
+
+	if err := checkResponse(resp, "current user"); err != nil {
+		return nil, err
+	}
+
+	result := []models.Organization{convertOrganization(user)}
+
+	for org, err := range scanPages[giteaUser](ctx, g, settings, apiBaseURL(settings)+"/user/orgs", nil, "organizations") {
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, convertOrganization(org))
+	}
+
+	return result, nil
+}
+
+func (g *GiteaProvider) ListBranches(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	_ models.ListOptions,
+) ([]models.Branch, error) {
+	apiURL := repositoryURL(settings, owner, repo) + "/branches"
+	result := make([]models.Branch, 0)
+
+	for branch, err := range scanPages[giteaBranch](
+		ctx, g, settings, apiURL, nil, fmt.Sprintf("repository %s/%s", owner, repo),
+	) {
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, models.Branch{
+			Name: branch.Name,
+		})
+	}
+
+	return result, nil
+}
+
+// ListPullRequests returns pull requests for the given repository with filtering and pagination.
+// Gitea only filters by open, closed and all, and "closed" includes merged pull requests, so the
+// merged and closed states are post-filtered the same way the GitHub provider does it.
+func (g *GiteaProvider) ListPullRequests(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	opts models.PullRequestListOptions,
+) (*models.PullRequestsResponse, error) {
+	if opts.State == stateMerged || opts.State == stateClosed {
+		return g.listPullRequestsWithPostFilter(ctx, owner, repo, settings, opts)
+	}
+
+	giteaState := stateOpen
+	if opts.State == stateAll {
+		giteaState = stateAll
+	}
+
+	prs, resp, err := g.listPullRequestsPage(ctx, owner, repo, settings, giteaState, opts.Page, opts.PerPage)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.PullRequest, 0, len(prs))
+	for _, pr := range prs {
+		result = append(result, convertPullRequest(pr))
+	}
+
+	total, err := strconv.Atoi(resp.Header().Get("X-Total-Count"))
+	if err != nil {
+		total = (opts.Page-1)*opts.PerPage + len(result)
+	}
+
+	return &models.PullRequestsResponse{
+		Data: result,
+		Pagination: models.Pagination{
+			Total:   total,
+			Page:    &opts.Page,
+			PerPage: &opts.PerPage,
+		},
+	}, nil
+}
+
+// listPullRequestsWithPostFilter scans closed pull requests and keeps those matching opts.State.
+func (g *GiteaProvider) listPullRequestsWithPostFilter(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	opts models.PullRequestListOptions,
+) (*models.PullRequestsResponse, error) {
+	offset := (opts.Page - 1) * opts.PerPage
+	skip := offset
+	result := make([]models.PullRequest, 0, opts.PerPage)
+
+	for page := 1; page <= postFilterMaxPages; page++ {
+		prs, _, err := g.listPullRequestsPage(ctx, owner, repo, settings, stateClosed, page, pageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, pr := range prs {
+			if pr.Merged != (opts.State == stateMerged) {
+				continue
+			}
+
+			if skip > 0 {
+				skip--
+
+				continue
+			}
+
+			result = append(result, convertPullRequest(pr))
+
+			if len(result) >= opts.PerPage {
+				// The page is full before the results ran out: at least one more page may exist.
+				return &models.PullRequestsResponse{
+					Data: result,
+					Pagination: models.Pagination{
+						Total:   opts.Page*opts.PerPage + 1,
+						Page:    &opts.Page,
+						PerPage: &opts.PerPage,
+					},
+				}, nil
+			}
+		}
+
+		if len(prs) < pageSize {
+			break
+		}
+	}
+
+	return &models.PullRequestsResponse{
+		Data: result,
+		Pagination: models.Pagination{
+			Total:   offset - skip + len(result),
+			Page:    &opts.Page,
+			PerPage: &opts.PerPage,
+		},
+	}, nil
+}
+
+func (g *GiteaProvider) listPullRequestsPage(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	state string,
+	page, limit int,
+) ([]giteaPullRequest, *resty.Response, error) {
+	var prs []giteaPullRequest
+
+	resp, err := g.request(ctx, settings).
+		SetQueryParam("state", state).
+		SetQueryParam("page", strconv.Itoa(page)).
+		SetQueryParam("limit", strconv.Itoa(limit)).
+		SetResult(&prs).
+		Get(repositoryURL(settings, owner, repo) + "/pulls")
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list pull requests for %s/%s: %w", owner, repo, err)
+	}
+
+	if err := checkResponse(resp, fmt.Sprintf("repository %s/%s", owner, repo)); err != nil {
+		return nil, nil, err
+	}
+
+	return prs, resp, nil
+}
+
+// scanPages iterates over every item of a page/limit paginated Gitea collection.
+// subject names the requested resource in not-found errors.
+func scanPages[T any](
+	ctx context.Context,
+	g *GiteaProvider,
+	settings krci.GitServerSettings,
+	apiURL string,
+	query url.Values,
+	subject string,
+) xiter.Scan[T] {
+	return func(yield func(T, error) bool) {
+		var zero T
+
+		for page := 1; ; page++ {
+			var items []T
+
+			resp, err := g.request(ctx, settings).
+				SetQueryParamsFromValues(query).
+				SetQueryParam("page", strconv.Itoa(page)).
+				SetQueryParam("limit", strconv.Itoa(pageSize)).
+				SetResult(&items).
+				Get(apiURL)
+			if err != nil {
+				yield(zero, fmt.Errorf("failed to list %s: %w", subject, err))
+
+				return
+			}
+
+			if err := checkResponse(resp, subject); err != nil {
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(items) < pageSize {
+				return
+			}
+		}
+	}
+}
+
+func (g *GiteaProvider) request(ctx context.Context, settings krci.GitServerSettings) *resty.Request {
+	return g.httpClient.R().
+		SetContext(ctx).
+		SetAuthScheme("token").
+		SetAuthToken(settings.Token).
+		SetHeader("Accept", "application/json")
+}
+
+// checkResponse maps Gitea error statuses to gitfusion error sentinels.
+func checkResponse(resp *resty.Response, subject string) error {
+	switch {
+	case resp.StatusCode() == http.StatusNotFound:
+		return fmt.Errorf("%s: %w", subject, gferrors.ErrNotFound)
+	case resp.StatusCode() == http.StatusUnauthorized || resp.StatusCode() == http.StatusForbidden:
+		return fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case resp.StatusCode() == http.StatusBadRequest || resp.StatusCode() == http.StatusUnprocessableEntity:
+		return fmt.Errorf("%s: %s: %w", subject, resp.String(), gferrors.ErrBadRequest)
+	case resp.IsError():
+		return fmt.Errorf("request for %s failed: status %d, body: %s", subject, resp.StatusCode(), resp.String())
+	default:
+		return nil
+	}
+}
+
+func apiBaseURL(settings krci.GitServerSettings) string {
+	return strings.TrimRight(settings.Url, "/") + apiPath
+}
+
+func repositoryURL(settings krci.GitServerSettings, owner, repo string) string {
+	return fmt.Sprintf("%s/repos/%s/%s", apiBaseURL(settings), url.PathEscape(owner), url.PathEscape(repo))
+}
+
+func convertRepository(repo giteaRepository) *models.Repository {
+	visibility := models.RepositoryVisibilityPublic
+	if repo.Private {
+		visibility = models.RepositoryVisibilityPrivate
+	}
+
+	result := &models.Repository{
+		Id:         strconv.FormatInt(repo.ID, 10),
+		Name:       repo.Name,
+		Owner:      &repo.Owner.Login,
+		Url:        &repo.HTMLURL,
+		Visibility: &visibility,
+	}
+
+	if repo.DefaultBranch != "" {
+		result.DefaultBranch = &repo.DefaultBranch
+	}
+
+	if repo.Description != "" {
+		result.Description = &repo.Description
+	}
+
+	return result
+}
+
+// convertOrganization converts a user or an organization; Gitea returns the organization name
+// in "username" and the user name in "login".
+func convertOrganization(u giteaUser) models.Organization {
+	org := models.Organization{
+		Id:   strconv.FormatInt(u.ID, 10),
+		Name: u.Login,
+	}
+
+	if org.Name == "" {
+		org.Name = u.UserName
+	}
+
+	if u.AvatarURL != "" {
+		org.AvatarUrl = &u.AvatarURL
+	}
+
+	return org
+}
+
+func convertPullRequest(pr giteaPullRequest) models.PullRequest {
+	author := &models.Owner{
+		Id:   strconv.FormatInt(pr.User.ID, 10),
+		Name: pr.User.Login,
+	}
+
+	if pr.User.AvatarURL != "" {
+		author.AvatarUrl = &pr.User.AvatarURL
+	}
+
+	result := models.PullRequest{
+		Id:           strconv.FormatInt(pr.ID, 10),
+		Number:       pr.Number,
+		Title:        pr.Title,
+		State:        convertPRState(pr),
+		SourceBranch: pr.Head.Ref,
+		TargetBranch: pr.Base.Ref,
+		Url:          pr.HTMLURL,
+		Author:       author,
+		CreatedAt:    pr.CreatedAt,
+		UpdatedAt:    pr.UpdatedAt,
+		Draft:        pr.Draft,
+	}
+
+	if pr.Body != "" {
+		result.Description = &pr.Body
+	}
+
+	if pr.Head.Sha != "" {
+		result.CommitSha = &pr.Head.Sha
+	}
+
+	return result
+}
+
+func convertPRState(pr giteaPullRequest) models.PullRequestState {
+	switch {
+	case pr.Merged:
+		return models.PullRequestStateMerged
+	case pr.State == stateClosed:
+		return models.PullRequestStateClosed
+	default:
+		return models.PullRequestStateOpen
+	}
+}
@@ -0,0 +1,653 @@
+package gitea
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// dispatchRunLookupAttempts bounds how many times TriggerPipeline polls for the run a
+// workflow dispatch created; the dispatch API itself returns no run ID.
+const dispatchRunLookupAttempts = 10
+
+const (
+	runStatusCompleted    = "completed"
+	eventWorkflowDispatch = "workflow_dispatch"
+)
+
+type giteaWorkflowRunsResponse struct {
+	TotalCount   int                `json:"total_count"`
+	WorkflowRuns []giteaWorkflowRun `json:"workflow_runs"`
+}
+
+type giteaWorkflowRun struct {
+	ID           int64      `json:"id"`
+	DisplayTitle string     `json:"display_title"`
+	Event        string     `json:"event"`
+	HeadBranch   string     `json:"head_branch"`
+	HeadSha      string     `json:"head_sha"`
+	HTMLURL      string     `json:"html_url"`
+	Path         string     `json:"path"`
+	Status       string     `json:"status"`
+	Conclusion   string     `json:"conclusion"`
+	RepositoryID int64      `json:"repository_id"`
+	CreatedAt    *time.Time `json:"created_at,omitempty"`
+	StartedAt    *time.Time `json:"started_at,omitempty"`
+	CompletedAt  *time.Time `json:"completed_at,omitempty"`
+}
+
+type giteaWorkflowJobsResponse struct {
+	TotalCount int                `json:"total_count"`
+	Jobs       []giteaWorkflowJob `json:"jobs"`
+}
+
+type giteaWorkflowJob struct {
+	ID          int64       `json:"id"`
+	RunID       int64       `json:"run_id"`
+	Name        string      `json:"name"`
+	HeadBranch  string      `json:"head_branch"`
+	HTMLURL     string      `json:"html_url"`
+	Status      string      `json:"status"`
+	Conclusion  string      `json:"conclusion"`
+	CreatedAt   *time.Time  `json:"created_at,omitempty"`
+	StartedAt   *time.Time  `json:"started_at,omitempty"`
+	CompletedAt *time.Time  `json:"completed_at,omitempty"`
+	Steps       []giteaStep `json:"steps"`
+}
+
+type giteaStep struct {
+	Number      int        `json:"number"`
+	Name        string     `json:"name"`
+	Status      string     `json:"status"`
+	Conclusion  string     `json:"conclusion"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+type giteaWorkflowsResponse struct {
+	TotalCount int             `json:"total_count"`
+	Workflows  []giteaWorkflow `json:"workflows"`
+}
+
+// giteaWorkflow is a workflow definition; Gitea uses the workflow file name as its ID.
+type giteaWorkflow struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Path    string `json:"path"`
+	State   string `json:"state"`
+	HTMLURL string `json:"html_url"`
+}
+
+// ListPipelines returns Gitea Actions workflow runs for a repository. Only the ref and status
+// filters are applied; the others are rejected.
+func (g *GiteaProvider) ListPipelines(
+	ctx context.Context,
+	project string,
+	settings krci.GitServerSettings,
+	opts models.PipelineListOptions,
+) (*models.PipelinesResponse, error) {
+	if err := common.RejectPipelineListFilters("gitea", opts); err != nil {
+		return nil, err
+	}
+
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("page", strconv.Itoa(opts.Page))
+	query.Set("limit", strconv.Itoa(opts.PerPage))
+
+	if opts.Ref != nil {
+		query.Set("branch", *opts.Ref)
+	}
+
+	if opts.Status != nil {
+		if status := mapPipelineStatusToGitea(*opts.Status); status != "" {
+			query.Set("status", status)
+		}
+	}
+
+	runs, err := g.listWorkflowRuns(ctx, owner, repo, settings, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Pipeline, 0, len(runs.WorkflowRuns))
+	for _, run := range runs.WorkflowRuns {
+		result = append(result, convertWorkflowRun(run))
+	}
+
+	return &models.PipelinesResponse{
+		Data: result,
+		Pagination: models.Pagination{
+			Total:   runs.TotalCount,
+			Page:    &opts.Page,
+			PerPage: &opts.PerPage,
+		},
+	}, nil
+}
+
+// TriggerPipeline dispatches the repository's workflow_dispatch workflow on ref and returns the
+// run the dispatch created. Pipeline variables are passed as workflow inputs; a named pipeline
+// selects the workflow file to dispatch. When the created run cannot be identified (see
+// common.DispatchWorkflow) the response has no ID and links to the workflow's runs.
+func (g *GiteaProvider) TriggerPipeline(
+	ctx context.Context,
+	project string,
+	settings krci.GitServerSettings,
+	opts models.PipelineTriggerOptions,
+) (*models.PipelineResponse, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow, err := g.findDispatchableWorkflow(ctx, owner, repo, settings, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	run, found, err := common.DispatchWorkflow(ctx, common.WorkflowDispatch[giteaWorkflowRun]{
+		Key: fmt.Sprintf("gitea|%s|%s|%s|%s", settings.Url, project, workflow.ID, opts.Ref),
+		ListRuns: func(ctx context.Context) ([]giteaWorkflowRun, error) {
+			return g.listDispatchedRuns(ctx, owner, repo, settings, workflow, opts.Ref)
+		},
+		RunID: func(run giteaWorkflowRun) int64 { return run.ID },
+		Dispatch: func(ctx context.Context) error {
+			return g.dispatchWorkflow(ctx, owner, repo, settings, workflow, opts)
+		},
+		Attempts:     dispatchRunLookupAttempts,
+		PollInterval: g.dispatchPollInterval,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return &models.PipelineResponse{
+			WebUrl: workflowRunsURL(workflow),
+			Status: string(models.PipelineStatusPending),
+			Ref:    opts.Ref,
+		}, nil
+	}
+
+	result := &models.PipelineResponse{
+		Id:     strconv.FormatInt(run.ID, 10),
+		WebUrl: run.HTMLURL,
+		Status: string(normalizeGiteaRunStatus(run.Status, run.Conclusion)),
+		Ref:    opts.Ref,
+	}
+
+	if run.HeadSha != "" {
+		result.Sha = &run.HeadSha
+	}
+
+	return result, nil
+}
+
+// dispatchWorkflow creates a workflow_dispatch event for workflow on the trigger ref with the
+// pipeline variables as inputs.
+func (g *GiteaProvider) dispatchWorkflow(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	workflow giteaWorkflow,
+	opts models.PipelineTriggerOptions,
+) error {
+	resp, err := g.request(ctx, settings).
+		SetBody(map[string]any{
+			"ref":    opts.Ref,
+			"inputs": common.WorkflowInputs(opts.Variables),
+		}).
+		Post(fmt.Sprintf("%s/actions/workflows/%s/dispatches", repositoryURL(settings, owner, repo),
+			url.PathEscape(workflow.ID)))
+	if err != nil {
+		return fmt.Errorf("failed to dispatch workflow %s for %s/%s ref %s: %w", workflow.Path, owner, repo, opts.Ref, err)
+	}
+
+	return checkResponse(resp, fmt.Sprintf("dispatch workflow %s for %s/%s ref %s", workflow.Path, owner, repo, opts.Ref))
+}
+
+// workflowRunsURL returns the web page listing the runs of workflow, derived from the URL of its
+// file ("<repo>/src/<ref>/<path>"), or the file URL when it has another form.
+func workflowRunsURL(workflow giteaWorkflow) string {
+	repoURL, _, ok := strings.Cut(workflow.HTMLURL, "/src/")
+	if !ok {
+		return workflow.HTMLURL
+	}
+
+	return repoURL + "/actions?workflow=" + url.QueryEscape(path.Base(workflow.Path))
+}
+
+// findDispatchableWorkflow returns the workflow to dispatch on the trigger ref; see
+// common.FindDispatchableWorkflow. A named pipeline restricts the search to the workflow with
+// that file name or path.
+func (g *GiteaProvider) findDispatchableWorkflow(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	opts models.PipelineTriggerOptions,
+) (giteaWorkflow, error) {
+	var name string
+	if opts.Pipeline != nil {
+		name = *opts.Pipeline
+	}
+
+	return common.FindDispatchableWorkflow(ctx, common.WorkflowLookup[giteaWorkflow]{
+		Repository: owner + "/" + repo,
+		Ref:        opts.Ref,
+		Name:       name,
+		ListWorkflows: func(ctx context.Context) ([]giteaWorkflow, error) {
+			return g.listWorkflows(ctx, owner, repo, settings)
+		},
+		Path: func(workflow giteaWorkflow) string { return workflow.Path },
+		Active: func(workflow giteaWorkflow) bool {
+			return workflow.State == "active"
+		},
+		ReadFile: func(ctx context.Context, filePath string) ([]byte, error) {
+			return g.getRawFile(ctx, owner, repo, settings, filePath, opts.Ref)
+		},
+	})
+}
+
+// listWorkflows returns the workflows of the repository.
+func (g *GiteaProvider) listWorkflows(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+) ([]giteaWorkflow, error) {
+	var workflows giteaWorkflowsResponse
+
+	resp, err := g.request(ctx, settings).
+		SetResult(&workflows).
+		Get(repositoryURL(settings, owner, repo) + "/actions/workflows")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows for %s/%s: %w", owner, repo, err)
+	}
+
+	if err := checkResponse(resp, fmt.Sprintf("repository %s/%s", owner, repo)); err != nil {
+		return nil, err
+	}
+
+	return workflows.Workflows, nil
+}
+
+// getRawFile returns a file's content at ref, or nil if the file does not exist there.
+func (g *GiteaProvider) getRawFile(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	filePath, ref string,
+) ([]byte, error) {
+	escaped := make([]string, 0)
+	for _, segment := range strings.Split(filePath, "/") {
+		escaped = append(escaped, url.PathEscape(segment))
+	}
+
+	resp, err := g.request(ctx, settings).
+		SetQueryParam("ref", ref).
+		Get(repositoryURL(settings, owner, repo) + "/raw/" + strings.Join(escaped, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get workflow file %s for %s/%s: %w", filePath, owner, repo, err)
+	}
+
+	if resp.StatusCode() == http.StatusNotFound {
+		return nil, nil
+	}
+
+	if err := checkResponse(resp, fmt.Sprintf("workflow file %s in %s/%s", filePath, owner, repo)); err != nil {
+		return nil, err
+	}
+
+	return resp.Body(), nil
+}
+
+// listDispatchedRuns returns the recent workflow_dispatch runs of workflow on ref. Runs report
+// their workflow as "<file>@<ref>" in path; runs without a path are kept.
+func (g *GiteaProvider) listDispatchedRuns(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	workflow giteaWorkflow,
+	ref string,
+) ([]giteaWorkflowRun, error) {
+	query := url.Values{}
+	query.Set("event", eventWorkflowDispatch)
+	query.Set("branch", ref)
+	query.Set("limit", "20")
+
+	runs, err := g.listWorkflowRuns(ctx, owner, repo, settings, query)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]giteaWorkflowRun, 0, len(runs.WorkflowRuns))
+
+	for _, run := range runs.WorkflowRuns {
+		runWorkflow, _, _ := strings.Cut(run.Path, "@")
+		if runWorkflow != "" && path.Base(runWorkflow) != path.Base(workflow.Path) {
+			continue
+		}
+
+		result = append(result, run)
+	}
+
+	return result, nil
+}
+
+func (g *GiteaProvider) listWorkflowRuns(
+	ctx context.Context,
+	owner, repo string,
+	settings krci.GitServerSettings,
+	query url.Values,
+) (*giteaWorkflowRunsResponse, error) {
+	var runs giteaWorkflowRunsResponse
+
+	resp, err := g.request(ctx, settings).
+		SetQueryParamsFromValues(query).
+		SetResult(&runs).
+		Get(repositoryURL(settings, owner, repo) + "/actions/runs")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflow runs for %s/%s: %w", owner, repo, err)
+	}
+
+	if err := checkResponse(resp, fmt.Sprintf("project %s/%s", owner, repo)); err != nil {
+		return nil, err
+	}
+
+	return &runs, nil
+}
+
+// ListPipelineJobs lists the jobs of a Gitea Actions workflow run, ordered by job ID ascending.
+// Each job's steps are returned as its stages.
+func (g *GiteaProvider) ListPipelineJobs(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+) ([]models.PipelineJob, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateID("workflow run", pipelineID); err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf("%s/actions/runs/%s/jobs", repositoryURL(settings, owner, repo), pipelineID)
+	rawJobs := make([]giteaWorkflowJob, 0)
+
+	for page := 1; ; page++ {
+		var jobs giteaWorkflowJobsResponse
+
+		resp, err := g.request(ctx, settings).
+			SetQueryParam("page", strconv.Itoa(page)).
+			SetQueryParam("limit", strconv.Itoa(pageSize)).
+			SetResult(&jobs).
+			Get(apiURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list jobs for %s workflow run %s: %w", project, pipelineID, err)
+		}
+
+		if err := checkResponse(resp, fmt.Sprintf("project %s or workflow run %s", project, pipelineID)); err != nil {
+			return nil, err
+		}
+
+		rawJobs = append(rawJobs, jobs.Jobs...)
+
+		if len(rawJobs) >= common.MaxJobsTotal {
+			slog.Warn("ListPipelineJobs reached pagination cap; some jobs may be omitted",
+				"project", project,
+				"pipelineID", pipelineID,
+				"cap", common.MaxJobsTotal,
+			)
+
+			rawJobs = rawJobs[:common.MaxJobsTotal]
+
+			break
+		}
+
+		if len(jobs.Jobs) < pageSize || len(rawJobs) >= jobs.TotalCount {
+			break
+		}
+	}
+
+	sort.SliceStable(rawJobs, func(i, k int) bool {
+		return rawJobs[i].ID < rawJobs[k].ID
+	})
+
+	result := make([]models.PipelineJob, 0, len(rawJobs))
+	for _, j := range rawJobs {
+		result = append(result, convertWorkflowJob(j))
+	}
+
+	return result, nil
+}
+
+// GetJobTrace returns the raw log text of a Gitea Actions job and whether it was truncated
+// to common.MaxTraceBytes. The body is streamed through an io.LimitReader, so at most
+// common.MaxTraceBytes+1 bytes are read.
+func (g *GiteaProvider) GetJobTrace(
+	ctx context.Context,
+	project string,
+	jobID string,
+	settings krci.GitServerSettings,
+) (string, bool, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return "", false, err
+	}
+
+	if err := validateID("job", jobID); err != nil {
+		return "", false, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, common.TraceRequestTimeout)
+	defer cancel()
+
+	resp, err := g.request(ctx, settings).
+		SetHeader("Accept", "text/plain").
+		SetDoNotParseResponse(true).
+		Get(fmt.Sprintf("%s/actions/jobs/%s/logs", repositoryURL(settings, owner, repo), jobID))
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get job log for %s job %s: %w", project, jobID, err)
+	}
+
+	body := resp.RawBody()
+	defer func() { _ = body.Close() }()
+
+	if resp.StatusCode() != http.StatusOK {
+		switch resp.StatusCode() {
+		case http.StatusNotFound, http.StatusGone:
+			return "", false, fmt.Errorf("project %s or job %s: %w", project, jobID, gferrors.ErrNotFound)
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return "", false, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+		default:
+			return "", false, fmt.Errorf("gitea job log download failed for %s job %s: status %d",
+				project, jobID, resp.StatusCode())
+		}
+	}
+
+	// As with the other providers, we intentionally do not drain the remainder on truncation.
+	data, err := io.ReadAll(io.LimitReader(body, common.MaxTraceBytes+1))
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read job log for %s job %s: %w", project, jobID, err)
+	}
+
+	if len(data) > common.MaxTraceBytes {
+		return string(data[:common.MaxTraceBytes]), true, nil
+	}
+
+	return string(data), false, nil
+}
+
+// validateID rejects non-numeric Gitea run and job IDs before they are put into a URL path.
+func validateID(kind, id string) error {
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		return fmt.Errorf("%s ID %q must be a numeric value: %w", kind, id, gferrors.ErrBadRequest)
+	}
+
+	return nil
+}
+
+// normalizeGiteaRunStatus maps the GitHub-compatible status and conclusion Gitea reports for
+// workflow runs to the unified pipeline status enum.
+func normalizeGiteaRunStatus(status, conclusion string) models.PipelineStatus {
+	switch status {
+	case "queued", "waiting", "blocked", "pending":
+		return models.PipelineStatusPending
+	case "in_progress", "running":
+		return models.PipelineStatusRunning
+	case runStatusCompleted:
+		switch conclusion {
+		case "success":
+			return models.PipelineStatusSuccess
+		case "cancelled":
+			return models.PipelineStatusCancelled
+		case "skipped":
+			return models.PipelineStatusSkipped
+		default:
+			return models.PipelineStatusFailed
+		}
+	default:
+		return models.PipelineStatusPending
+	}
+}
+
+// normalizeGiteaRunEvent maps Gitea Actions trigger events to the unified pipeline source enum.
+func normalizeGiteaRunEvent(event string) models.PipelineSource {
+	switch event {
+	case "push":
+		return models.PipelineSourcePush
+	case "pull_request", "pull_request_target":
+		return models.PipelineSourceMergeRequest
+	case "schedule":
+		return models.PipelineSourceSchedule
+	case eventWorkflowDispatch:
+		return models.PipelineSourceManual
+	default:
+		return models.PipelineSourceOther
+	}
+}
+
+// mapPipelineStatusToGitea maps a unified status filter to the Gitea run status filter, or ""
+// when Gitea cannot filter by it.
+func mapPipelineStatusToGitea(status string) string {
+	switch status {
+	case "pending":
+		return "queued"
+	case "running":
+		return "in_progress"
+	case "success":
+		return "success"
+	case "failed":
+		return "failure"
+	case "cancelled":
+		return "cancelled"
+	case "skipped":
+		return "skipped"
+	default:
+		return ""
+	}
+}
+
+func convertWorkflowRun(run giteaWorkflowRun) models.Pipeline {
+	pipeline := models.Pipeline{
+		Id:     strconv.FormatInt(run.ID, 10),
+		Status: normalizeGiteaRunStatus(run.Status, run.Conclusion),
+		Ref:    run.HeadBranch,
+		Sha:    run.HeadSha,
+		WebUrl: run.HTMLURL,
+	}
+
+	// Gitea does not report when a run was queued; fall back to its start time.
+	switch {
+	case run.CreatedAt != nil:
+		pipeline.CreatedAt = *run.CreatedAt
+	case run.StartedAt != nil:
+		pipeline.CreatedAt = *run.StartedAt
+	}
+
+	if run.CompletedAt != nil {
+		pipeline.UpdatedAt = run.CompletedAt
+	}
+
+	if run.RepositoryID != 0 {
+		projectID := strconv.FormatInt(run.RepositoryID, 10)
+		pipeline.ProjectId = &projectID
+	}
+
+	if run.Event != "" {
+		source := normalizeGiteaRunEvent(run.Event)
+		pipeline.Source = &source
+	}
+
+	return pipeline
+}
+
+// jobStatus returns the provider-native job status: the conclusion once the job has completed,
+// otherwise the live status.
+func jobStatus(status, conclusion string) string {
+	if status == runStatusCompleted && conclusion != "" {
+		return conclusion
+	}
+
+	return status
+}
+
+func convertWorkflowJob(j giteaWorkflowJob) models.PipelineJob {
+	job := models.PipelineJob{
+		Id:         strconv.FormatInt(j.ID, 10),
+		Name:       j.Name,
+		Status:     jobStatus(j.Status, j.Conclusion),
+		CreatedAt:  j.CreatedAt,
+		StartedAt:  j.StartedAt,
+		FinishedAt: j.CompletedAt,
+	}
+
+	if j.HeadBranch != "" {
+		job.Ref = &j.HeadBranch
+	}
+
+	if j.HTMLURL != "" {
+		job.WebUrl = &j.HTMLURL
+	}
+
+	if job.StartedAt != nil && job.FinishedAt != nil {
+		duration := float32(job.FinishedAt.Sub(*job.StartedAt).Seconds())
+		job.Duration = &duration
+	}
+
+	if len(j.Steps) > 0 {
+		stages := make([]models.PipelineJobStage, 0, len(j.Steps))
+		for _, step := range j.Steps {
+			stages = append(stages, models.PipelineJobStage{
+				Number:     step.Number,
+				Name:       step.Name,
+				Status:     jobStatus(step.Status, step.Conclusion),
+				StartedAt:  step.StartedAt,
+				FinishedAt: step.CompletedAt,
+			})
+		}
+
+		job.Stages = &stages
+	}
+
+	return job
+}
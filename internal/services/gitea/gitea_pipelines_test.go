@@ -0,0 +1,265 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+)
+
+func TestGiteaProviderListPipelines(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /api/v1/repos/platform/api/actions/runs": {fixture: "runs.json"},
+	})
+
+	resp, err := NewGiteaProvider().ListPipelines(context.Background(), "platform/api", testSettings(server.URL),
+		models.PipelineListOptions{Page: 1, PerPage: 20})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, resp.Pagination.Total)
+	require.Len(t, resp.Data, 2)
+
+	run := resp.Data[0]
+	assert.Equal(t, "41", run.Id)
+	assert.Equal(t, models.PipelineStatusSuccess, run.Status)
+	assert.Equal(t, "main", run.Ref)
+	assert.Equal(t, "https://gitea.example.com/platform/api/actions/runs/41", run.WebUrl)
+	assert.Equal(t, time.Date(2025, 3, 2, 12, 0, 0, 0, time.UTC), run.CreatedAt)
+	assert.Equal(t, "12", pointer.ValueOrEmpty(run.ProjectId))
+	assert.Equal(t, models.PipelineSourcePush, *run.Source)
+
+	assert.Equal(t, models.PipelineStatusRunning, resp.Data[1].Status)
+	assert.Equal(t, models.PipelineSourceSchedule, *resp.Data[1].Source)
+}
+
+func TestGiteaProviderListPipelinesFilters(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "release", r.URL.Query().Get("branch"))
+		assert.Equal(t, "failure", r.URL.Query().Get("status"))
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		assert.Equal(t, "5", r.URL.Query().Get("limit"))
+
+		_, _ = w.Write([]byte(`{"total_count": 0, "workflow_runs": []}`))
+	}))
+	defer server.Close()
+
+	resp, err := NewGiteaProvider().ListPipelines(context.Background(), "platform/api", testSettings(server.URL),
+		models.PipelineListOptions{Ref: pointer.To("release"), Status: pointer.To("failed"), Page: 2, PerPage: 5})
+
+	require.NoError(t, err)
+	assert.Empty(t, resp.Data)
+}
+
+func TestGiteaProviderListPipelineJobs(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /api/v1/repos/platform/api/actions/runs/41/jobs": {fixture: "run_jobs.json"},
+	})
+
+	jobs, err := NewGiteaProvider().ListPipelineJobs(context.Background(), "platform/api", "41", testSettings(server.URL))
+
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+
+	assert.Equal(t, "304", jobs[0].Id)
+	assert.Equal(t, "build", jobs[0].Name)
+	assert.Equal(t, "in_progress", jobs[0].Status)
+	assert.Nil(t, jobs[0].Duration)
+	assert.Nil(t, jobs[0].Stages)
+
+	assert.Equal(t, "305", jobs[1].Id)
+	assert.Equal(t, "failure", jobs[1].Status)
+	require.NotNil(t, jobs[1].Duration)
+	assert.InDelta(t, 120, *jobs[1].Duration, 0.001)
+	require.NotNil(t, jobs[1].Stages)
+	require.Len(t, *jobs[1].Stages, 2)
+	assert.Equal(t, "go test ./...", (*jobs[1].Stages)[1].Name)
+	assert.Equal(t, "failure", (*jobs[1].Stages)[1].Status)
+}
+
+func TestGiteaProviderListPipelineJobsRejectsNonNumericID(t *testing.T) {
+	_, err := NewGiteaProvider().ListPipelineJobs(context.Background(), "platform/api", "abc",
+		testSettings("http://unused"))
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, gferrors.ErrBadRequest))
+}
+
+func TestGiteaProviderGetJobTrace(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /api/v1/repos/platform/api/actions/jobs/305/logs": {fixture: "job_logs.txt"},
+		"GET /api/v1/repos/platform/api/actions/jobs/306/logs": {status: http.StatusNotFound},
+	})
+
+	expected, err := os.ReadFile(filepath.Join("testdata", "job_logs.txt"))
+	require.NoError(t, err)
+
+	provider := NewGiteaProvider()
+
+	content, truncated, err := provider.GetJobTrace(context.Background(), "platform/api", "305", testSettings(server.URL))
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, string(expected), content)
+
+	_, _, err = provider.GetJobTrace(context.Background(), "platform/api", "306", testSettings(server.URL))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, gferrors.ErrNotFound))
+}
+
+func TestGiteaProviderGetJobTraceTruncates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(strings.Repeat("x", common.MaxTraceBytes+10)))
+	}))
+	defer server.Close()
+
+	content, truncated, err := NewGiteaProvider().GetJobTrace(context.Background(), "platform/api", "1",
+		testSettings(server.URL))
+
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Len(t, content, common.MaxTraceBytes)
+}
+
+// newDispatchServer serves a repository with a push-only workflow and a dispatchable one.
+// The dispatched run only appears after the dispatch request, and never unless runAppears.
+func newDispatchServer(t *testing.T, gotDispatch *map[string]any, runAppears bool) *httptest.Server {
+	t.Helper()
+
+	var dispatched atomic.Bool
+
+	readFixture := func(name string) []byte {
+		body, err := os.ReadFile(filepath.Join("testdata", name))
+		require.NoError(t, err)
+
+		return body
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/repos/platform/api/actions/workflows", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(readFixture("workflows.json"))
+	})
+	mux.HandleFunc("GET /api/v1/repos/platform/api/raw/.gitea/workflows/{file}",
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "main", r.URL.Query().Get("ref"))
+			_, _ = w.Write(readFixture(r.PathValue("file")))
+		})
+	mux.HandleFunc("GET /api/v1/repos/platform/api/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "workflow_dispatch", r.URL.Query().Get("event"))
+		w.Header().Set("Content-Type", "application/json")
+
+		if !dispatched.Load() || !runAppears {
+			_, _ = w.Write([]byte(`{"total_count": 0, "workflow_runs": []}`))
+
+			return
+		}
+
+		_, _ = w.Write(readFixture("dispatch_runs.json"))
+	})
+	mux.HandleFunc("POST /api/v1/repos/platform/api/actions/workflows/deploy.yaml/dispatches",
+		func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(gotDispatch))
+			dispatched.Store(true)
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestGiteaProviderTriggerPipeline(t *testing.T) {
+	var gotDispatch map[string]any
+
+	server := newDispatchServer(t, &gotDispatch, true)
+
+	provider := NewGiteaProvider()
+	provider.dispatchPollInterval = time.Millisecond
+
+	resp, err := provider.TriggerPipeline(context.Background(), "platform/api", testSettings(server.URL),
+		models.PipelineTriggerOptions{
+			Ref:       "main",
+			Variables: []models.PipelineVariable{{Key: "environment", Value: "staging"}},
+		})
+
+	require.NoError(t, err)
+	assert.Equal(t, "42", resp.Id)
+	assert.Equal(t, "main", resp.Ref)
+	assert.Equal(t, string(models.PipelineStatusPending), resp.Status)
+	assert.Equal(t, "https://gitea.example.com/platform/api/actions/runs/42", resp.WebUrl)
+	assert.Equal(t, "9a8b7c6d5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b", pointer.ValueOrEmpty(resp.Sha))
+
+	assert.Equal(t, map[string]any{
+		"ref":    "main",
+		"inputs": map[string]any{"environment": "staging"},
+	}, gotDispatch)
+}
+
+func TestGiteaProviderTriggerPipelineUnidentifiedRun(t *testing.T) {
+	var gotDispatch map[string]any
+
+	server := newDispatchServer(t, &gotDispatch, false)
+
+	provider := NewGiteaProvider()
+	provider.dispatchPollInterval = time.Millisecond
+
+	resp, err := provider.TriggerPipeline(context.Background(), "platform/api", testSettings(server.URL),
+		models.PipelineTriggerOptions{Ref: "main"})
+
+	// The dispatch succeeded, so a retry would only create another run.
+	require.NoError(t, err)
+	assert.NotNil(t, gotDispatch)
+	assert.Empty(t, resp.Id)
+	assert.Equal(t, string(models.PipelineStatusPending), resp.Status)
+	assert.Equal(t, "https://gitea.example.com/platform/api/actions?workflow=deploy.yaml", resp.WebUrl)
+}
+
+func TestGiteaProviderTriggerPipelineNamedWorkflowWithoutDispatch(t *testing.T) {
+	var gotDispatch map[string]any
+
+	server := newDispatchServer(t, &gotDispatch, true)
+
+	_, err := NewGiteaProvider().TriggerPipeline(context.Background(), "platform/api", testSettings(server.URL),
+		models.PipelineTriggerOptions{Ref: "main", Pipeline: pointer.To("build.yaml")})
+
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, gferrors.ErrNotFound))
+	assert.Nil(t, gotDispatch)
+}
+
+func TestNormalizeGiteaRunStatus(t *testing.T) {
+	tests := []struct {
+		status     string
+		conclusion string
+		want       models.PipelineStatus
+	}{
+		{status: "queued", want: models.PipelineStatusPending},
+		{status: "waiting", want: models.PipelineStatusPending},
+		{status: "in_progress", want: models.PipelineStatusRunning},
+		{status: "completed", conclusion: "success", want: models.PipelineStatusSuccess},
+		{status: "completed", conclusion: "failure", want: models.PipelineStatusFailed},
+		{status: "completed", conclusion: "cancelled", want: models.PipelineStatusCancelled},
+		{status: "completed", conclusion: "skipped", want: models.PipelineStatusSkipped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status+"/"+tt.conclusion, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeGiteaRunStatus(tt.status, tt.conclusion))
+		})
+	}
+}
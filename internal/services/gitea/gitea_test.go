@@ -0,0 +1,227 @@
+package gitea
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+)
+
+// recorded is a response captured from a Gitea 1.24 instance and stored under testdata.
+type recorded struct {
+	fixture string
+	status  int
+	headers map[string]string
+}
+
+// newRecordedServer replays recorded responses keyed by ServeMux pattern (e.g. "GET /api/v1/user").
+// Every request must carry the test token.
+func newRecordedServer(t *testing.T, routes map[string]recorded) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+
+	for pattern, rec := range routes {
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "token test-token", r.Header.Get("Authorization"))
+
+			if filepath.Ext(rec.fixture) == ".json" {
+				w.Header().Set("Content-Type", "application/json")
+			}
+
+			for k, v := range rec.headers {
+				w.Header().Set(k, v)
+			}
+
+			if rec.status != 0 {
+				w.WriteHeader(rec.status)
+			}
+
+			if rec.fixture != "" {
+				body, err := os.ReadFile(filepath.Join("testdata", rec.fixture))
+				require.NoError(t, err)
+
+				_, _ = w.Write(body)
+			}
+		})
+	}
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func testSettings(serverURL string) krci.GitServerSettings {
+	return krci.GitServerSettings{
+		Url:           serverURL,
+		Token:         "test-token",
+		GitProvider:   "gitea",
+		GitServerName: "gitea",
+	}
+}
+
+func TestGiteaProviderGetRepository(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /api/v1/repos/platform/api": {fixture: "repo.json"},
+	})
+
+	repo, err := NewGiteaProvider().GetRepository(context.Background(), "platform", "api", testSettings(server.URL))
+
+	require.NoError(t, err)
+	assert.Equal(t, "12", repo.Id)
+	assert.Equal(t, "api", repo.Name)
+	assert.Equal(t, "platform", pointer.ValueOrEmpty(repo.Owner))
+	assert.Equal(t, "main", pointer.ValueOrEmpty(repo.DefaultBranch))
+	assert.Equal(t, "Public API service", pointer.ValueOrEmpty(repo.Description))
+	assert.Equal(t, "https://gitea.example.com/platform/api", pointer.ValueOrEmpty(repo.Url))
+	assert.Equal(t, models.RepositoryVisibilityPrivate, *repo.Visibility)
+}
+
+func TestGiteaProviderGetRepositoryErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "not found", status: http.StatusNotFound, wantErr: gferrors.ErrNotFound},
+		{name: "unauthorized", status: http.StatusUnauthorized, wantErr: gferrors.ErrUnauthorized},
+		{name: "forbidden", status: http.StatusForbidden, wantErr: gferrors.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newRecordedServer(t, map[string]recorded{
+				"GET /api/v1/repos/platform/api": {status: tt.status},
+			})
+
+			_, err := NewGiteaProvider().GetRepository(context.Background(), "platform", "api", testSettings(server.URL))
+
+			require.Error(t, err)
+			assert.True(t, errors.Is(err, tt.wantErr))
+		})
+	}
+}
+
+func TestGiteaProviderListRepositories(t *testing.T) {
+	t.Run("organization with name filter", func(t *testing.T) {
+		server := newRecordedServer(t, map[string]recorded{
+			"GET /api/v1/orgs/platform":       {fixture: "user_orgs.json"},
+			"GET /api/v1/orgs/platform/repos": {fixture: "org_repos.json"},
+		})
+
+		repos, err := NewGiteaProvider().ListRepositories(context.Background(), "platform", testSettings(server.URL),
+			models.ListOptions{Name: pointer.To("API")})
+
+		require.NoError(t, err)
+		require.Len(t, repos, 2)
+		assert.Equal(t, "api", repos[0].Name)
+		assert.Equal(t, "api-gateway", repos[1].Name)
+		assert.Equal(t, models.RepositoryVisibilityPublic, *repos[1].Visibility)
+	})
+
+	t.Run("falls back to user repositories", func(t *testing.T) {
+		server := newRecordedServer(t, map[string]recorded{
+			"GET /api/v1/orgs/jdoe":        {status: http.StatusNotFound},
+			"GET /api/v1/users/jdoe/repos": {fixture: "org_repos.json"},
+		})
+
+		repos, err := NewGiteaProvider().ListRepositories(context.Background(), "jdoe", testSettings(server.URL),
+			models.ListOptions{})
+
+		require.NoError(t, err)
+		assert.Len(t, repos, 3)
+	})
+}
+
+func TestGiteaProviderListUserOrganizations(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /api/v1/user":      {fixture: "user.json"},
+		"GET /api/v1/user/orgs": {fixture: "user_orgs.json"},
+	})
+
+	orgs, err := NewGiteaProvider().ListUserOrganizations(context.Background(), testSettings(server.URL))
+
+	require.NoError(t, err)
+	require.Len(t, orgs, 2)
+	assert.Equal(t, "1", orgs[0].Id)
+	assert.Equal(t, "jdoe", orgs[0].Name)
+	assert.Equal(t, "3", orgs[1].Id)
+	assert.Equal(t, "platform", orgs[1].Name)
+	assert.Equal(t, "https://gitea.example.com/avatars/3", pointer.ValueOrEmpty(orgs[1].AvatarUrl))
+}
+
+func TestGiteaProviderListBranches(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /api/v1/repos/platform/api/branches": {fixture: "branches.json"},
+	})
+
+	branches, err := NewGiteaProvider().ListBranches(context.Background(), "platform", "api", testSettings(server.URL),
+		models.ListOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []models.Branch{{Name: "develop"}, {Name: "main"}}, branches)
+}
+
+func TestGiteaProviderListPullRequestsOpen(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /api/v1/repos/platform/api/pulls": {
+			fixture: "pulls_open.json",
+			headers: map[string]string{"X-Total-Count": "11"},
+		},
+	})
+
+	resp, err := NewGiteaProvider().ListPullRequests(context.Background(), "platform", "api", testSettings(server.URL),
+		models.PullRequestListOptions{State: "open", Page: 1, PerPage: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, 11, resp.Pagination.Total)
+	require.Len(t, resp.Data, 1)
+
+	pr := resp.Data[0]
+	assert.Equal(t, "101", pr.Id)
+	assert.Equal(t, 7, pr.Number)
+	assert.Equal(t, models.PullRequestStateOpen, pr.State)
+	assert.Equal(t, "feature/health", pr.SourceBranch)
+	assert.Equal(t, "main", pr.TargetBranch)
+	assert.Equal(t, "jdoe", pr.Author.Name)
+	assert.Equal(t, "Adds /healthz", pointer.ValueOrEmpty(pr.Description))
+	assert.Equal(t, "c3d4e5f6a7b8c9d0e1f2a3b4c5d6e7f8a9b0c1d2", pointer.ValueOrEmpty(pr.CommitSha))
+	assert.True(t, pointer.ValueOrEmpty(pr.Draft))
+	assert.Equal(t, time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC), pr.CreatedAt)
+}
+
+func TestGiteaProviderListPullRequestsPostFilter(t *testing.T) {
+	server := newRecordedServer(t, map[string]recorded{
+		"GET /api/v1/repos/platform/api/pulls": {fixture: "pulls_closed.json"},
+	})
+
+	provider := NewGiteaProvider()
+
+	merged, err := provider.ListPullRequests(context.Background(), "platform", "api", testSettings(server.URL),
+		models.PullRequestListOptions{State: "merged", Page: 1, PerPage: 10})
+	require.NoError(t, err)
+	require.Len(t, merged.Data, 1)
+	assert.Equal(t, 5, merged.Data[0].Number)
+	assert.Equal(t, models.PullRequestStateMerged, merged.Data[0].State)
+	assert.Equal(t, 1, merged.Pagination.Total)
+
+	closed, err := provider.ListPullRequests(context.Background(), "platform", "api", testSettings(server.URL),
+		models.PullRequestListOptions{State: "closed", Page: 1, PerPage: 10})
+	require.NoError(t, err)
+	require.Len(t, closed.Data, 1)
+	assert.Equal(t, 4, closed.Data[0].Number)
+	assert.Equal(t, models.PullRequestStateClosed, closed.Data[0].State)
+}
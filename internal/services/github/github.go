@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
 	"net/http"
+	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/google/go-github/v72/github"
 	"golang.org/x/sync/errgroup"
@@ -32,6 +36,8 @@ func classifyGitHubError(err error) error {
 		return gferrors.ErrNotFound
 	case http.StatusUnauthorized:
 		return gferrors.ErrUnauthorized
+	case http.StatusUnprocessableEntity:
+		return gferrors.ErrBadRequest
 	default:
 		return nil
 	}
@@ -45,10 +51,80 @@ const (
 
 type GitHubProvider struct {
 	httpClient *http.Client
+
+	// dispatchPollInterval is the delay between lookups of a freshly dispatched workflow run.
+	dispatchPollInterval time.Duration
 }
 
 func NewGitHubProvider() *GitHubProvider {
-	return &GitHubProvider{}
+	return &GitHubProvider{
+		dispatchPollInterval: time.Second,
+	}
+}
+
+// publicGitHubAPIHost is the API host of github.com; any other GitServer URL is treated as
+// a GitHub Enterprise Server instance.
+const publicGitHubAPIHost = "api.github.com"
+
+// enterpriseAPIPath matches the API suffix of a GitHub Enterprise Server URL. The GitServer
+// settings resolver appends the CR's httpsPort after the suffix (".../api/v3:443"), so an
+// optional port is captured and moved back onto the host.
+var enterpriseAPIPath = regexp.MustCompile(`/api/v3(?::(\d+))?/?$`)
+
+// newClient builds a go-github client for the git server: the public API for github.com,
+// otherwise a GitHub Enterprise Server client using the server's base and upload URLs.
+func (g *GitHubProvider) newClient(settings krci.GitServerSettings) (*github.Client, error) {
+	client := github.NewClient(g.httpClient).WithAuthToken(settings.Token)
+
+	rootURL, err := enterpriseRootURL(settings.Url)
+	if err != nil {
+		return nil, err
+	}
+
+	if rootURL == "" {
+		return client, nil
+	}
+
+	client, err = client.WithEnterpriseURLs(rootURL, rootURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid GitHub Enterprise URL %q: %w", settings.Url, err)
+	}
+
+	return client, nil
+}
+
+// enterpriseRootURL returns the root URL of a GitHub Enterprise Server instance (without the
+// /api/v3 suffix), or "" when serverURL is empty or points at github.com.
+func enterpriseRootURL(serverURL string) (string, error) {
+	if serverURL == "" {
+		return "", nil
+	}
+
+	root := serverURL
+	port := ""
+
+	if loc := enterpriseAPIPath.FindStringSubmatchIndex(serverURL); loc != nil {
+		root = serverURL[:loc[0]]
+
+		if loc[2] >= 0 {
+			port = serverURL[loc[2]:loc[3]]
+		}
+	}
+
+	u, err := url.Parse(root)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return "", fmt.Errorf("invalid GitHub server URL %q: %w", serverURL, gferrors.ErrBadRequest)
+	}
+
+	if u.Hostname() == publicGitHubAPIHost || u.Hostname() == "github.com" {
+		return "", nil
+	}
+
+	if port != "" && u.Port() == "" && (u.Scheme != "https" || port != "443") {
+		u.Host = net.JoinHostPort(u.Hostname(), port)
+	}
+
+	return strings.TrimRight(u.String(), "/"), nil
 }
 
 func (g *GitHubProvider) GetRepository(
@@ -56,7 +132,10 @@ func (g *GitHubProvider) GetRepository(
 	owner, repo string,
 	settings krci.GitServerSettings,
 ) (*models.Repository, error) {
-	client := github.NewClient(nil).WithAuthToken(settings.Token)
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
 
 	repository, _, err := client.Repositories.Get(ctx, owner, repo)
 	if err != nil {
@@ -76,7 +155,10 @@ func (g *GitHubProvider) ListRepositories(
 	settings krci.GitServerSettings,
 	listOptions models.ListOptions,
 ) ([]models.Repository, error) {
-	client := github.NewClient(nil).WithAuthToken(settings.Token)
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
 
 	it := filterRepositoriesByName(
 		g.listRepositories(ctx, owner, client),
@@ -210,7 +292,11 @@ func (g *GitHubProvider) ListUserOrganizations(
 	ctx context.Context,
 	settings krci.GitServerSettings,
 ) ([]models.Organization, error) {
-	client := github.NewClient(nil).WithAuthToken(settings.Token)
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 
 	var userOrg *models.Organization
@@ -287,7 +373,10 @@ func (g *GitHubProvider) ListBranches(
 	settings krci.GitServerSettings,
 	_ models.ListOptions,
 ) ([]models.Branch, error) {
-	client := github.NewClient(nil).WithAuthToken(settings.Token)
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
 
 	it := gfgithub.ScanGitHubList(
 		func(opt github.ListOptions) ([]*github.Branch, *github.Response, error) {
@@ -328,7 +417,10 @@ func (g *GitHubProvider) ListPullRequests(
 	settings krci.GitServerSettings,
 	opts models.PullRequestListOptions,
 ) (*models.PullRequestsResponse, error) {
-	client := github.NewClient(g.httpClient).WithAuthToken(settings.Token)
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
 
 	ghState := mapPullRequestStateToGitHub(opts.State)
 
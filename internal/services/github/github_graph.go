@@ -0,0 +1,142 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// githubCallSeparator joins the name of a job calling a reusable workflow and the name of a job
+// of the called workflow in the job names GitHub reports.
+const githubCallSeparator = " / "
+
+// GetPipelineGraph returns a GitHub Actions workflow run as a graph. GitHub runs the jobs of
+// reusable workflows inside the calling run, so the graph has no downstream runs: the jobs of
+// each call, which GitHub names "<calling job> / <job>", are grouped into a node named after the
+// calling job, nested calls up to depth levels deep. The called workflow is looked up in the
+// run's workflow file at the run's commit, for calls of the root only. A job whose own name
+// contains " / " is taken for a call as well.
+func (g *GitHubProvider) GetPipelineGraph(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+	depth int,
+) (*models.PipelineGraphNode, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	runID, err := parseGitHubID("workflow run", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	run, _, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, runID)
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return nil, fmt.Errorf("project %s or workflow run %d: %w", project, runID, sentinel)
+		}
+
+		return nil, fmt.Errorf("failed to get workflow run %d for %s: %w", runID, project, err)
+	}
+
+	rawJobs, err := listGitHubRunJobs(ctx, client, owner, repo, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]models.PipelineJob, 0, len(rawJobs))
+	for _, j := range rawJobs {
+		jobs = append(jobs, mapGitHubWorkflowJob(j))
+	}
+
+	// The called workflows only annotate the graph, so a workflow file that cannot be read
+	// leaves them out rather than failing the graph.
+	content, err := getWorkflowFile(ctx, client, owner, repo, run.GetPath(), run.GetHeadSHA())
+	if err != nil {
+		slog.Warn("Failed to read workflow file; leaving called workflows out",
+			"project", project,
+			"path", run.GetPath(),
+			"error", err,
+		)
+	}
+
+	pipeline := mapGitHubWorkflowRun(run)
+
+	root := &models.PipelineGraphNode{
+		Kind:     models.Root,
+		Project:  project,
+		Pipeline: &pipeline,
+	}
+
+	groupGitHubCallJobs(root, jobs, common.WorkflowJobCalls(content), 0, depth)
+
+	return root, nil
+}
+
+// groupGitHubCallJobs assigns jobs to node, at the given level of call nesting, splitting the jobs
+// of each reusable-workflow call into a child node. calls maps calling job names to the workflows
+// they call, when known. Jobs keep their full names.
+func groupGitHubCallJobs(
+	node *models.PipelineGraphNode,
+	jobs []models.PipelineJob,
+	calls map[string]string,
+	level, depth int,
+) {
+	node.Jobs = make([]models.PipelineJob, 0)
+	node.Children = make([]models.PipelineGraphNode, 0)
+
+	callers := make([]string, 0)
+	callJobs := make(map[string][]models.PipelineJob)
+
+	for _, job := range jobs {
+		parts := strings.Split(job.Name, githubCallSeparator)
+		if len(parts) <= level+1 {
+			node.Jobs = append(node.Jobs, job)
+
+			continue
+		}
+
+		if level >= depth {
+			node.Jobs = append(node.Jobs, job)
+			node.Truncated = true
+
+			continue
+		}
+
+		caller := parts[level]
+		if _, ok := callJobs[caller]; !ok {
+			callers = append(callers, caller)
+		}
+
+		callJobs[caller] = append(callJobs[caller], job)
+	}
+
+	for _, caller := range callers {
+		child := models.PipelineGraphNode{
+			Kind:    models.ReusableWorkflow,
+			Project: node.Project,
+			Name:    &caller,
+		}
+
+		if workflow, ok := calls[caller]; ok {
+			child.Workflow = &workflow
+		}
+
+		groupGitHubCallJobs(&child, callJobs[caller], nil, level+1, depth)
+
+		node.Children = append(node.Children, child)
+	}
+}
@@ -0,0 +1,105 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v72/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// newGraphTestMux serves workflow run 100, whose lint job runs next to a call of a reusable
+// deploy workflow that itself calls a notify workflow.
+func newGraphTestMux(t *testing.T) *http.ServeMux {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/runs/100", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.WorkflowRun{
+			ID: ptr(int64(100)), Status: ptr("in_progress"), HeadBranch: ptr("main"), HeadSHA: ptr("abc"),
+			Path: ptr(".github/workflows/ci.yaml"),
+		})
+	})
+	mux.HandleFunc("/repos/owner/repo/actions/runs/100/jobs", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.Jobs{
+			TotalCount: ptr(4),
+			Jobs: []*github.WorkflowJob{
+				{ID: ptr(int64(1)), Name: ptr("lint"), Status: ptr("completed"), Conclusion: ptr("success")},
+				{ID: ptr(int64(2)), Name: ptr("deploy / plan"), Status: ptr("completed"), Conclusion: ptr("success")},
+				{ID: ptr(int64(3)), Name: ptr("deploy / apply"), Status: ptr("completed"), Conclusion: ptr("failure")},
+				{ID: ptr(int64(4)), Name: ptr("deploy / notify / send"), Status: ptr("queued")},
+			},
+		})
+	})
+	mux.HandleFunc(
+		"/repos/owner/repo/contents/.github/workflows/ci.yaml",
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "abc", r.URL.Query().Get("ref"))
+			writeWorkflowContent(w, "jobs:\n  lint:\n    runs-on: ubuntu-latest\n"+
+				"  deploy:\n    uses: ./.github/workflows/deploy.yaml\n")
+		},
+	)
+
+	return mux
+}
+
+func TestGitHubProviderGetPipelineGraph(t *testing.T) {
+	server := httptest.NewServer(newGraphTestMux(t))
+	defer server.Close()
+
+	graph, err := newTestProvider(server.URL).GetPipelineGraph(
+		context.Background(), "owner/repo", "100", krci.GitServerSettings{Token: "test-token"}, 2,
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.Root, graph.Kind)
+	require.NotNil(t, graph.Pipeline)
+	assert.Equal(t, "100", graph.Pipeline.Id)
+	require.Len(t, graph.Jobs, 1)
+	assert.Equal(t, "lint", graph.Jobs[0].Name)
+
+	require.Len(t, graph.Children, 1)
+
+	deploy := graph.Children[0]
+	assert.Equal(t, models.ReusableWorkflow, deploy.Kind)
+	assert.Nil(t, deploy.Pipeline)
+	assert.Equal(t, "deploy", *deploy.Name)
+	require.NotNil(t, deploy.Workflow)
+	assert.Equal(t, "./.github/workflows/deploy.yaml", *deploy.Workflow)
+	require.Len(t, deploy.Jobs, 2)
+	assert.Equal(t, "deploy / plan", deploy.Jobs[0].Name, "jobs keep their full names")
+
+	require.Len(t, deploy.Children, 1)
+
+	notify := deploy.Children[0]
+	assert.Equal(t, "notify", *notify.Name)
+	assert.Nil(t, notify.Workflow)
+	require.Len(t, notify.Jobs, 1)
+	assert.False(t, notify.Truncated)
+}
+
+func TestGitHubProviderGetPipelineGraphDepthLimit(t *testing.T) {
+	server := httptest.NewServer(newGraphTestMux(t))
+	defer server.Close()
+
+	graph, err := newTestProvider(server.URL).GetPipelineGraph(
+		context.Background(), "owner/repo", "100", krci.GitServerSettings{Token: "test-token"}, 1,
+	)
+
+	require.NoError(t, err)
+	require.Len(t, graph.Children, 1)
+
+	deploy := graph.Children[0]
+	assert.Empty(t, deploy.Children)
+	assert.True(t, deploy.Truncated)
+	assert.Len(t, deploy.Jobs, 3, "jobs of calls past the depth limit stay with their caller")
+}
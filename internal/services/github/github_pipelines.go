@@ -1,16 +1,29 @@
 package github
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/go-github/v72/github"
 
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
 	"github.com/KubeRocketCI/gitfusion/internal/services/common"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	gfgithub "github.com/KubeRocketCI/gitfusion/pkg/github"
 )
 
 const (
@@ -19,7 +32,9 @@ const (
 	ghConclusionSkipped   = "skipped"
 )
 
-// ListPipelines returns workflow runs for a GitHub repository.
+// ListPipelines returns workflow runs for a GitHub repository. GitHub has no filter on the last
+// update of a run, nor one for the source "other", so these are applied to each page after listing
+// and such pages may hold fewer runs than requested.
 func (g *GitHubProvider) ListPipelines(
 	ctx context.Context,
 	project string,
@@ -31,7 +46,10 @@ func (g *GitHubProvider) ListPipelines(
 		return nil, err
 	}
 
-	client := github.NewClient(g.httpClient).WithAuthToken(settings.Token)
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
 
 	ghOpts := &github.ListWorkflowRunsOptions{
 		ListOptions: github.ListOptions{
@@ -51,7 +69,40 @@ func (g *GitHubProvider) ListPipelines(
 		}
 	}
 
-	workflowRuns, _, err := client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, ghOpts)
+	if opts.Sha != nil {
+		ghOpts.HeadSHA = *opts.Sha
+	}
+
+	if opts.Source != nil {
+		ghOpts.Event = mapPipelineSourceToGitHub(*opts.Source)
+	}
+
+	if opts.TriggeredBy != nil {
+		ghOpts.Actor = *opts.TriggeredBy
+	}
+
+	// A run last updated before a time was created before it too, so the creation filter narrows
+	// the listing down for the update filter applied below.
+	if opts.UpdatedBefore != nil {
+		ghOpts.Created = "<=" + opts.UpdatedBefore.UTC().Format(time.RFC3339)
+	}
+
+	var (
+		runs  []*github.WorkflowRun
+		total int
+	)
+
+	if opts.Ascending {
+		runs, total, err = listGitHubRunsAscending(ctx, client, owner, repo, ghOpts)
+	} else {
+		var workflowRuns *github.WorkflowRuns
+
+		workflowRuns, _, err = client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, ghOpts)
+		if err == nil {
+			runs, total = workflowRuns.WorkflowRuns, workflowRuns.GetTotalCount()
+		}
+	}
+
 	if err != nil {
 		if sentinel := classifyGitHubError(err); sentinel != nil {
 			return nil, fmt.Errorf("project %s: %w", project, sentinel)
@@ -60,53 +111,275 @@ func (g *GitHubProvider) ListPipelines(
 		return nil, fmt.Errorf("failed to list pipelines for %s: %w", project, err)
 	}
 
-	result := make([]models.Pipeline, 0, len(workflowRuns.WorkflowRuns))
+	result := make([]models.Pipeline, 0, len(runs))
 
-	for _, run := range workflowRuns.WorkflowRuns {
-		var createdAt time.Time
-		if run.CreatedAt != nil {
-			createdAt = run.CreatedAt.Time
+	for _, run := range runs {
+		if !matchesGitHubRunPostFilters(run, opts, ghOpts.Event == "") {
+			continue
 		}
 
-		pipeline := models.Pipeline{
-			Id:        strconv.FormatInt(run.GetID(), 10),
-			Status:    normalizeGitHubWorkflowRunStatus(run.GetStatus(), run.GetConclusion()),
-			Ref:       run.GetHeadBranch(),
-			Sha:       run.GetHeadSHA(),
-			WebUrl:    run.GetHTMLURL(),
-			CreatedAt: createdAt,
+		result = append(result, mapGitHubWorkflowRun(run))
+	}
+
+	return &models.PipelinesResponse{
+		Data: result,
+		Pagination: models.Pagination{
+			Total:   total,
+			Page:    &opts.Page,
+			PerPage: &opts.PerPage,
+		},
+	}, nil
+}
+
+// maxFilteredGitHubRuns is the number of workflow runs GitHub serves for a listing filtered by
+// actor, branch, event, status, creation time or commit; later runs are never returned.
+const maxFilteredGitHubRuns = 1000
+
+// listGitHubRunsAscending returns a page of workflow runs oldest first with the total run count.
+// GitHub lists runs newest first only, so the page is cut from the one or two newest-first pages
+// holding it, located from the total count a first single-run request reports, and reversed.
+// A filtered page reaching past the newest maxFilteredGitHubRuns runs is rejected, since GitHub
+// would return it short or empty.
+func listGitHubRunsAscending(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo string,
+	ghOpts *github.ListWorkflowRunsOptions,
+) ([]*github.WorkflowRun, int, error) {
+	page, perPage := ghOpts.Page, ghOpts.PerPage
+
+	probe := *ghOpts
+	probe.ListOptions = github.ListOptions{Page: 1, PerPage: 1}
+
+	first, _, err := client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, &probe)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total := first.GetTotalCount()
+
+	// Positions of the requested runs in newest-first order, from lo up to but excluding hi.
+	hi := total - (page-1)*perPage
+	lo := max(hi-perPage, 0)
+
+	if hi > maxFilteredGitHubRuns && isFilteredGitHubRunListing(ghOpts) {
+		return nil, 0, fmt.Errorf("oldest-first page %d reaches past the newest %d of %d filtered workflow runs "+
+			"GitHub lists, narrow the filters or sort newest first: %w",
+			page, maxFilteredGitHubRuns, total, gferrors.ErrBadRequest)
+	}
+
+	runs := make([]*github.WorkflowRun, 0, perPage)
+
+	for p := lo/perPage + 1; hi > 0 && p <= (hi-1)/perPage+1; p++ {
+		pageOpts := *ghOpts
+		pageOpts.ListOptions = github.ListOptions{Page: p, PerPage: perPage}
+
+		workflowRuns, _, err := client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo, &pageOpts)
+		if err != nil {
+			return nil, 0, err
 		}
 
-		if run.UpdatedAt != nil {
-			updatedAt := run.UpdatedAt.Time
-			pipeline.UpdatedAt = &updatedAt
+		for i, run := range workflowRuns.WorkflowRuns {
+			if pos := (p-1)*perPage + i; pos >= lo && pos < hi {
+				runs = append(runs, run)
+			}
 		}
+	}
+
+	slices.Reverse(runs)
+
+	return runs, total, nil
+}
+
+// isFilteredGitHubRunListing reports whether a workflow run listing uses a filter that limits it
+// to maxFilteredGitHubRuns runs.
+func isFilteredGitHubRunListing(o *github.ListWorkflowRunsOptions) bool {
+	return o.Actor != "" || o.Branch != "" || o.Event != "" || o.Status != "" ||
+		o.Created != "" || o.HeadSHA != "" || o.CheckSuiteID != 0
+}
+
+// matchesGitHubRunPostFilters reports whether a run passes the filters GitHub cannot apply: the
+// last update bounds and, when filterSource is set, the source.
+func matchesGitHubRunPostFilters(run *github.WorkflowRun, opts models.PipelineListOptions, filterSource bool) bool {
+	if filterSource && opts.Source != nil && string(normalizeGitHubWorkflowRunEvent(run.GetEvent())) != *opts.Source {
+		return false
+	}
+
+	updatedAt := run.GetUpdatedAt().Time
+
+	if opts.UpdatedAfter != nil && updatedAt.Before(*opts.UpdatedAfter) {
+		return false
+	}
+
+	return opts.UpdatedBefore == nil || !updatedAt.After(*opts.UpdatedBefore)
+}
+
+// ListPullRequestPipelines returns the workflow runs of a pull request, newest first: the runs on
+// its head branch that GitHub links to it, or that ran its head commit, since GitHub links no
+// runs of pull requests from forks. GitHub cannot filter runs by pull request, so the runs on the
+// branch are filtered per page after listing; pages may hold fewer runs than requested, and the
+// total counts every run on the branch.
+func (g *GitHubProvider) ListPullRequestPipelines(
+	ctx context.Context,
+	project string,
+	pullRequest int,
+	settings krci.GitServerSettings,
+	opts models.PipelineListOptions,
+) (*models.PipelinesResponse, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
 
-		if run.Repository != nil && run.Repository.GetID() != 0 {
-			projectID := strconv.FormatInt(run.Repository.GetID(), 10)
-			pipeline.ProjectId = &projectID
+	pr, _, err := client.PullRequests.Get(ctx, owner, repo, pullRequest)
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return nil, fmt.Errorf("project %s or pull request %d: %w", project, pullRequest, sentinel)
 		}
 
-		if run.GetEvent() != "" {
-			source := normalizeGitHubWorkflowRunEvent(run.GetEvent())
-			pipeline.Source = &source
+		return nil, fmt.Errorf("failed to get pull request %d for %s: %w", pullRequest, project, err)
+	}
+
+	workflowRuns, _, err := client.Actions.ListRepositoryWorkflowRuns(ctx, owner, repo,
+		&github.ListWorkflowRunsOptions{
+			Branch:      pr.GetHead().GetRef(),
+			ListOptions: github.ListOptions{Page: opts.Page, PerPage: opts.PerPage},
+		})
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return nil, fmt.Errorf("project %s: %w", project, sentinel)
 		}
 
-		result = append(result, pipeline)
+		return nil, fmt.Errorf("failed to list pipelines of pull request %d for %s: %w", pullRequest, project, err)
 	}
 
-	total := workflowRuns.GetTotalCount()
+	result := make([]models.Pipeline, 0, len(workflowRuns.WorkflowRuns))
+
+	for _, run := range workflowRuns.WorkflowRuns {
+		if run.GetHeadSHA() != pr.GetHead().GetSHA() && !slices.ContainsFunc(run.PullRequests,
+			func(linked *github.PullRequest) bool { return linked.GetNumber() == pullRequest }) {
+			continue
+		}
+
+		result = append(result, mapGitHubWorkflowRun(run))
+	}
 
 	return &models.PipelinesResponse{
 		Data: result,
 		Pagination: models.Pagination{
-			Total:   total,
+			Total:   workflowRuns.GetTotalCount(),
 			Page:    &opts.Page,
 			PerPage: &opts.PerPage,
 		},
 	}, nil
 }
 
+// GetPipeline returns a single workflow run with its triggering user and head commit title.
+// GitHub reports no run duration: it is taken from the run start to the last update of a completed
+// run, and the queued duration from the run creation to its start.
+func (g *GitHubProvider) GetPipeline(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+) (*models.Pipeline, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	runID, err := parseGitHubID("workflow run", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	run, _, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, runID)
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return nil, fmt.Errorf("project %s or workflow run %d: %w", project, runID, sentinel)
+		}
+
+		return nil, fmt.Errorf("failed to get workflow run %d for %s: %w", runID, project, err)
+	}
+
+	pipeline := mapGitHubWorkflowRun(run)
+
+	if run.RunStartedAt != nil {
+		if run.CreatedAt != nil {
+			queued := float32(run.RunStartedAt.Sub(run.CreatedAt.Time).Seconds())
+			pipeline.QueuedDuration = &queued
+		}
+
+		if run.GetStatus() == "completed" && run.UpdatedAt != nil {
+			duration := float32(run.UpdatedAt.Sub(run.RunStartedAt.Time).Seconds())
+			pipeline.Duration = &duration
+		}
+	}
+
+	actor := run.TriggeringActor
+	if actor == nil {
+		actor = run.Actor
+	}
+
+	if actor != nil {
+		pipeline.TriggeredBy = &models.Owner{
+			Id:        strconv.FormatInt(actor.GetID(), 10),
+			Name:      actor.GetLogin(),
+			AvatarUrl: actor.AvatarURL,
+		}
+	}
+
+	if title, _, _ := strings.Cut(run.GetHeadCommit().GetMessage(), "\n"); title != "" {
+		pipeline.CommitTitle = &title
+	}
+
+	return &pipeline, nil
+}
+
+// mapGitHubWorkflowRun converts a go-github WorkflowRun to the unified Pipeline model.
+func mapGitHubWorkflowRun(run *github.WorkflowRun) models.Pipeline {
+	var createdAt time.Time
+	if run.CreatedAt != nil {
+		createdAt = run.CreatedAt.Time
+	}
+
+	pipeline := models.Pipeline{
+		Id:        strconv.FormatInt(run.GetID(), 10),
+		Status:    normalizeGitHubWorkflowRunStatus(run.GetStatus(), run.GetConclusion()),
+		Ref:       run.GetHeadBranch(),
+		Sha:       run.GetHeadSHA(),
+		WebUrl:    run.GetHTMLURL(),
+		CreatedAt: createdAt,
+	}
+
+	if run.UpdatedAt != nil {
+		updatedAt := run.UpdatedAt.Time
+		pipeline.UpdatedAt = &updatedAt
+	}
+
+	if run.Repository != nil && run.Repository.GetID() != 0 {
+		projectID := strconv.FormatInt(run.Repository.GetID(), 10)
+		pipeline.ProjectId = &projectID
+	}
+
+	if run.GetEvent() != "" {
+		source := normalizeGitHubWorkflowRunEvent(run.GetEvent())
+		pipeline.Source = &source
+	}
+
+	return pipeline
+}
+
 // normalizeGitHubWorkflowRunStatus maps GitHub workflow run status and conclusion
 // to the unified pipeline status enum.
 func normalizeGitHubWorkflowRunStatus(status, conclusion string) models.PipelineStatus {
@@ -156,6 +429,29 @@ func normalizeGitHubWorkflowRunEvent(event string) models.PipelineSource {
 	}
 }
 
+// mapPipelineSourceToGitHub maps a unified source filter to a GitHub workflow run event, or ""
+// for "other", which covers many events and is filtered after listing instead.
+// NOTE: GitHub filters by a single event, so unified sources normalized from several events (see
+// normalizeGitHubWorkflowRunEvent) query the most common one:
+//   - "merge_request" queries pull_request (misses pull_request_target)
+//   - "trigger" queries repository_dispatch (misses workflow_call)
+func mapPipelineSourceToGitHub(source string) string {
+	switch source {
+	case "push":
+		return "push"
+	case "merge_request":
+		return "pull_request"
+	case "schedule":
+		return "schedule"
+	case "manual":
+		return "workflow_dispatch"
+	case "trigger":
+		return "repository_dispatch"
+	default:
+		return ""
+	}
+}
+
 // mapPipelineStatusToGitHub maps a unified status filter string to the GitHub
 // workflow run status/conclusion value used for API filtering.
 func mapPipelineStatusToGitHub(status string) *string {
@@ -185,13 +481,1177 @@ func mapPipelineStatusToGitHub(status string) *string {
 	return &v
 }
 
-// TriggerPipeline is not supported for GitHub.
+// dispatchRunLookupAttempts bounds how many times TriggerPipeline polls for the run a
+// workflow_dispatch created; the dispatch API itself returns no run ID.
+const dispatchRunLookupAttempts = 10
+
+// TriggerPipeline dispatches the repository's workflow_dispatch workflow on ref and returns
+// the run the dispatch created. Pipeline variables are passed as workflow inputs; a named
+// pipeline selects the workflow file to dispatch. When the created run cannot be identified
+// (see common.DispatchWorkflow) the response has no ID and links to the workflow's runs.
 func (g *GitHubProvider) TriggerPipeline(
-	_ context.Context,
-	_ string,
-	_ string,
-	_ []models.PipelineVariable,
-	_ krci.GitServerSettings,
+	ctx context.Context,
+	project string,
+	settings krci.GitServerSettings,
+	opts models.PipelineTriggerOptions,
 ) (*models.PipelineResponse, error) {
-	return nil, fmt.Errorf("trigger pipeline is not supported for GitHub")
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	ref := opts.Ref
+
+	var workflowName string
+	if opts.Pipeline != nil {
+		workflowName = *opts.Pipeline
+	}
+
+	workflow, err := findDispatchableWorkflow(ctx, client, owner, repo, ref, workflowName)
+	if err != nil {
+		return nil, err
+	}
+
+	run, found, err := common.DispatchWorkflow(ctx, common.WorkflowDispatch[*github.WorkflowRun]{
+		Key: fmt.Sprintf("github|%s|%s|%d|%s", settings.Url, project, workflow.GetID(), ref),
+		ListRuns: func(ctx context.Context) ([]*github.WorkflowRun, error) {
+			return listDispatchedRuns(ctx, client, owner, repo, workflow.GetID(), ref)
+		},
+		RunID: (*github.WorkflowRun).GetID,
+		Dispatch: func(ctx context.Context) error {
+			return dispatchWorkflow(ctx, client, owner, repo, workflow, ref, opts.Variables)
+		},
+		Attempts:     dispatchRunLookupAttempts,
+		PollInterval: g.dispatchPollInterval,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return &models.PipelineResponse{
+			WebUrl: workflowRunsURL(workflow),
+			Status: string(models.PipelineStatusPending),
+			Ref:    ref,
+		}, nil
+	}
+
+	result := &models.PipelineResponse{
+		Id:     strconv.FormatInt(run.GetID(), 10),
+		WebUrl: run.GetHTMLURL(),
+		Status: string(normalizeGitHubWorkflowRunStatus(run.GetStatus(), run.GetConclusion())),
+		Ref:    ref,
+	}
+
+	if run.GetHeadSHA() != "" {
+		result.Sha = run.HeadSHA
+	}
+
+	return result, nil
+}
+
+// dispatchWorkflow creates a workflow_dispatch event for workflow on ref with the variables as inputs.
+func dispatchWorkflow(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo string,
+	workflow *github.Workflow,
+	ref string,
+	variables []models.PipelineVariable,
+) error {
+	_, err := client.Actions.CreateWorkflowDispatchEventByID(ctx, owner, repo, workflow.GetID(),
+		github.CreateWorkflowDispatchEventRequest{
+			Ref:    ref,
+			Inputs: common.WorkflowInputs(variables),
+		},
+	)
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return fmt.Errorf("dispatch workflow %s for %s/%s ref %s: %w", workflow.GetPath(), owner, repo, ref, sentinel)
+		}
+
+		return fmt.Errorf("failed to dispatch workflow %s for %s/%s ref %s: %w", workflow.GetPath(), owner, repo, ref, err)
+	}
+
+	return nil
+}
+
+// workflowRunsURL returns the web page listing the runs of workflow, derived from the URL of its
+// file ("<repo>/blob/<ref>/<path>"), or the file URL when it has another form.
+func workflowRunsURL(workflow *github.Workflow) string {
+	repoURL, _, ok := strings.Cut(workflow.GetHTMLURL(), "/blob/")
+	if !ok {
+		return workflow.GetHTMLURL()
+	}
+
+	return repoURL + "/actions/workflows/" + path.Base(workflow.GetPath())
+}
+
+// findDispatchableWorkflow returns the workflow to dispatch on ref; see common.FindDispatchableWorkflow.
+// A non-empty name restricts the search to the workflow with that file name or path.
+func findDispatchableWorkflow(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo, ref, name string,
+) (*github.Workflow, error) {
+	return common.FindDispatchableWorkflow(ctx, common.WorkflowLookup[*github.Workflow]{
+		Repository: owner + "/" + repo,
+		Ref:        ref,
+		Name:       name,
+		ListWorkflows: func(ctx context.Context) ([]*github.Workflow, error) {
+			return listWorkflows(ctx, client, owner, repo)
+		},
+		Path: (*github.Workflow).GetPath,
+		Active: func(workflow *github.Workflow) bool {
+			return workflow.GetState() == "active"
+		},
+		ReadFile: func(ctx context.Context, filePath string) ([]byte, error) {
+			return getWorkflowFile(ctx, client, owner, repo, filePath, ref)
+		},
+	})
+}
+
+// listWorkflows returns all workflows of the repository.
+func listWorkflows(ctx context.Context, client *github.Client, owner, repo string) ([]*github.Workflow, error) {
+	it := gfgithub.ScanGitHubList(
+		func(opt github.ListOptions) ([]*github.Workflow, *github.Response, error) {
+			workflows, resp, err := client.Actions.ListWorkflows(ctx, owner, repo, &opt)
+			if err != nil {
+				return nil, resp, err
+			}
+
+			return workflows.Workflows, resp, nil
+		},
+	)
+
+	result := make([]*github.Workflow, 0)
+
+	for workflow, err := range it {
+		if err != nil {
+			if sentinel := classifyGitHubError(err); sentinel != nil {
+				return nil, fmt.Errorf("repository %s/%s: %w", owner, repo, sentinel)
+			}
+
+			return nil, fmt.Errorf("failed to list workflows for %s/%s: %w", owner, repo, err)
+		}
+
+		result = append(result, workflow)
+	}
+
+	return result, nil
+}
+
+// getWorkflowFile returns the workflow definition at ref, or nil if the file does not exist there.
+func getWorkflowFile(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo, filePath, ref string,
+) ([]byte, error) {
+	file, _, _, err := client.Repositories.GetContents(ctx, owner, repo, filePath,
+		&github.RepositoryContentGetOptions{Ref: ref})
+	if err != nil {
+		if errors.Is(classifyGitHubError(err), gferrors.ErrNotFound) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("failed to get workflow file %s for %s/%s: %w", filePath, owner, repo, err)
+	}
+
+	if file == nil {
+		return nil, nil
+	}
+
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode workflow file %s for %s/%s: %w", filePath, owner, repo, err)
+	}
+
+	return []byte(content), nil
+}
+
+// listDispatchedRuns returns the recent workflow_dispatch runs of the workflow on ref.
+func listDispatchedRuns(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo string,
+	workflowID int64,
+	ref string,
+) ([]*github.WorkflowRun, error) {
+	runs, _, err := client.Actions.ListWorkflowRunsByID(ctx, owner, repo, workflowID, &github.ListWorkflowRunsOptions{
+		Event:       "workflow_dispatch",
+		Branch:      ref,
+		ListOptions: github.ListOptions{PerPage: 20},
+	})
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return nil, fmt.Errorf("workflow runs of %s/%s: %w", owner, repo, sentinel)
+		}
+
+		return nil, fmt.Errorf("failed to list workflow runs for %s/%s: %w", owner, repo, err)
+	}
+
+	return runs.WorkflowRuns, nil
+}
+
+// ListPipelineJobs lists the jobs of the latest attempt of a GitHub Actions workflow run,
+// ordered by job ID ascending. Each job's steps are returned as its stages.
+func (g *GitHubProvider) ListPipelineJobs(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+) ([]models.PipelineJob, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	pipelineID, err := parseGitHubID("workflow run", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	rawJobs, err := listGitHubRunJobs(ctx, client, owner, repo, pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.PipelineJob, 0, len(rawJobs))
+	for _, j := range rawJobs {
+		result = append(result, mapGitHubWorkflowJob(j))
+	}
+
+	return result, nil
+}
+
+// listGitHubRunJobs returns up to common.MaxJobsTotal jobs of the latest attempt of a workflow run in
+// ascending job ID order.
+func listGitHubRunJobs(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo string,
+	pipelineID int64,
+) ([]*github.WorkflowJob, error) {
+	it := gfgithub.ScanGitHubList(
+		func(opt github.ListOptions) ([]*github.WorkflowJob, *github.Response, error) {
+			jobs, resp, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, pipelineID,
+				&github.ListWorkflowJobsOptions{Filter: "latest", ListOptions: opt})
+			if err != nil {
+				return nil, resp, err
+			}
+
+			return jobs.Jobs, resp, nil
+		},
+	)
+
+	rawJobs := make([]*github.WorkflowJob, 0)
+
+	for j, err := range it {
+		if err != nil {
+			if sentinel := classifyGitHubError(err); sentinel != nil {
+				return nil, fmt.Errorf("project %s/%s or workflow run %d: %w", owner, repo, pipelineID, sentinel)
+			}
+
+			return nil, fmt.Errorf("failed to list jobs for %s/%s workflow run %d: %w", owner, repo, pipelineID, err)
+		}
+
+		rawJobs = append(rawJobs, j)
+
+		if len(rawJobs) >= common.MaxJobsTotal {
+			slog.Warn("ListPipelineJobs reached pagination cap; some jobs may be omitted",
+				"project", owner+"/"+repo,
+				"pipelineID", pipelineID,
+				"cap", common.MaxJobsTotal,
+			)
+
+			break
+		}
+	}
+
+	sort.SliceStable(rawJobs, func(i, k int) bool {
+		return rawJobs[i].GetID() < rawJobs[k].GetID()
+	})
+
+	return rawJobs, nil
+}
+
+// GetJobTrace returns the raw log text of a GitHub Actions job and whether it was truncated
+// to common.MaxTraceBytes.
+//
+// The logs endpoint answers with a redirect to a short-lived download URL. We resolve the
+// redirect through go-github (which authenticates the API call) and then stream the download
+// through an io.LimitReader without credentials, so at most common.MaxTraceBytes+1 bytes are read.
+func (g *GitHubProvider) GetJobTrace(
+	ctx context.Context,
+	project string,
+	rawJobID string,
+	settings krci.GitServerSettings,
+) (string, bool, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return "", false, err
+	}
+
+	jobID, err := parseGitHubID("job", rawJobID)
+	if err != nil {
+		return "", false, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return "", false, err
+	}
+
+	trace, err := g.readJobLog(ctx, client, owner, repo, jobID, models.JobTraceWindow{})
+	if err != nil {
+		return "", false, err
+	}
+
+	return trace.Content, trace.Truncated, nil
+}
+
+// GetJobTraceWindow reads a window of a GitHub Actions job log with a Range request to the
+// download URL, so any part of a log larger than common.MaxTraceBytes can be reached.
+func (g *GitHubProvider) GetJobTraceWindow(
+	ctx context.Context,
+	project string,
+	rawJobID string,
+	settings krci.GitServerSettings,
+	w models.JobTraceWindow,
+) (*models.JobTrace, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID, err := parseGitHubID("job", rawJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return g.readJobLog(ctx, client, owner, repo, jobID, w)
+}
+
+// readJobLog downloads window w of a job log, at most common.MaxTraceBytes of it. Windows other than the
+// whole log are requested with a Range header; a server that ignores it answers with the whole
+// log, which is then windowed as it streams.
+func (g *GitHubProvider) readJobLog(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo string,
+	jobID int64,
+	w models.JobTraceWindow,
+) (*models.JobTrace, error) {
+	project := owner + "/" + repo
+
+	logURL, resp, err := client.Actions.GetWorkflowJobLogs(ctx, owner, repo, jobID, 1)
+	if err != nil {
+		if resp != nil {
+			if sentinel := mapGitHubLogsStatus(resp.StatusCode); sentinel != nil {
+				return nil, fmt.Errorf("project %s or job %d: %w", project, jobID, sentinel)
+			}
+		}
+
+		return nil, fmt.Errorf("failed to get job log URL for %s job %d: %w", project, jobID, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, logURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build job log request for %s job %d: %w", project, jobID, err)
+	}
+
+	if rng := common.TraceRangeHeader(w, common.MaxTraceBytes); rng != "" {
+		req.Header.Set("Range", rng)
+	}
+
+	httpClient := &http.Client{Timeout: common.TraceRequestTimeout}
+	if g.httpClient != nil {
+		httpClient.Transport = g.httpClient.Transport
+	}
+
+	logResp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download job log for %s job %d: %w", project, jobID, err)
+	}
+
+	defer func() { _ = logResp.Body.Close() }()
+
+	switch logResp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent, http.StatusRequestedRangeNotSatisfiable:
+	default:
+		if sentinel := mapGitHubLogsStatus(logResp.StatusCode); sentinel != nil {
+			return nil, fmt.Errorf("project %s or job %d: %w", project, jobID, sentinel)
+		}
+
+		return nil, fmt.Errorf("github job log download failed for %s job %d: status %d",
+			project, jobID, logResp.StatusCode)
+	}
+
+	trace, err := common.ReadTraceWindow(logResp, w, common.MaxTraceBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read job log for %s job %d: %w", project, jobID, err)
+	}
+
+	return trace, nil
+}
+
+// parseGitHubID parses a numeric GitHub workflow run or job ID received as an opaque string.
+func parseGitHubID(kind, id string) (int64, error) {
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s ID %q must be a numeric value: %w", kind, id, gferrors.ErrBadRequest)
+	}
+
+	return n, nil
+}
+
+// mapGitHubLogsStatus maps a job-log HTTP status to a GitFusion sentinel error, or nil if
+// the status has no sentinel. Expired logs (410 Gone) are reported as not found.
+func mapGitHubLogsStatus(statusCode int) error {
+	switch statusCode {
+	case http.StatusNotFound, http.StatusGone:
+		return gferrors.ErrNotFound
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return gferrors.ErrUnauthorized
+	default:
+		return nil
+	}
+}
+
+// githubJobStatus returns the provider-native job status: the conclusion once the job has
+// completed, otherwise the live status (queued, in_progress, waiting, ...).
+func githubJobStatus(status, conclusion string) string {
+	if status == "completed" && conclusion != "" {
+		return conclusion
+	}
+
+	return status
+}
+
+// mapGitHubWorkflowJob converts a go-github WorkflowJob to the unified PipelineJob model.
+// GitHub has no stages between a run and its jobs, so the workflow name is used as the stage.
+func mapGitHubWorkflowJob(j *github.WorkflowJob) models.PipelineJob {
+	job := models.PipelineJob{
+		Id:     strconv.FormatInt(j.GetID(), 10),
+		Name:   j.GetName(),
+		Stage:  j.GetWorkflowName(),
+		Status: githubJobStatus(j.GetStatus(), j.GetConclusion()),
+	}
+
+	if j.GetRunID() != 0 {
+		runID := strconv.FormatInt(j.GetRunID(), 10)
+		job.PipelineId = &runID
+	}
+
+	if j.GetHeadBranch() != "" {
+		job.Ref = j.HeadBranch
+	}
+
+	if j.GetHTMLURL() != "" {
+		job.WebUrl = j.HTMLURL
+	}
+
+	if j.CreatedAt != nil {
+		job.CreatedAt = &j.CreatedAt.Time
+	}
+
+	if j.StartedAt != nil {
+		job.StartedAt = &j.StartedAt.Time
+	}
+
+	if j.CompletedAt != nil {
+		job.FinishedAt = &j.CompletedAt.Time
+	}
+
+	if job.StartedAt != nil && job.FinishedAt != nil {
+		duration := float32(job.FinishedAt.Sub(*job.StartedAt).Seconds())
+		job.Duration = &duration
+	}
+
+	if len(j.Steps) > 0 {
+		stages := make([]models.PipelineJobStage, 0, len(j.Steps))
+		for _, step := range j.Steps {
+			stages = append(stages, mapGitHubTaskStep(step))
+		}
+
+		job.Stages = &stages
+	}
+
+	return job
+}
+
+// mapGitHubTaskStep converts a workflow job step to a PipelineJobStage.
+func mapGitHubTaskStep(step *github.TaskStep) models.PipelineJobStage {
+	stage := models.PipelineJobStage{
+		Number: int(step.GetNumber()),
+		Name:   step.GetName(),
+		Status: githubJobStatus(step.GetStatus(), step.GetConclusion()),
+	}
+
+	if step.StartedAt != nil {
+		stage.StartedAt = &step.StartedAt.Time
+	}
+
+	if step.CompletedAt != nil {
+		stage.FinishedAt = &step.CompletedAt.Time
+	}
+
+	return stage
+}
+
+// CancelPipeline requests cancellation of a GitHub Actions workflow run. GitHub cancels
+// asynchronously, so the response carries no status.
+func (g *GitHubProvider) CancelPipeline(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineActionResponse, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	runID, err := parseGitHubID("workflow run", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	// The cancel endpoint answers 202 Accepted, which go-github reports as an AcceptedError.
+	_, err = client.Actions.CancelWorkflowRunByID(ctx, owner, repo, runID)
+	if err != nil && !errors.As(err, new(*github.AcceptedError)) {
+		return nil, mapGitHubRunActionError(err, "cancel", project, runID)
+	}
+
+	return &models.PipelineActionResponse{
+		PipelineId: strconv.FormatInt(runID, 10),
+		Action:     models.PipelineActionCancel,
+	}, nil
+}
+
+// RetryPipeline re-runs a finished GitHub Actions workflow run as a new attempt of the same run,
+// or only its failed jobs (and their dependents) when opts.FailedOnly is set.
+func (g *GitHubProvider) RetryPipeline(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+	opts models.PipelineRetryOptions,
+) (*models.PipelineActionResponse, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	runID, err := parseGitHubID("workflow run", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.FailedOnly {
+		_, err = client.Actions.RerunFailedJobsByID(ctx, owner, repo, runID)
+	} else {
+		_, err = client.Actions.RerunWorkflowByID(ctx, owner, repo, runID)
+	}
+
+	if err != nil {
+		return nil, mapGitHubRunActionError(err, "re-run", project, runID)
+	}
+
+	return &models.PipelineActionResponse{
+		PipelineId: strconv.FormatInt(runID, 10),
+		Action:     models.PipelineActionRetry,
+	}, nil
+}
+
+// mapGitHubRunActionError maps a failed cancel/re-run call to a GitFusion sentinel error.
+// GitHub answers 409 Conflict when the run's state does not allow the action (cancelling a
+// completed run) and 403 when a run cannot be re-run (still in progress, or too old).
+func mapGitHubRunActionError(err error, action, project string, runID int64) error {
+	ghErr := &github.ErrorResponse{}
+	if errors.As(err, &ghErr) && ghErr.Response != nil {
+		switch ghErr.Response.StatusCode {
+		case http.StatusConflict, http.StatusForbidden:
+			return fmt.Errorf("%s workflow run %d for %s: %s: %w", action, runID, project, ghErr.Message, gferrors.ErrBadRequest)
+		}
+	}
+
+	if sentinel := classifyGitHubError(err); sentinel != nil {
+		return fmt.Errorf("project %s or workflow run %d: %w", project, runID, sentinel)
+	}
+
+	return fmt.Errorf("failed to %s workflow run %d for %s: %w", action, runID, project, err)
+}
+
+// PlayJob starts a GitHub Actions job that waits for an environment deployment approval by
+// approving the pending deployments of its workflow run. GitHub does not tie a pending
+// deployment to a job, so every environment of the run the token's user may approve is
+// approved. Job variables are not supported.
+func (g *GitHubProvider) PlayJob(
+	ctx context.Context,
+	project string,
+	rawJobID string,
+	settings krci.GitServerSettings,
+	opts models.PipelineJobPlayOptions,
+) (*models.PipelineJob, error) {
+	if len(opts.Variables) > 0 {
+		return nil, fmt.Errorf("job variables are not supported for GitHub: %w", gferrors.ErrBadRequest)
+	}
+
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID, err := parseGitHubID("job", rawJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := getWorkflowJob(ctx, client, owner, repo, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.GetStatus() != "waiting" {
+		return nil, fmt.Errorf("job %d is %s, not waiting for approval: %w",
+			jobID, githubJobStatus(job.GetStatus(), job.GetConclusion()), gferrors.ErrBadRequest)
+	}
+
+	pending, _, err := client.Actions.GetPendingDeployments(ctx, owner, repo, job.GetRunID())
+	if err != nil {
+		return nil, mapGitHubRunActionError(err, "get pending deployments of", project, job.GetRunID())
+	}
+
+	envIDs := make([]int64, 0, len(pending))
+
+	for _, p := range pending {
+		if p.GetCurrentUserCanApprove() && p.GetEnvironment().GetID() != 0 {
+			envIDs = append(envIDs, p.GetEnvironment().GetID())
+		}
+	}
+
+	if len(envIDs) == 0 {
+		return nil, fmt.Errorf("workflow run %d has no deployments the user can approve: %w",
+			job.GetRunID(), gferrors.ErrBadRequest)
+	}
+
+	_, _, err = client.Actions.PendingDeployments(ctx, owner, repo, job.GetRunID(), &github.PendingDeploymentsRequest{
+		EnvironmentIDs: envIDs,
+		State:          "approved",
+		Comment:        "Approved via GitFusion",
+	})
+	if err != nil {
+		return nil, mapGitHubRunActionError(err, "approve deployments of", project, job.GetRunID())
+	}
+
+	job, err = getWorkflowJob(ctx, client, owner, repo, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := mapGitHubWorkflowJob(job)
+
+	return &result, nil
+}
+
+// RetryJob re-runs a finished GitHub Actions job and the jobs that depend on it. The re-run is a
+// new attempt of the workflow run with new job IDs, so the job of that attempt is returned
+// (falling back to the original job if the attempt is not listed yet).
+func (g *GitHubProvider) RetryJob(
+	ctx context.Context,
+	project string,
+	rawJobID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineJob, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	jobID, err := parseGitHubID("job", rawJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := getWorkflowJob(ctx, client, owner, repo, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = client.Actions.RerunJobByID(ctx, owner, repo, jobID); err != nil {
+		return nil, mapGitHubRunActionError(err, "re-run job of", project, job.GetRunID())
+	}
+
+	latest, _, err := client.Actions.ListWorkflowJobs(ctx, owner, repo, job.GetRunID(),
+		&github.ListWorkflowJobsOptions{Filter: "latest", ListOptions: github.ListOptions{PerPage: 100}})
+	if err != nil {
+		return nil, mapGitHubRunActionError(err, "list jobs of", project, job.GetRunID())
+	}
+
+	for _, j := range latest.Jobs {
+		if j.GetName() == job.GetName() && j.GetID() != jobID {
+			job = j
+
+			break
+		}
+	}
+
+	result := mapGitHubWorkflowJob(job)
+
+	return &result, nil
+}
+
+// getWorkflowJob fetches a workflow job, mapping API errors to GitFusion sentinel errors.
+func getWorkflowJob(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo string,
+	jobID int64,
+) (*github.WorkflowJob, error) {
+	job, _, err := client.Actions.GetWorkflowJobByID(ctx, owner, repo, jobID)
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return nil, fmt.Errorf("project %s/%s or job %d: %w", owner, repo, jobID, sentinel)
+		}
+
+		return nil, fmt.Errorf("failed to get job %d for %s/%s: %w", jobID, owner, repo, err)
+	}
+
+	return job, nil
+}
+
+const (
+	// maxTestReportArtifactBytes caps the size of an artifact searched for JUnit reports; the zip
+	// archive is held in memory to read its entries.
+	maxTestReportArtifactBytes = 32 * 1024 * 1024
+
+	// maxTestReportArtifacts caps the artifacts of a run searched for JUnit reports.
+	maxTestReportArtifacts = 10
+
+	// artifactRequestTimeout bounds an artifact download.
+	artifactRequestTimeout = 2 * time.Minute
+)
+
+// testReportArtifactName matches the names of artifacts that may hold JUnit reports.
+var testReportArtifactName = regexp.MustCompile(`(?i)test|junit|report`)
+
+// GetPipelineTestReport builds the test report of a GitHub Actions workflow run from the JUnit XML
+// files in its artifacts. Only unexpired artifacts whose name matches testReportArtifactName and
+// that are within maxTestReportArtifactBytes are downloaded; XML files that are not JUnit reports
+// are ignored and unreadable ones are skipped with a warning. The run status is read before the
+// artifacts, so once it is terminal the report is final.
+func (g *GitHubProvider) GetPipelineTestReport(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineTestReport, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	runID, err := parseGitHubID("workflow run", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	run, _, err := client.Actions.GetWorkflowRunByID(ctx, owner, repo, runID)
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return nil, fmt.Errorf("project %s or workflow run %d: %w", project, runID, sentinel)
+		}
+
+		return nil, fmt.Errorf("failed to get workflow run %d for %s: %w", runID, project, err)
+	}
+
+	artifacts, err := testReportArtifacts(ctx, client, owner, repo, runID)
+	if err != nil {
+		return nil, err
+	}
+
+	suites := make([]models.PipelineTestSuite, 0)
+
+	for _, artifact := range artifacts {
+		artifactSuites, err := g.readJUnitArtifact(ctx, client, owner, repo, artifact)
+		if err != nil {
+			return nil, err
+		}
+
+		suites = append(suites, artifactSuites...)
+	}
+
+	return &models.PipelineTestReport{
+		PipelineId:     rawPipelineID,
+		PipelineStatus: string(normalizeGitHubWorkflowRunStatus(run.GetStatus(), run.GetConclusion())),
+		Totals:         common.SumPipelineTestTotals(suites),
+		Suites:         suites,
+	}, nil
+}
+
+// testReportArtifacts lists the artifacts of a workflow run that may hold JUnit reports.
+func testReportArtifacts(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo string,
+	runID int64,
+) ([]*github.Artifact, error) {
+	it := gfgithub.ScanGitHubList(
+		func(opt github.ListOptions) ([]*github.Artifact, *github.Response, error) {
+			list, resp, err := client.Actions.ListWorkflowRunArtifacts(ctx, owner, repo, runID, &opt)
+			if err != nil {
+				return nil, resp, err
+			}
+
+			return list.Artifacts, resp, nil
+		},
+	)
+
+	var result []*github.Artifact
+
+	for artifact, err := range it {
+		if err != nil {
+			if sentinel := classifyGitHubError(err); sentinel != nil {
+				return nil, fmt.Errorf("project %s/%s or workflow run %d: %w", owner, repo, runID, sentinel)
+			}
+
+			return nil, fmt.Errorf("failed to list artifacts for %s/%s workflow run %d: %w", owner, repo, runID, err)
+		}
+
+		if artifact.GetExpired() || !testReportArtifactName.MatchString(artifact.GetName()) {
+			continue
+		}
+
+		if artifact.GetSizeInBytes() > maxTestReportArtifactBytes {
+			slog.Warn("Skipping test report artifact over the size limit",
+				"project", owner+"/"+repo,
+				"runID", runID,
+				"artifact", artifact.GetName(),
+				"size", artifact.GetSizeInBytes(),
+			)
+
+			continue
+		}
+
+		result = append(result, artifact)
+
+		if len(result) == maxTestReportArtifacts {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// readJUnitArtifact downloads an artifact and parses the JUnit reports among its XML files.
+func (g *GitHubProvider) readJUnitArtifact(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo string,
+	artifact *github.Artifact,
+) ([]models.PipelineTestSuite, error) {
+	project := owner + "/" + repo
+
+	archive, err := g.readArtifactArchive(ctx, client, owner, repo, artifact.GetID())
+	if err != nil {
+		return nil, err
+	}
+
+	if archive == nil {
+		slog.Warn("Skipping test report artifact over the size limit",
+			"project", project, "artifact", artifact.GetName())
+
+		return nil, nil
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		slog.Warn("Skipping unreadable test report artifact",
+			"project", project, "artifact", artifact.GetName(), "error", err)
+
+		return nil, nil
+	}
+
+	var suites []models.PipelineTestSuite
+
+	for _, f := range zr.File {
+		if !strings.EqualFold(path.Ext(f.Name), ".xml") {
+			continue
+		}
+
+		fileSuites, err := readJUnitFile(f)
+		if err != nil {
+			if !errors.Is(err, common.ErrNotJUnit) {
+				slog.Warn("Skipping unreadable JUnit report",
+					"project", project, "artifact", artifact.GetName(), "file", f.Name, "error", err)
+			}
+
+			continue
+		}
+
+		suites = append(suites, fileSuites...)
+	}
+
+	return suites, nil
+}
+
+func readJUnitFile(f *zip.File) ([]models.PipelineTestSuite, error) {
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = rc.Close() }()
+
+	// The archive is within maxTestReportArtifactBytes, but a file may decompress to far more.
+	return common.ParseJUnit(io.LimitReader(rc, 4*maxTestReportArtifactBytes))
+}
+
+// readArtifactArchive returns the zip archive of an artifact, or nil when it is larger than
+// maxTestReportArtifactBytes.
+func (g *GitHubProvider) readArtifactArchive(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo string,
+	artifactID int64,
+) ([]byte, error) {
+	artifactResp, err := g.openArtifact(ctx, client, owner, repo, artifactID, artifactRequestTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() { _ = artifactResp.Body.Close() }()
+
+	data, err := io.ReadAll(io.LimitReader(artifactResp.Body, maxTestReportArtifactBytes+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read artifact %d for %s/%s: %w", artifactID, owner, repo, err)
+	}
+
+	if len(data) > maxTestReportArtifactBytes {
+		return nil, nil
+	}
+
+	return data, nil
+}
+
+// openArtifact starts the download of an artifact's zip archive; the caller must close the
+// response body. The download URL is resolved through go-github and fetched without credentials,
+// like job logs. A zero timeout leaves the download bounded only by ctx.
+func (g *GitHubProvider) openArtifact(
+	ctx context.Context,
+	client *github.Client,
+	owner, repo string,
+	artifactID int64,
+	timeout time.Duration,
+) (*http.Response, error) {
+	project := owner + "/" + repo
+
+	artifactURL, resp, err := client.Actions.DownloadArtifact(ctx, owner, repo, artifactID, 1)
+	if err != nil {
+		if resp != nil {
+			if sentinel := mapGitHubLogsStatus(resp.StatusCode); sentinel != nil {
+				return nil, fmt.Errorf("project %s or artifact %d: %w", project, artifactID, sentinel)
+			}
+		}
+
+		return nil, fmt.Errorf("failed to get artifact URL for %s artifact %d: %w", project, artifactID, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, artifactURL.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build artifact request for %s artifact %d: %w", project, artifactID, err)
+	}
+
+	httpClient := &http.Client{Timeout: timeout}
+	if g.httpClient != nil {
+		httpClient.Transport = g.httpClient.Transport
+	}
+
+	artifactResp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download artifact %d for %s: %w", artifactID, project, err)
+	}
+
+	if artifactResp.StatusCode != http.StatusOK {
+		_ = artifactResp.Body.Close()
+
+		if sentinel := mapGitHubLogsStatus(artifactResp.StatusCode); sentinel != nil {
+			return nil, fmt.Errorf("project %s or artifact %d: %w", project, artifactID, sentinel)
+		}
+
+		return nil, fmt.Errorf("github artifact download failed for %s artifact %d: status %d",
+			project, artifactID, artifactResp.StatusCode)
+	}
+
+	return artifactResp, nil
+}
+
+// ListPipelineArtifacts lists the artifacts of a GitHub Actions workflow run, expired ones
+// included. Artifacts belong to the run rather than to the job uploading them, so a job filter is
+// rejected.
+func (g *GitHubProvider) ListPipelineArtifacts(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+	opts models.PipelineArtifactListOptions,
+) ([]models.PipelineArtifact, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.JobID != nil {
+		return nil, fmt.Errorf("github workflow run artifacts are not linked to jobs: %w", gferrors.ErrBadRequest)
+	}
+
+	runID, err := parseGitHubID("workflow run", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	it := gfgithub.ScanGitHubList(
+		func(opt github.ListOptions) ([]*github.Artifact, *github.Response, error) {
+			list, resp, err := client.Actions.ListWorkflowRunArtifacts(ctx, owner, repo, runID, &opt)
+			if err != nil {
+				return nil, resp, err
+			}
+
+			return list.Artifacts, resp, nil
+		},
+	)
+
+	result := make([]models.PipelineArtifact, 0)
+
+	for artifact, err := range it {
+		if err != nil {
+			if sentinel := classifyGitHubError(err); sentinel != nil {
+				return nil, fmt.Errorf("project %s or workflow run %d: %w", project, runID, sentinel)
+			}
+
+			return nil, fmt.Errorf("failed to list artifacts for %s workflow run %d: %w", project, runID, err)
+		}
+
+		result = append(result, mapGitHubArtifact(artifact))
+	}
+
+	return result, nil
+}
+
+// mapGitHubArtifact converts a workflow run artifact to the unified PipelineArtifact model.
+func mapGitHubArtifact(a *github.Artifact) models.PipelineArtifact {
+	size := a.GetSizeInBytes()
+
+	artifact := models.PipelineArtifact{
+		Id:      strconv.FormatInt(a.GetID(), 10),
+		Name:    a.GetName(),
+		Size:    &size,
+		Expired: a.GetExpired(),
+		Scope:   models.PipelineArtifactScopePipeline,
+	}
+
+	if a.CreatedAt != nil {
+		artifact.CreatedAt = &a.CreatedAt.Time
+	}
+
+	if a.ExpiresAt != nil {
+		artifact.ExpiresAt = &a.ExpiresAt.Time
+	}
+
+	return artifact
+}
+
+// DownloadArtifact opens the zip archive of a workflow run artifact. The artifact is read first
+// for its name and size; an expired artifact is reported as not found.
+func (g *GitHubProvider) DownloadArtifact(
+	ctx context.Context,
+	project string,
+	artifactID string,
+	settings krci.GitServerSettings,
+) (*models.ArtifactDownload, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := parseGitHubID("artifact", artifactID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	artifact, _, err := client.Actions.GetArtifact(ctx, owner, repo, id)
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return nil, fmt.Errorf("project %s or artifact %d: %w", project, id, sentinel)
+		}
+
+		return nil, fmt.Errorf("failed to get artifact %d for %s: %w", id, project, err)
+	}
+
+	if artifact.GetExpired() {
+		return nil, fmt.Errorf("artifact %d of %s has expired: %w", id, project, gferrors.ErrNotFound)
+	}
+
+	resp, err := g.openArtifact(ctx, client, owner, repo, id, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.ArtifactDownload{
+		Body:        resp.Body,
+		Name:        artifact.GetName() + ".zip",
+		Size:        resp.ContentLength,
+		ContentType: "application/zip",
+	}, nil
 }
@@ -1,10 +1,19 @@
 package github
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -12,7 +21,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
 )
 
@@ -342,6 +353,139 @@ func TestGitHubProviderListPipelinesFilterParams(t *testing.T) {
 	assert.Equal(t, "100", result.Data[0].Id)
 }
 
+func TestGitHubProviderListPipelinesCommitUserAndDateFilters(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "abc123", q.Get("head_sha"))
+		assert.Equal(t, "pull_request", q.Get("event"))
+		assert.Equal(t, "octocat", q.Get("actor"))
+		assert.Equal(t, "<=2026-01-31T00:00:00Z", q.Get("created"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.WorkflowRuns{
+			TotalCount: ptr(2),
+			WorkflowRuns: []*github.WorkflowRun{
+				{ID: ptr(int64(101)), Event: ptr("pull_request"),
+					UpdatedAt: newTimestamp(mustParseTime("2026-01-20T10:00:00Z"))},
+				{ID: ptr(int64(100)), Event: ptr("pull_request"),
+					UpdatedAt: newTimestamp(mustParseTime("2025-12-20T10:00:00Z"))},
+			},
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	sha, source, user := "abc123", "merge_request", "octocat"
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	result, err := newTestProvider(server.URL).ListPipelines(
+		context.Background(),
+		"owner/repo",
+		krci.GitServerSettings{Token: "test-token"},
+		models.PipelineListOptions{
+			Sha:           &sha,
+			Source:        &source,
+			TriggeredBy:   &user,
+			UpdatedAfter:  &after,
+			UpdatedBefore: &before,
+			Page:          1,
+			PerPage:       20,
+		},
+	)
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1, "runs last updated before updatedAfter are filtered out")
+	assert.Equal(t, "101", result.Data[0].Id)
+}
+
+func TestGitHubProviderListPipelinesAscending(t *testing.T) {
+	// Five runs, listed newest first like GitHub does.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+
+		runs := make([]*github.WorkflowRun, 0, perPage)
+		for pos := (page - 1) * perPage; pos < page*perPage && pos < 5; pos++ {
+			runs = append(runs, &github.WorkflowRun{ID: ptr(int64(5 - pos))})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.WorkflowRuns{TotalCount: ptr(5), WorkflowRuns: runs})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	ids := func(page int) []string {
+		result, err := newTestProvider(server.URL).ListPipelines(
+			context.Background(),
+			"owner/repo",
+			krci.GitServerSettings{Token: "test-token"},
+			models.PipelineListOptions{Ascending: true, Page: page, PerPage: 2},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, 5, result.Pagination.Total)
+
+		got := make([]string, 0, len(result.Data))
+		for _, p := range result.Data {
+			got = append(got, p.Id)
+		}
+
+		return got
+	}
+
+	assert.Equal(t, []string{"1", "2"}, ids(1))
+	assert.Equal(t, []string{"3", "4"}, ids(2))
+	assert.Equal(t, []string{"5"}, ids(3))
+	assert.Empty(t, ids(4))
+}
+
+func TestGitHubProviderListPipelinesAscendingPastFilteredWindow(t *testing.T) {
+	// 1500 runs on the branch, of which GitHub serves the newest 1000.
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		perPage, _ := strconv.Atoi(r.URL.Query().Get("per_page"))
+
+		runs := make([]*github.WorkflowRun, 0, perPage)
+		for pos := (page - 1) * perPage; pos < page*perPage && pos < maxFilteredGitHubRuns; pos++ {
+			runs = append(runs, &github.WorkflowRun{ID: ptr(int64(1500 - pos))})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.WorkflowRuns{TotalCount: ptr(1500), WorkflowRuns: runs})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	list := func(page int) (*models.PipelinesResponse, error) {
+		return newTestProvider(server.URL).ListPipelines(
+			context.Background(),
+			"owner/repo",
+			krci.GitServerSettings{Token: "test-token"},
+			models.PipelineListOptions{Ref: ptr("main"), Ascending: true, Page: page, PerPage: 100},
+		)
+	}
+
+	for _, page := range []int{1, 5} {
+		_, err := list(page)
+		require.Error(t, err, "page %d", page)
+		assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+	}
+
+	// Page 6 holds the oldest of the newest 1000 runs.
+	result, err := list(6)
+	require.NoError(t, err)
+	require.Len(t, result.Data, 100)
+	assert.Equal(t, "501", result.Data[0].Id)
+	assert.Equal(t, "600", result.Data[99].Id)
+}
+
 func TestGitHubProviderListPipelinesNotFound(t *testing.T) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/repos/nonexistent/project/actions/runs", func(w http.ResponseWriter, r *http.Request) {
@@ -396,18 +540,1033 @@ func TestGitHubProviderListPipelinesUnauthorized(t *testing.T) {
 	assert.Contains(t, err.Error(), "unauthorized")
 }
 
-func TestGitHubProviderTriggerPipelineUnsupported(t *testing.T) {
-	provider := NewGitHubProvider()
+// newDispatchTestMux serves a repository with a push-only workflow and a dispatchable one.
+// The dispatch endpoint answers with dispatchStatus; a successful dispatch makes created new
+// workflow runs (101, 102, ...) appear, as concurrent dispatches would for created > 1.
+func newDispatchTestMux(
+	t *testing.T,
+	gotDispatch *github.CreateWorkflowDispatchEventRequest,
+	dispatchStatus int,
+	created int,
+) *http.ServeMux {
+	t.Helper()
+
+	dispatched := false
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/workflows", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.Workflows{
+			TotalCount: ptr(3),
+			Workflows: []*github.Workflow{
+				{ID: ptr(int64(1)), Path: ptr(".github/workflows/push.yaml"), State: ptr("active")},
+				{ID: ptr(int64(2)), Path: ptr(".github/workflows/release.yaml"), State: ptr("active")},
+				{ID: ptr(int64(3)), Path: ptr(".github/workflows/old.yaml"), State: ptr("disabled_manually")},
+			},
+		})
+	})
+	mux.HandleFunc("/repos/owner/repo/contents/.github/workflows/push.yaml", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "main", r.URL.Query().Get("ref"))
+		writeWorkflowContent(w, "on: push\n")
+	})
+	mux.HandleFunc(
+		"/repos/owner/repo/contents/.github/workflows/release.yaml",
+		func(w http.ResponseWriter, _ *http.Request) {
+			writeWorkflowContent(w, "on:\n  workflow_dispatch:\n    inputs:\n      env: {}\n")
+		},
+	)
+	mux.HandleFunc("/repos/owner/repo/actions/workflows/2/dispatches", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(gotDispatch))
+
+		if dispatchStatus != http.StatusNoContent {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(dispatchStatus)
+			_ = json.NewEncoder(w).Encode(map[string]string{"message": "Unexpected inputs provided"})
+
+			return
+		}
+
+		dispatched = true
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/repos/owner/repo/actions/workflows/2/runs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "workflow_dispatch", r.URL.Query().Get("event"))
+		assert.Equal(t, "main", r.URL.Query().Get("branch"))
+
+		runs := []*github.WorkflowRun{
+			{ID: ptr(int64(100)), Status: ptr("completed"), Conclusion: ptr("success")},
+		}
+
+		for i := range created {
+			if dispatched {
+				runs = append([]*github.WorkflowRun{{
+					ID:      ptr(int64(101 + i)),
+					Status:  ptr("queued"),
+					HeadSHA: ptr("abc123"),
+					HTMLURL: ptr(fmt.Sprintf("https://github.com/owner/repo/actions/runs/%d", 101+i)),
+				}}, runs...)
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.WorkflowRuns{TotalCount: ptr(len(runs)), WorkflowRuns: runs})
+	})
+
+	return mux
+}
+
+func writeWorkflowContent(w http.ResponseWriter, content string) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(github.RepositoryContent{
+		Type:     ptr("file"),
+		Encoding: ptr("base64"),
+		Content:  ptr(base64.StdEncoding.EncodeToString([]byte(content))),
+	})
+}
+
+func TestGitHubProviderTriggerPipeline(t *testing.T) {
+	var gotDispatch github.CreateWorkflowDispatchEventRequest
+
+	server := httptest.NewServer(newDispatchTestMux(t, &gotDispatch, http.StatusNoContent, 1))
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
 
 	result, err := provider.TriggerPipeline(
 		context.Background(),
 		"owner/repo",
-		"main",
-		nil,
 		krci.GitServerSettings{Token: "test-token"},
+		models.PipelineTriggerOptions{
+			Ref:       "main",
+			Variables: []models.PipelineVariable{{Key: "env", Value: "prod"}},
+		},
 	)
 
-	assert.Error(t, err)
+	require.NoError(t, err)
+	require.NotNil(t, result)
+
+	assert.Equal(t, "main", gotDispatch.Ref)
+	assert.Equal(t, map[string]any{"env": "prod"}, gotDispatch.Inputs)
+
+	// The run above the pre-dispatch baseline is the one our dispatch created.
+	assert.Equal(t, "101", result.Id)
+	assert.Equal(t, "https://github.com/owner/repo/actions/runs/101", result.WebUrl)
+	assert.Equal(t, string(models.PipelineStatusPending), result.Status)
+	assert.Equal(t, "main", result.Ref)
+	require.NotNil(t, result.Sha)
+	assert.Equal(t, "abc123", *result.Sha)
+}
+
+func TestGitHubProviderTriggerPipelineUnidentifiedRun(t *testing.T) {
+	tests := []struct {
+		name    string
+		created int
+	}{
+		{name: "run did not appear in time", created: 0},
+		{name: "several runs appeared", created: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotDispatch github.CreateWorkflowDispatchEventRequest
+
+			server := httptest.NewServer(newDispatchTestMux(t, &gotDispatch, http.StatusNoContent, tt.created))
+			defer server.Close()
+
+			result, err := newTestProvider(server.URL).TriggerPipeline(
+				context.Background(),
+				"owner/repo",
+				krci.GitServerSettings{Token: "test-token"},
+				models.PipelineTriggerOptions{Ref: "main"},
+			)
+
+			// The dispatch succeeded, so a retry would only create another run.
+			require.NoError(t, err)
+			assert.Equal(t, "main", gotDispatch.Ref)
+			assert.Empty(t, result.Id)
+			assert.Equal(t, string(models.PipelineStatusPending), result.Status)
+			assert.Equal(t, "main", result.Ref)
+			assert.Nil(t, result.Sha)
+		})
+	}
+}
+
+func TestWorkflowRunsURL(t *testing.T) {
+	workflow := &github.Workflow{
+		Path:    ptr(".github/workflows/release.yaml"),
+		HTMLURL: ptr("https://github.com/owner/repo/blob/main/.github/workflows/release.yaml"),
+	}
+
+	assert.Equal(t, "https://github.com/owner/repo/actions/workflows/release.yaml", workflowRunsURL(workflow))
+	assert.Empty(t, workflowRunsURL(&github.Workflow{}))
+}
+
+func TestGitHubProviderTriggerPipelineNamedWorkflow(t *testing.T) {
+	t.Run("dispatches the named workflow", func(t *testing.T) {
+		var gotDispatch github.CreateWorkflowDispatchEventRequest
+
+		server := httptest.NewServer(newDispatchTestMux(t, &gotDispatch, http.StatusNoContent, 1))
+		defer server.Close()
+
+		provider := newTestProvider(server.URL)
+
+		result, err := provider.TriggerPipeline(
+			context.Background(),
+			"owner/repo",
+			krci.GitServerSettings{Token: "test-token"},
+			models.PipelineTriggerOptions{Ref: "main", Pipeline: ptr("release.yaml")},
+		)
+
+		require.NoError(t, err)
+		assert.Equal(t, "101", result.Id)
+		assert.Equal(t, "main", gotDispatch.Ref)
+	})
+
+	t.Run("named workflow without workflow_dispatch returns not found", func(t *testing.T) {
+		var gotDispatch github.CreateWorkflowDispatchEventRequest
+
+		server := httptest.NewServer(newDispatchTestMux(t, &gotDispatch, http.StatusNoContent, 1))
+		defer server.Close()
+
+		provider := newTestProvider(server.URL)
+
+		result, err := provider.TriggerPipeline(
+			context.Background(),
+			"owner/repo",
+			krci.GitServerSettings{Token: "test-token"},
+			models.PipelineTriggerOptions{Ref: "main", Pipeline: ptr(".github/workflows/push.yaml")},
+		)
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, gferrors.ErrNotFound)
+		assert.Contains(t, err.Error(), "push.yaml")
+		assert.Empty(t, gotDispatch.Ref, "no workflow should have been dispatched")
+	})
+}
+
+func TestGitHubProviderTriggerPipelineNoDispatchableWorkflow(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/workflows", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.Workflows{
+			TotalCount: ptr(2),
+			Workflows: []*github.Workflow{
+				{ID: ptr(int64(1)), Path: ptr(".github/workflows/push.yaml"), State: ptr("active")},
+				{ID: ptr(int64(2)), Path: ptr(".github/workflows/gone.yaml"), State: ptr("active")},
+			},
+		})
+	})
+	mux.HandleFunc("/repos/owner/repo/contents/.github/workflows/push.yaml", func(w http.ResponseWriter, r *http.Request) {
+		writeWorkflowContent(w, "on: [push, pull_request]\n")
+	})
+	mux.HandleFunc("/repos/owner/repo/contents/.github/workflows/gone.yaml", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]string{"message": "Not Found"})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+
+	result, err := provider.TriggerPipeline(
+		context.Background(),
+		"owner/repo",
+		krci.GitServerSettings{Token: "test-token"},
+		models.PipelineTriggerOptions{Ref: "main"},
+	)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+	assert.Contains(t, err.Error(), "workflow_dispatch")
+}
+
+func TestGitHubProviderTriggerPipelineRejectedInputs(t *testing.T) {
+	var gotDispatch github.CreateWorkflowDispatchEventRequest
+
+	server := httptest.NewServer(newDispatchTestMux(t, &gotDispatch, http.StatusUnprocessableEntity, 0))
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+
+	result, err := provider.TriggerPipeline(
+		context.Background(),
+		"owner/repo",
+		krci.GitServerSettings{Token: "test-token"},
+		models.PipelineTriggerOptions{
+			Ref:       "main",
+			Variables: []models.PipelineVariable{{Key: "bogus", Value: "1"}},
+		},
+	)
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.True(t, errors.Is(err, gferrors.ErrBadRequest), "rejected inputs should map to ErrBadRequest, got: %v", err)
+}
+
+func TestGitHubProviderTriggerPipelineInvalidProject(t *testing.T) {
+	provider := NewGitHubProvider()
+
+	result, err := provider.TriggerPipeline(
+		context.Background(),
+		"no-slash",
+		krci.GitServerSettings{Token: "test-token"},
+		models.PipelineTriggerOptions{Ref: "main"},
+	)
+
+	require.Error(t, err)
 	assert.Nil(t, result)
-	assert.Contains(t, err.Error(), "not supported for GitHub")
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
+
+func TestGithubJobStatus(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		conclusion string
+		want       string
+	}{
+		{name: "queued keeps live status", status: "queued", want: "queued"},
+		{name: "in_progress keeps live status", status: "in_progress", want: "in_progress"},
+		{name: "completed uses conclusion", status: "completed", conclusion: "failure", want: "failure"},
+		{name: "completed without conclusion keeps status", status: "completed", want: "completed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, githubJobStatus(tt.status, tt.conclusion))
+		})
+	}
+}
+
+func TestGitHubProviderListPipelineJobs(t *testing.T) {
+	startedAt := mustParseTime("2024-06-01T10:00:00Z")
+	completedAt := mustParseTime("2024-06-01T10:01:30Z")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/runs/77/jobs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "latest", r.URL.Query().Get("filter"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.Jobs{
+			TotalCount: ptr(2),
+			Jobs: []*github.WorkflowJob{
+				{
+					ID:           ptr(int64(502)),
+					Name:         ptr("deploy"),
+					WorkflowName: ptr("CI"),
+					Status:       ptr("in_progress"),
+					HeadBranch:   ptr("main"),
+				},
+				{
+					ID:           ptr(int64(501)),
+					Name:         ptr("build"),
+					WorkflowName: ptr("CI"),
+					Status:       ptr("completed"),
+					Conclusion:   ptr("success"),
+					HeadBranch:   ptr("main"),
+					HTMLURL:      ptr("https://github.com/owner/repo/actions/runs/77/job/501"),
+					StartedAt:    newTimestamp(startedAt),
+					CompletedAt:  newTimestamp(completedAt),
+					Steps: []*github.TaskStep{
+						{Number: ptr(int64(1)), Name: ptr("Set up job"), Status: ptr("completed"), Conclusion: ptr("success")},
+						{Number: ptr(int64(2)), Name: ptr("Run make"), Status: ptr("completed"), Conclusion: ptr("failure")},
+					},
+				},
+			},
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+
+	jobs, err := provider.ListPipelineJobs(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"})
+
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+
+	build := jobs[0]
+	assert.Equal(t, "501", build.Id, "jobs should be ordered by ID ascending")
+	assert.Equal(t, "build", build.Name)
+	assert.Equal(t, "CI", build.Stage)
+	assert.Equal(t, "success", build.Status)
+	require.NotNil(t, build.Ref)
+	assert.Equal(t, "main", *build.Ref)
+	require.NotNil(t, build.WebUrl)
+	assert.Equal(t, "https://github.com/owner/repo/actions/runs/77/job/501", *build.WebUrl)
+	require.NotNil(t, build.Duration)
+	assert.InDelta(t, 90, *build.Duration, 0.001)
+	require.NotNil(t, build.Stages)
+	assert.Equal(t, []models.PipelineJobStage{
+		{Number: 1, Name: "Set up job", Status: "success"},
+		{Number: 2, Name: "Run make", Status: "failure"},
+	}, *build.Stages)
+
+	deploy := jobs[1]
+	assert.Equal(t, "502", deploy.Id)
+	assert.Equal(t, "in_progress", deploy.Status)
+	assert.Nil(t, deploy.Duration)
+	assert.Nil(t, deploy.Stages)
+}
+
+func TestGitHubProviderListPipelineJobsNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]string{"message": "Not Found"})
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+
+	jobs, err := provider.ListPipelineJobs(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"})
+
+	require.Error(t, err)
+	assert.Nil(t, jobs)
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+func newJobLogsTestMux(t *testing.T, logs string) *http.ServeMux {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/jobs/501/logs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer test-token", r.Header.Get("Authorization"))
+		w.Header().Set("Location", "https://pipelines.actions.githubusercontent.com/logs/501?sig=abc")
+		w.WriteHeader(http.StatusFound)
+	})
+	mux.HandleFunc("/logs/501", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "abc", r.URL.Query().Get("sig"))
+		assert.Empty(t, r.Header.Get("Authorization"), "the log download must not carry the API token")
+		_, _ = w.Write([]byte(logs))
+	})
+
+	return mux
+}
+
+func TestGitHubProviderGetJobTrace(t *testing.T) {
+	server := httptest.NewServer(newJobLogsTestMux(t, "##[group]Run make\nok\n##[endgroup]\n"))
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+
+	content, truncated, err := provider.GetJobTrace(context.Background(), "owner/repo", "501",
+		krci.GitServerSettings{Token: "test-token"})
+
+	require.NoError(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, "##[group]Run make\nok\n##[endgroup]\n", content)
+}
+
+func TestGitHubProviderGetJobTraceTruncated(t *testing.T) {
+	server := httptest.NewServer(newJobLogsTestMux(t, strings.Repeat("x", common.MaxTraceBytes+10)))
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+
+	content, truncated, err := provider.GetJobTrace(context.Background(), "owner/repo", "501",
+		krci.GitServerSettings{Token: "test-token"})
+
+	require.NoError(t, err)
+	assert.True(t, truncated)
+	assert.Len(t, content, common.MaxTraceBytes)
+}
+
+func TestGitHubProviderGetJobTraceErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "missing job maps to not found", status: http.StatusNotFound, wantErr: gferrors.ErrNotFound},
+		{name: "expired logs map to not found", status: http.StatusGone, wantErr: gferrors.ErrNotFound},
+		{name: "bad credentials map to unauthorized", status: http.StatusUnauthorized, wantErr: gferrors.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			provider := newTestProvider(server.URL)
+
+			content, truncated, err := provider.GetJobTrace(context.Background(), "owner/repo", "501",
+				krci.GitServerSettings{Token: "test-token"})
+
+			require.Error(t, err)
+			assert.Empty(t, content)
+			assert.False(t, truncated)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestGitHubProviderGetJobTraceWindow(t *testing.T) {
+	logs := strings.Repeat("x", 100) + "\ntail\n"
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/actions/jobs/501/logs", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Location", "https://pipelines.actions.githubusercontent.com/logs/501")
+		w.WriteHeader(http.StatusFound)
+	})
+	mux.HandleFunc("/logs/501", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "bytes=95-104", r.Header.Get("Range"))
+		http.ServeContent(w, r, "", time.Time{}, strings.NewReader(logs))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	trace, err := newTestProvider(server.URL).GetJobTraceWindow(context.Background(), "owner/repo", "501",
+		krci.GitServerSettings{Token: "test-token"}, models.JobTraceWindow{Offset: 95, Limit: 10})
+
+	require.NoError(t, err)
+	assert.Equal(t, "xxxxx\ntail", trace.Content)
+	assert.Equal(t, int64(95), trace.Offset)
+	require.NotNil(t, trace.TotalSize)
+	assert.Equal(t, int64(106), *trace.TotalSize)
+	assert.True(t, trace.Truncated)
+}
+
+func TestGitHubProviderNonNumericIDs(t *testing.T) {
+	provider := NewGitHubProvider()
+	settings := krci.GitServerSettings{Token: "test-token"}
+
+	jobs, err := provider.ListPipelineJobs(context.Background(), "owner/repo", "abc", settings)
+	require.Error(t, err)
+	assert.Nil(t, jobs)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+
+	_, _, err = provider.GetJobTrace(context.Background(), "owner/repo", "nope", settings)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
+
+func TestGitHubProviderListPullRequestPipelines(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo/pulls/7", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.PullRequest{
+			Number: ptr(7),
+			Head:   &github.PullRequestBranch{Ref: ptr("feature"), SHA: ptr("head2")},
+		})
+	})
+	mux.HandleFunc("/repos/owner/repo/actions/runs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "feature", r.URL.Query().Get("branch"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.WorkflowRuns{
+			TotalCount: ptr(4),
+			WorkflowRuns: []*github.WorkflowRun{
+				{ID: ptr(int64(104)), HeadSHA: ptr("head2")},
+				{ID: ptr(int64(103)), HeadSHA: ptr("head1"),
+					PullRequests: []*github.PullRequest{{Number: ptr(7)}}},
+				{ID: ptr(int64(102)), HeadSHA: ptr("other"),
+					PullRequests: []*github.PullRequest{{Number: ptr(8)}}},
+				{ID: ptr(int64(101)), HeadSHA: ptr("other")},
+			},
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	result, err := newTestProvider(server.URL).ListPullRequestPipelines(
+		context.Background(), "owner/repo", 7, krci.GitServerSettings{Token: "test-token"},
+		models.PipelineListOptions{Page: 1, PerPage: 20},
+	)
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 2, "runs of other pull requests and unlinked runs are left out")
+	assert.Equal(t, "104", result.Data[0].Id, "runs of the head commit count for fork pull requests")
+	assert.Equal(t, "103", result.Data[1].Id)
+	assert.Equal(t, 4, result.Pagination.Total)
+}
+
+func TestGitHubProviderListPullRequestPipelinesNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestProvider(server.URL).ListPullRequestPipelines(
+		context.Background(), "owner/repo", 7, krci.GitServerSettings{Token: "test-token"},
+		models.PipelineListOptions{Page: 1, PerPage: 20},
+	)
+
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+func TestGitHubProviderGetPipeline(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/owner/repo/actions/runs/77", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": 77,
+			"status": "completed",
+			"conclusion": "success",
+			"event": "push",
+			"head_branch": "main",
+			"head_sha": "abc123",
+			"html_url": "https://github.com/owner/repo/actions/runs/77",
+			"created_at": "2026-01-15T10:00:00Z",
+			"run_started_at": "2026-01-15T10:00:30Z",
+			"updated_at": "2026-01-15T10:05:30Z",
+			"actor": {"id": 1, "login": "author"},
+			"triggering_actor": {"id": 2, "login": "rerunner"},
+			"head_commit": {"id": "abc123", "message": "Fix the build\n\nLonger description"}
+		}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	p, err := newTestProvider(server.URL).GetPipeline(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "77", p.Id)
+	assert.Equal(t, models.PipelineStatusSuccess, p.Status)
+	require.NotNil(t, p.QueuedDuration)
+	assert.InDelta(t, 30, *p.QueuedDuration, 0)
+	require.NotNil(t, p.Duration)
+	assert.InDelta(t, 300, *p.Duration, 0)
+	require.NotNil(t, p.TriggeredBy)
+	assert.Equal(t, "rerunner", p.TriggeredBy.Name, "the triggering actor wins over the run author")
+	require.NotNil(t, p.CommitTitle)
+	assert.Equal(t, "Fix the build", *p.CommitTitle)
+	assert.Nil(t, p.Coverage)
+}
+
+func TestGitHubProviderGetPipelineInProgress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": 77,
+			"status": "in_progress",
+			"created_at": "2026-01-15T10:00:00Z",
+			"run_started_at": "2026-01-15T10:00:30Z",
+			"updated_at": "2026-01-15T10:05:30Z"
+		}`))
+	}))
+	defer server.Close()
+
+	p, err := newTestProvider(server.URL).GetPipeline(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"})
+
+	require.NoError(t, err)
+	assert.Equal(t, models.PipelineStatusRunning, p.Status)
+	assert.Nil(t, p.Duration)
+	assert.Nil(t, p.TriggeredBy)
+	assert.Nil(t, p.CommitTitle)
+}
+
+func TestGitHubProviderGetPipelineNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "Not Found"}`))
+	}))
+	defer server.Close()
+
+	_, err := newTestProvider(server.URL).GetPipeline(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"})
+
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+// zipArchive returns a zip archive holding files, keyed by name.
+func zipArchive(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		f, err := zw.Create(name)
+		require.NoError(t, err)
+
+		_, err = f.Write([]byte(content))
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, zw.Close())
+
+	return buf.Bytes()
+}
+
+func TestGitHubProviderGetPipelineTestReport(t *testing.T) {
+	archive := zipArchive(t, map[string]string{
+		"reports/junit.xml": `<?xml version="1.0"?>
+<testsuites>
+  <testsuite name="api">
+    <testcase name="TestList" classname="api" time="0.25"/>
+    <testcase name="TestGet" classname="api" time="1.5">
+      <failure message="expected 200, got 500">api_test.go:42</failure>
+    </testcase>
+  </testsuite>
+</testsuites>`,
+		"reports/coverage.xml": `<coverage line-rate="0.9"/>`,
+		"reports/readme.txt":   "not a report",
+	})
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/owner/repo/actions/runs/77", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 77, "status": "completed", "conclusion": "failure"}`))
+	})
+	mux.HandleFunc("GET /repos/owner/repo/actions/runs/77/artifacts", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"total_count": 4, "artifacts": [
+			{"id": 1, "name": "test-results", "size_in_bytes": %d, "expired": false},
+			{"id": 2, "name": "binaries", "size_in_bytes": 100, "expired": false},
+			{"id": 3, "name": "junit-old", "size_in_bytes": 100, "expired": true},
+			{"id": 4, "name": "junit-huge", "size_in_bytes": %d, "expired": false}
+		]}`, len(archive), maxTestReportArtifactBytes+1)
+	})
+	mux.HandleFunc("GET /repos/owner/repo/actions/artifacts/1/zip", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Location", "https://artifacts.actions.githubusercontent.com/zip/1?sig=abc")
+		w.WriteHeader(http.StatusFound)
+	})
+	mux.HandleFunc("GET /zip/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"), "the artifact download must not carry the API token")
+		_, _ = w.Write(archive)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	report, err := newTestProvider(server.URL).GetPipelineTestReport(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "77", report.PipelineId)
+	assert.Equal(t, string(models.PipelineStatusFailed), report.PipelineStatus)
+	assert.Equal(t, models.PipelineTestTotals{Count: 2, Success: 1, Failed: 1, Duration: 1.75}, report.Totals)
+	require.Len(t, report.Suites, 1)
+	assert.Equal(t, "api", report.Suites[0].Name)
+
+	failed := report.Suites[0].Cases[1]
+	assert.Equal(t, models.PipelineTestCaseStatusFailed, failed.Status)
+	require.NotNil(t, failed.Message)
+	assert.Equal(t, "expected 200, got 500", *failed.Message)
+}
+
+func TestGitHubProviderGetPipelineTestReportWithoutArtifacts(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/owner/repo/actions/runs/77", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 77, "status": "in_progress"}`))
+	})
+	mux.HandleFunc("GET /repos/owner/repo/actions/runs/77/artifacts", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"total_count": 0, "artifacts": []}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	report, err := newTestProvider(server.URL).GetPipelineTestReport(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"})
+	require.NoError(t, err)
+
+	assert.Equal(t, string(models.PipelineStatusRunning), report.PipelineStatus)
+	assert.NotNil(t, report.Suites)
+	assert.Empty(t, report.Suites)
+	assert.Zero(t, report.Totals.Count)
+}
+
+func TestGitHubProviderListPipelineArtifacts(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/owner/repo/actions/runs/77/artifacts", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"total_count": 2, "artifacts": [
+			{"id": 1, "name": "binaries", "size_in_bytes": 100, "expired": false,
+			 "created_at": "2024-06-01T10:00:00Z", "expires_at": "2024-08-30T10:00:00Z"},
+			{"id": 2, "name": "junit-old", "size_in_bytes": 50, "expired": true}
+		]}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	artifacts, err := newTestProvider(server.URL).ListPipelineArtifacts(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"}, models.PipelineArtifactListOptions{})
+	require.NoError(t, err)
+	require.Len(t, artifacts, 2)
+
+	assert.Equal(t, "1", artifacts[0].Id)
+	assert.Equal(t, "binaries", artifacts[0].Name)
+	require.NotNil(t, artifacts[0].Size)
+	assert.Equal(t, int64(100), *artifacts[0].Size)
+	require.NotNil(t, artifacts[0].CreatedAt)
+	require.NotNil(t, artifacts[0].ExpiresAt)
+	assert.False(t, artifacts[0].Expired)
+	assert.Nil(t, artifacts[0].JobId)
+	assert.Equal(t, models.PipelineArtifactScopePipeline, artifacts[0].Scope)
+
+	assert.True(t, artifacts[1].Expired)
+
+	_, err = newTestProvider(server.URL).ListPipelineArtifacts(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"}, models.PipelineArtifactListOptions{JobID: ptr("501")})
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest, "run artifacts cannot be filtered by job")
+}
+
+func TestGitHubProviderDownloadArtifact(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/owner/repo/actions/artifacts/1", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 1, "name": "binaries", "size_in_bytes": 9, "expired": false}`))
+	})
+	mux.HandleFunc("GET /repos/owner/repo/actions/artifacts/1/zip", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Location", "https://artifacts.actions.githubusercontent.com/zip/1?sig=abc")
+		w.WriteHeader(http.StatusFound)
+	})
+	mux.HandleFunc("GET /zip/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"), "the artifact download must not carry the API token")
+		_, _ = w.Write([]byte("zip-bytes"))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	download, err := newTestProvider(server.URL).DownloadArtifact(context.Background(), "owner/repo", "1",
+		krci.GitServerSettings{Token: "test-token"})
+	require.NoError(t, err)
+
+	defer func() { _ = download.Body.Close() }()
+
+	content, err := io.ReadAll(download.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "zip-bytes", string(content))
+	assert.Equal(t, "binaries.zip", download.Name)
+	assert.Equal(t, "application/zip", download.ContentType)
+}
+
+func TestGitHubProviderDownloadArtifactExpired(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/owner/repo/actions/artifacts/2", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 2, "name": "junit-old", "expired": true}`))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	_, err := newTestProvider(server.URL).DownloadArtifact(context.Background(), "owner/repo", "2",
+		krci.GitServerSettings{Token: "test-token"})
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+func TestGitHubProviderCancelPipeline(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /repos/owner/repo/actions/runs/77/cancel", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+
+	result, err := provider.CancelPipeline(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "77", result.PipelineId)
+	assert.Equal(t, models.PipelineActionCancel, result.Action)
+	assert.Nil(t, result.Status)
+}
+
+func TestGitHubProviderCancelPipelineCompletedRun(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusConflict)
+		_ = json.NewEncoder(w).Encode(map[string]string{"message": "Cannot cancel a workflow run that is completed."})
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+
+	result, err := provider.CancelPipeline(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"})
+
+	require.Error(t, err)
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+	assert.Contains(t, err.Error(), "completed")
+}
+
+func TestGitHubProviderRetryPipeline(t *testing.T) {
+	tests := []struct {
+		name       string
+		failedOnly bool
+		wantPath   string
+	}{
+		{name: "whole run", failedOnly: false, wantPath: "/repos/owner/repo/actions/runs/77/rerun"},
+		{name: "failed jobs only", failedOnly: true, wantPath: "/repos/owner/repo/actions/runs/77/rerun-failed-jobs"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotPath string
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPost, r.Method)
+				gotPath = r.URL.Path
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			provider := newTestProvider(server.URL)
+
+			result, err := provider.RetryPipeline(context.Background(), "owner/repo", "77",
+				krci.GitServerSettings{Token: "test-token"}, models.PipelineRetryOptions{FailedOnly: tt.failedOnly})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPath, gotPath)
+			assert.Equal(t, models.PipelineActionRetry, result.Action)
+		})
+	}
+}
+
+func TestGitHubProviderRetryPipelineNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_ = json.NewEncoder(w).Encode(map[string]string{"message": "Not Found"})
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+
+	_, err := provider.RetryPipeline(context.Background(), "owner/repo", "77",
+		krci.GitServerSettings{Token: "test-token"}, models.PipelineRetryOptions{})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+func TestGitHubProviderRetryJob(t *testing.T) {
+	var rerun bool
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/owner/repo/actions/jobs/501", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 501, "run_id": 77, "name": "build", "status": "completed", "conclusion": "failure"}`))
+	})
+	mux.HandleFunc("POST /repos/owner/repo/actions/jobs/501/rerun", func(w http.ResponseWriter, _ *http.Request) {
+		rerun = true
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("GET /repos/owner/repo/actions/runs/77/jobs", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "latest", r.URL.Query().Get("filter"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"total_count": 2, "jobs": [
+			{"id": 601, "run_id": 77, "name": "lint", "status": "completed", "conclusion": "success"},
+			{"id": 602, "run_id": 77, "name": "build", "status": "queued"}
+		]}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	job, err := newTestProvider(server.URL).RetryJob(context.Background(), "owner/repo", "501",
+		krci.GitServerSettings{Token: "test-token"})
+
+	require.NoError(t, err)
+	assert.True(t, rerun)
+	assert.Equal(t, "602", job.Id, "the job of the new attempt should be returned")
+	assert.Equal(t, "queued", job.Status)
+	require.NotNil(t, job.PipelineId)
+	assert.Equal(t, "77", *job.PipelineId)
+}
+
+func TestGitHubProviderPlayJob(t *testing.T) {
+	var (
+		approved    github.PendingDeploymentsRequest
+		wasApproved atomic.Bool
+	)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/owner/repo/actions/jobs/501", func(w http.ResponseWriter, _ *http.Request) {
+		status := "waiting"
+		if wasApproved.Load() {
+			status = "queued"
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"id": 501, "run_id": 77, "name": "deploy", "status": %q}`, status)
+	})
+	mux.HandleFunc("GET /repos/owner/repo/actions/runs/77/pending_deployments",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[
+				{"environment": {"id": 3, "name": "prod"}, "current_user_can_approve": true},
+				{"environment": {"id": 4, "name": "audit"}, "current_user_can_approve": false}
+			]`))
+		},
+	)
+	mux.HandleFunc("POST /repos/owner/repo/actions/runs/77/pending_deployments",
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&approved))
+			wasApproved.Store(true)
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[]`))
+		},
+	)
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	job, err := newTestProvider(server.URL).PlayJob(context.Background(), "owner/repo", "501",
+		krci.GitServerSettings{Token: "test-token"}, models.PipelineJobPlayOptions{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3}, approved.EnvironmentIDs, "only environments the user may approve are approved")
+	assert.Equal(t, "approved", approved.State)
+	assert.Equal(t, "queued", job.Status)
+}
+
+func TestGitHubProviderJobActionsRejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 501, "run_id": 77, "name": "build", "status": "in_progress"}`))
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+	settings := krci.GitServerSettings{Token: "test-token"}
+
+	_, err := provider.PlayJob(context.Background(), "owner/repo", "501", settings, models.PipelineJobPlayOptions{})
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest, "a job that is not waiting cannot be played")
+
+	_, err = provider.PlayJob(context.Background(), "owner/repo", "501", settings, models.PipelineJobPlayOptions{
+		Variables: []models.PipelineVariable{{Key: "ENV", Value: "prod"}},
+	})
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest, "job variables are not supported")
 }
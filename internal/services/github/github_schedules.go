@@ -0,0 +1,174 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-github/v72/github"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// githubScheduleIDSeparator joins the workflow ID and the index of a cron entry under on.schedule
+// into a schedule ID.
+const githubScheduleIDSeparator = ":"
+
+// ListPipelineSchedules derives schedules from the workflows of a GitHub repository: each cron
+// entry under on.schedule of a workflow on the default branch, where GitHub reads schedules from,
+// is one schedule. Schedules are ordered by workflow path, then by their position in the file.
+func (g *GitHubProvider) ListPipelineSchedules(
+	ctx context.Context,
+	project string,
+	settings krci.GitServerSettings,
+) ([]models.PipelineSchedule, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	defaultBranch, err := githubDefaultBranch(ctx, client, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	workflows, err := listWorkflows(ctx, client, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(workflows, func(i, k int) bool {
+		return workflows[i].GetPath() < workflows[k].GetPath()
+	})
+
+	result := make([]models.PipelineSchedule, 0)
+
+	for _, workflow := range workflows {
+		content, err := getWorkflowFile(ctx, client, owner, repo, workflow.GetPath(), defaultBranch)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, cron := range common.WorkflowSchedules(content) {
+			result = append(result, mapGitHubSchedule(workflow, i, cron, defaultBranch))
+		}
+	}
+
+	return result, nil
+}
+
+// GetPipelineSchedule returns the schedule a cron entry of a workflow on the default branch
+// declares. The schedule ID is the workflow ID and the entry's index, as listed.
+func (g *GitHubProvider) GetPipelineSchedule(
+	ctx context.Context,
+	project string,
+	scheduleID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineSchedule, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowID, index, err := parseGitHubScheduleID(scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	workflow, _, err := client.Actions.GetWorkflowByID(ctx, owner, repo, workflowID)
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return nil, fmt.Errorf("repository %s or workflow %d: %w", project, workflowID, sentinel)
+		}
+
+		return nil, fmt.Errorf("failed to get workflow %d for %s: %w", workflowID, project, err)
+	}
+
+	defaultBranch, err := githubDefaultBranch(ctx, client, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := getWorkflowFile(ctx, client, owner, repo, workflow.GetPath(), defaultBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	crons := common.WorkflowSchedules(content)
+	if index >= len(crons) {
+		return nil, fmt.Errorf("schedule %s of %s: %w", scheduleID, project, gferrors.ErrNotFound)
+	}
+
+	schedule := mapGitHubSchedule(workflow, index, crons[index], defaultBranch)
+
+	return &schedule, nil
+}
+
+// githubDefaultBranch returns the default branch of a repository.
+func githubDefaultBranch(ctx context.Context, client *github.Client, owner, repo string) (string, error) {
+	repository, _, err := client.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return "", fmt.Errorf("repository %s/%s: %w", owner, repo, sentinel)
+		}
+
+		return "", fmt.Errorf("failed to get repository %s/%s: %w", owner, repo, err)
+	}
+
+	return repository.GetDefaultBranch(), nil
+}
+
+// parseGitHubScheduleID splits a schedule ID into the workflow ID and the cron entry index.
+func parseGitHubScheduleID(id string) (int64, int, error) {
+	rawWorkflowID, rawIndex, ok := strings.Cut(id, githubScheduleIDSeparator)
+	workflowID, workflowErr := strconv.ParseInt(rawWorkflowID, 10, 64)
+	index, indexErr := strconv.Atoi(rawIndex)
+
+	if !ok || workflowErr != nil || indexErr != nil || index < 0 {
+		return 0, 0, fmt.Errorf("schedule ID %q must have the form <workflow>%s<index>: %w",
+			id, githubScheduleIDSeparator, gferrors.ErrBadRequest)
+	}
+
+	return workflowID, index, nil
+}
+
+// mapGitHubSchedule converts the cron entry at index of a workflow to the unified PipelineSchedule model.
+// GitHub evaluates schedules in UTC and only for enabled workflows.
+func mapGitHubSchedule(workflow *github.Workflow, index int, cron, defaultBranch string) models.PipelineSchedule {
+	workflowPath := workflow.GetPath()
+
+	schedule := models.PipelineSchedule{
+		Id:           fmt.Sprintf("%d%s%d", workflow.GetID(), githubScheduleIDSeparator, index),
+		Description:  workflow.GetName(),
+		Ref:          defaultBranch,
+		Cron:         cron,
+		CronTimezone: "UTC",
+		Active:       workflow.GetState() == "active",
+		Workflow:     &workflowPath,
+	}
+
+	if workflow.CreatedAt != nil {
+		schedule.CreatedAt = &workflow.CreatedAt.Time
+	}
+
+	if workflow.UpdatedAt != nil {
+		schedule.UpdatedAt = &workflow.UpdatedAt.Time
+	}
+
+	return schedule
+}
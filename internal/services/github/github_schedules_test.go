@@ -0,0 +1,118 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v72/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// newSchedulesTestMux serves a repository whose default branch is "trunk", with a scheduled
+// workflow, a disabled scheduled workflow and a workflow without schedules.
+func newSchedulesTestMux(t *testing.T) *http.ServeMux {
+	t.Helper()
+
+	nightly := &github.Workflow{
+		ID: ptr(int64(1)), Name: ptr("Nightly"), Path: ptr(".github/workflows/nightly.yaml"), State: ptr("active"),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/repos/owner/repo", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.Repository{DefaultBranch: ptr("trunk")})
+	})
+	mux.HandleFunc("/repos/owner/repo/actions/workflows", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.Workflows{
+			TotalCount: ptr(3),
+			Workflows: []*github.Workflow{
+				{ID: ptr(int64(3)), Name: ptr("Push"), Path: ptr(".github/workflows/push.yaml"), State: ptr("active")},
+				{
+					ID: ptr(int64(2)), Name: ptr("Cleanup"), Path: ptr(".github/workflows/cleanup.yaml"),
+					State: ptr("disabled_manually"),
+				},
+				nightly,
+			},
+		})
+	})
+	mux.HandleFunc("/repos/owner/repo/actions/workflows/1", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(nightly)
+	})
+	mux.HandleFunc(
+		"/repos/owner/repo/contents/.github/workflows/nightly.yaml",
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "trunk", r.URL.Query().Get("ref"))
+			writeWorkflowContent(w, "on:\n  schedule:\n    - cron: '0 1 * * *'\n    - cron: '30 4 * * 1'\n")
+		},
+	)
+	mux.HandleFunc(
+		"/repos/owner/repo/contents/.github/workflows/cleanup.yaml",
+		func(w http.ResponseWriter, _ *http.Request) {
+			writeWorkflowContent(w, "on:\n  schedule:\n    - cron: '0 0 * * 0'\n")
+		},
+	)
+	mux.HandleFunc(
+		"/repos/owner/repo/contents/.github/workflows/push.yaml",
+		func(w http.ResponseWriter, _ *http.Request) {
+			writeWorkflowContent(w, "on: push\n")
+		},
+	)
+
+	return mux
+}
+
+func TestGitHubProviderListPipelineSchedules(t *testing.T) {
+	server := httptest.NewServer(newSchedulesTestMux(t))
+	defer server.Close()
+
+	schedules, err := newTestProvider(server.URL).ListPipelineSchedules(
+		context.Background(), "owner/repo", krci.GitServerSettings{Token: "test-token"},
+	)
+
+	require.NoError(t, err)
+	require.Len(t, schedules, 3)
+
+	assert.Equal(t, "2:0", schedules[0].Id, "schedules follow the workflow paths")
+	assert.False(t, schedules[0].Active)
+
+	assert.Equal(t, "1:0", schedules[1].Id)
+	assert.Equal(t, "Nightly", schedules[1].Description)
+	assert.Equal(t, "trunk", schedules[1].Ref)
+	assert.Equal(t, "0 1 * * *", schedules[1].Cron)
+	assert.Equal(t, "UTC", schedules[1].CronTimezone)
+	assert.True(t, schedules[1].Active)
+	require.NotNil(t, schedules[1].Workflow)
+	assert.Equal(t, ".github/workflows/nightly.yaml", *schedules[1].Workflow)
+
+	assert.Equal(t, "1:1", schedules[2].Id)
+	assert.Equal(t, "30 4 * * 1", schedules[2].Cron)
+}
+
+func TestGitHubProviderGetPipelineSchedule(t *testing.T) {
+	server := httptest.NewServer(newSchedulesTestMux(t))
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+	settings := krci.GitServerSettings{Token: "test-token"}
+
+	schedule, err := provider.GetPipelineSchedule(context.Background(), "owner/repo", "1:1", settings)
+	require.NoError(t, err)
+	assert.Equal(t, "30 4 * * 1", schedule.Cron)
+
+	_, err = provider.GetPipelineSchedule(context.Background(), "owner/repo", "1:2", settings)
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+
+	for _, id := range []string{"1", "x:0", "1:x", "1:-1"} {
+		_, err = provider.GetPipelineSchedule(context.Background(), "owner/repo", id, settings)
+		assert.ErrorIs(t, err, gferrors.ErrBadRequest, id)
+	}
+}
@@ -0,0 +1,234 @@
+package github
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v72/github"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// ListCommitStatuses returns the latest commit status of each context of a commit, followed by
+// the latest check run of each name. Check runs include those of GitHub Actions jobs.
+func (g *GitHubProvider) ListCommitStatuses(
+	ctx context.Context,
+	project string,
+	sha string,
+	settings krci.GitServerSettings,
+) ([]models.CommitStatus, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.CommitStatus, 0)
+
+	opts := &github.ListOptions{PerPage: 100}
+
+	for {
+		combined, resp, err := client.Repositories.GetCombinedStatus(ctx, owner, repo, sha, opts)
+		if err != nil {
+			return nil, commitStatusesError(err, project, sha)
+		}
+
+		for _, s := range combined.Statuses {
+			result = append(result, mapGitHubRepoStatus(s))
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		opts.Page = resp.NextPage
+	}
+
+	checkOpts := &github.ListCheckRunsOptions{
+		Filter:      github.Ptr("latest"),
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+
+	for {
+		checkRuns, resp, err := client.Checks.ListCheckRunsForRef(ctx, owner, repo, sha, checkOpts)
+		if err != nil {
+			return nil, commitStatusesError(err, project, sha)
+		}
+
+		for _, run := range checkRuns.CheckRuns {
+			result = append(result, mapGitHubCheckRun(run))
+		}
+
+		if resp.NextPage == 0 {
+			break
+		}
+
+		checkOpts.Page = resp.NextPage
+	}
+
+	return result, nil
+}
+
+// CreateCommitStatus writes a commit status. GitHub has no running status, so it is written as
+// pending. Neither has it a cancelled one: its error state reads back as failed, so cancelled is
+// rejected rather than silently reported as a failure.
+func (g *GitHubProvider) CreateCommitStatus(
+	ctx context.Context,
+	project string,
+	sha string,
+	settings krci.GitServerSettings,
+	opts models.CommitStatusOptions,
+) (*models.CommitStatus, error) {
+	owner, repo, err := common.SplitProject(project)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := mapCommitStateToGitHub(opts.State)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := g.newClient(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	created, _, err := client.Repositories.CreateStatus(ctx, owner, repo, sha, &github.RepoStatus{
+		State:       &state,
+		Context:     &opts.Context,
+		Description: opts.Description,
+		TargetURL:   opts.TargetURL,
+	})
+	if err != nil {
+		if sentinel := classifyGitHubError(err); sentinel != nil {
+			return nil, fmt.Errorf("project %s or commit %s: %w", project, sha, sentinel)
+		}
+
+		return nil, fmt.Errorf("failed to create status of commit %s for %s: %w", sha, project, err)
+	}
+
+	status := mapGitHubRepoStatus(created)
+
+	return &status, nil
+}
+
+// mapCommitStateToGitHub maps a unified commit state to the state of a GitHub commit status.
+func mapCommitStateToGitHub(state models.CommitState) (string, error) {
+	switch state {
+	case models.CommitStatePending, models.CommitStateRunning:
+		return "pending", nil
+	case models.CommitStateSuccess:
+		return ghConclusionSuccess, nil
+	case models.CommitStateFailed:
+		return "failure", nil
+	default:
+		return "", fmt.Errorf("commit state %q cannot be written to GitHub: %w", state, gferrors.ErrBadRequest)
+	}
+}
+
+// commitStatusesError maps an error reading the statuses of a commit to a GitFusion sentinel
+// error where one applies; GitHub answers 422 for an unknown commit.
+func commitStatusesError(err error, project, sha string) error {
+	if sentinel := classifyGitHubError(err); sentinel != nil {
+		return fmt.Errorf("project %s or commit %s: %w", project, sha, sentinel)
+	}
+
+	return fmt.Errorf("failed to list statuses of commit %s for %s: %w", sha, project, err)
+}
+
+// mapGitHubRepoStatus converts a GitHub commit status to the unified CommitStatus model.
+func mapGitHubRepoStatus(s *github.RepoStatus) models.CommitStatus {
+	status := models.CommitStatus{
+		Kind:        models.CommitStatusKindStatus,
+		Context:     s.GetContext(),
+		State:       normalizeGitHubStatusState(s.GetState()),
+		Description: s.Description,
+		TargetUrl:   s.TargetURL,
+	}
+
+	if s.CreatedAt != nil {
+		status.CreatedAt = &s.CreatedAt.Time
+	}
+
+	if s.UpdatedAt != nil {
+		status.UpdatedAt = &s.UpdatedAt.Time
+	}
+
+	return status
+}
+
+// mapGitHubCheckRun converts a GitHub check run to the unified CommitStatus model, taking the
+// output title for the description and the start and completion for the creation and update.
+func mapGitHubCheckRun(run *github.CheckRun) models.CommitStatus {
+	status := models.CommitStatus{
+		Kind:    models.CommitStatusKindCheckRun,
+		Context: run.GetName(),
+		State:   normalizeGitHubCheckRunState(run.GetStatus(), run.GetConclusion()),
+	}
+
+	if title := run.GetOutput().GetTitle(); title != "" {
+		status.Description = &title
+	}
+
+	targetURL := run.GetDetailsURL()
+	if targetURL == "" {
+		targetURL = run.GetHTMLURL()
+	}
+
+	if targetURL != "" {
+		status.TargetUrl = &targetURL
+	}
+
+	if run.StartedAt != nil {
+		status.CreatedAt = &run.StartedAt.Time
+	}
+
+	if run.CompletedAt != nil {
+		status.UpdatedAt = &run.CompletedAt.Time
+	}
+
+	return status
+}
+
+// normalizeGitHubStatusState maps a GitHub commit status state to the unified commit state.
+func normalizeGitHubStatusState(state string) models.CommitState {
+	switch state {
+	case ghConclusionSuccess:
+		return models.CommitStateSuccess
+	case "failure", "error":
+		return models.CommitStateFailed
+	default:
+		return models.CommitStatePending
+	}
+}
+
+// normalizeGitHubCheckRunState maps the status and conclusion of a GitHub check run to the unified
+// commit state.
+func normalizeGitHubCheckRunState(status, conclusion string) models.CommitState {
+	switch status {
+	case "completed":
+	case "in_progress":
+		return models.CommitStateRunning
+	default:
+		return models.CommitStatePending
+	}
+
+	switch conclusion {
+	case ghConclusionSuccess:
+		return models.CommitStateSuccess
+	case ghConclusionCancelled:
+		return models.CommitStateCancelled
+	case ghConclusionSkipped, "neutral", "stale":
+		return models.CommitStateSkipped
+	default:
+		return models.CommitStateFailed
+	}
+}
@@ -0,0 +1,195 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-github/v72/github"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+func TestGitHubProviderListCommitStatuses(t *testing.T) {
+	var gotFilter string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /repos/owner/repo/commits/abc123/status", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.CombinedStatus{
+			State: ptr("failure"),
+			Statuses: []*github.RepoStatus{
+				{
+					Context:     ptr("ci/jenkins"),
+					State:       ptr("error"),
+					Description: ptr("Build errored"),
+					TargetURL:   ptr("https://jenkins.example.com/job/1"),
+					CreatedAt:   newTimestamp(mustParseTime("2024-01-15T10:00:00Z")),
+					UpdatedAt:   newTimestamp(mustParseTime("2024-01-15T10:05:00Z")),
+				},
+				{Context: ptr("security/scan"), State: ptr("pending")},
+			},
+		})
+	})
+	mux.HandleFunc("GET /repos/owner/repo/commits/abc123/check-runs", func(w http.ResponseWriter, r *http.Request) {
+		gotFilter = r.URL.Query().Get("filter")
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(github.ListCheckRunsResults{
+			Total: ptr(3),
+			CheckRuns: []*github.CheckRun{
+				{
+					Name:        ptr("build"),
+					Status:      ptr("completed"),
+					Conclusion:  ptr("success"),
+					DetailsURL:  ptr("https://github.com/owner/repo/actions/runs/1/job/2"),
+					Output:      &github.CheckRunOutput{Title: ptr("All good")},
+					StartedAt:   newTimestamp(mustParseTime("2024-01-15T10:00:00Z")),
+					CompletedAt: newTimestamp(mustParseTime("2024-01-15T10:03:00Z")),
+				},
+				{Name: ptr("lint"), Status: ptr("in_progress"), HTMLURL: ptr("https://github.com/owner/repo/runs/3")},
+				{Name: ptr("docs"), Status: ptr("completed"), Conclusion: ptr("neutral")},
+			},
+		})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+
+	statuses, err := provider.ListCommitStatuses(context.Background(), "owner/repo", "abc123",
+		krci.GitServerSettings{Token: "test-token"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "latest", gotFilter)
+	require.Len(t, statuses, 5)
+
+	assert.Equal(t, models.CommitStatusKindStatus, statuses[0].Kind)
+	assert.Equal(t, "ci/jenkins", statuses[0].Context)
+	assert.Equal(t, models.CommitStateFailed, statuses[0].State)
+	assert.Equal(t, "Build errored", *statuses[0].Description)
+	assert.Equal(t, "https://jenkins.example.com/job/1", *statuses[0].TargetUrl)
+	assert.Equal(t, mustParseTime("2024-01-15T10:05:00Z"), *statuses[0].UpdatedAt)
+	assert.Equal(t, models.CommitStatePending, statuses[1].State)
+
+	assert.Equal(t, models.CommitStatusKindCheckRun, statuses[2].Kind)
+	assert.Equal(t, "build", statuses[2].Context)
+	assert.Equal(t, models.CommitStateSuccess, statuses[2].State)
+	assert.Equal(t, "All good", *statuses[2].Description)
+	assert.Equal(t, "https://github.com/owner/repo/actions/runs/1/job/2", *statuses[2].TargetUrl)
+	assert.Equal(t, mustParseTime("2024-01-15T10:03:00Z"), *statuses[2].UpdatedAt)
+
+	assert.Equal(t, models.CommitStateRunning, statuses[3].State)
+	assert.Equal(t, "https://github.com/owner/repo/runs/3", *statuses[3].TargetUrl)
+	assert.Nil(t, statuses[3].Description)
+	assert.Equal(t, models.CommitStateSkipped, statuses[4].State)
+}
+
+func TestGitHubProviderListCommitStatusesUnknownCommit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		_ = json.NewEncoder(w).Encode(map[string]string{"message": "No commit found for SHA: nope"})
+	}))
+	defer server.Close()
+
+	provider := newTestProvider(server.URL)
+
+	_, err := provider.ListCommitStatuses(context.Background(), "owner/repo", "nope",
+		krci.GitServerSettings{Token: "test-token"})
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
+
+func TestNormalizeGitHubCheckRunState(t *testing.T) {
+	tests := []struct {
+		status     string
+		conclusion string
+		want       models.CommitState
+	}{
+		{status: "queued", want: models.CommitStatePending},
+		{status: "waiting", want: models.CommitStatePending},
+		{status: "in_progress", want: models.CommitStateRunning},
+		{status: "completed", conclusion: "success", want: models.CommitStateSuccess},
+		{status: "completed", conclusion: "failure", want: models.CommitStateFailed},
+		{status: "completed", conclusion: "timed_out", want: models.CommitStateFailed},
+		{status: "completed", conclusion: "action_required", want: models.CommitStateFailed},
+		{status: "completed", conclusion: "cancelled", want: models.CommitStateCancelled},
+		{status: "completed", conclusion: "skipped", want: models.CommitStateSkipped},
+		{status: "completed", conclusion: "stale", want: models.CommitStateSkipped},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status+"/"+tt.conclusion, func(t *testing.T) {
+			assert.Equal(t, tt.want, normalizeGitHubCheckRunState(tt.status, tt.conclusion))
+		})
+	}
+}
+
+func TestGitHubProviderCreateCommitStatus(t *testing.T) {
+	tests := []struct {
+		state     models.CommitState
+		wantState string
+	}{
+		{state: models.CommitStateRunning, wantState: "pending"},
+		{state: models.CommitStateSuccess, wantState: "success"},
+		{state: models.CommitStateFailed, wantState: "failure"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			var got github.RepoStatus
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("POST /repos/owner/repo/statuses/abc123", func(w http.ResponseWriter, r *http.Request) {
+				require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+
+				got.CreatedAt = newTimestamp(mustParseTime("2024-01-15T10:00:00Z"))
+
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusCreated)
+				_ = json.NewEncoder(w).Encode(got)
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			status, err := newTestProvider(server.URL).CreateCommitStatus(context.Background(), "owner/repo", "abc123",
+				krci.GitServerSettings{Token: "test-token"}, models.CommitStatusOptions{
+					State:       tt.state,
+					Context:     "tekton/build",
+					Description: ptr("Build"),
+					TargetURL:   ptr("https://tekton.example.com/runs/1"),
+				})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantState, got.GetState())
+			assert.Equal(t, "tekton/build", got.GetContext())
+			assert.Equal(t, "Build", got.GetDescription())
+			assert.Equal(t, "https://tekton.example.com/runs/1", got.GetTargetURL())
+			assert.Equal(t, models.CommitStatusKindStatus, status.Kind)
+			assert.Equal(t, "tekton/build", status.Context)
+			require.NotNil(t, status.CreatedAt)
+		})
+	}
+}
+
+func TestGitHubProviderCreateCommitStatusRejectsUnrepresentableStates(t *testing.T) {
+	for _, state := range []models.CommitState{models.CommitStateCancelled, models.CommitStateSkipped} {
+		status, err := newTestProvider("http://127.0.0.1:0").CreateCommitStatus(context.Background(), "owner/repo",
+			"abc123", krci.GitServerSettings{Token: "test-token"},
+			models.CommitStatusOptions{State: state, Context: "ci"})
+
+		require.Error(t, err, state)
+		assert.Nil(t, status)
+		assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+	}
+}
@@ -1,13 +1,20 @@
 package github
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"slices"
 	"testing"
 
 	"github.com/google/go-github/v72/github"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
 	"github.com/KubeRocketCI/gitfusion/pkg/xiter"
 )
 
@@ -97,3 +104,107 @@ func Test_filterProjectsByName(t *testing.T) {
 		})
 	}
 }
+
+func TestEnterpriseRootURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		serverURL string
+		want      string
+		wantErr   bool
+	}{
+		{name: "empty URL uses github.com", serverURL: "", want: ""},
+		{name: "public API uses github.com", serverURL: "https://api.github.com", want: ""},
+		{name: "enterprise API URL", serverURL: "https://ghe.example.com/api/v3", want: "https://ghe.example.com"},
+		{name: "enterprise root URL", serverURL: "https://ghe.example.com/", want: "https://ghe.example.com"},
+		{
+			name:      "port appended after the API suffix moves to the host",
+			serverURL: "http://ghe.example.com/api/v3:8080",
+			want:      "http://ghe.example.com:8080",
+		},
+		{
+			name:      "default https port is dropped",
+			serverURL: "https://ghe.example.com/api/v3:443",
+			want:      "https://ghe.example.com",
+		},
+		{name: "URL without scheme is rejected", serverURL: "ghe.example.com", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := enterpriseRootURL(tt.serverURL)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGitHubProviderNewClientEnterpriseURLs(t *testing.T) {
+	provider := NewGitHubProvider()
+
+	client, err := provider.newClient(krci.GitServerSettings{Url: "https://ghe.example.com/api/v3"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://ghe.example.com/api/v3/", client.BaseURL.String())
+	assert.Equal(t, "https://ghe.example.com/api/uploads/", client.UploadURL.String())
+
+	client, err = provider.newClient(krci.GitServerSettings{Url: "https://api.github.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://api.github.com/", client.BaseURL.String())
+}
+
+// TestGitHubProviderEnterpriseServer points the provider at an httptest stand-in for a GitHub
+// Enterprise Server and checks that repository, branch, pull request and Actions calls all go
+// to its /api/v3 endpoints.
+func TestGitHubProviderEnterpriseServer(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/repos/owner/repo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer ghe-token", r.Header.Get("Authorization"))
+		writeJSON(w, github.Repository{ID: ptr(int64(1)), Name: ptr("repo"), FullName: ptr("owner/repo")})
+	})
+	mux.HandleFunc("/api/v3/repos/owner/repo/branches", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, []*github.Branch{{Name: ptr("main")}})
+	})
+	mux.HandleFunc("/api/v3/repos/owner/repo/pulls", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, []*github.PullRequest{})
+	})
+	mux.HandleFunc("/api/v3/repos/owner/repo/actions/runs", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, github.WorkflowRuns{TotalCount: ptr(0)})
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	provider := NewGitHubProvider()
+	settings := krci.GitServerSettings{Url: server.URL + "/api/v3", Token: "ghe-token"}
+	ctx := context.Background()
+
+	repo, err := provider.GetRepository(ctx, "owner", "repo", settings)
+	require.NoError(t, err)
+	assert.Equal(t, "repo", repo.Name)
+
+	branches, err := provider.ListBranches(ctx, "owner", "repo", settings, models.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, branches, 1)
+	assert.Equal(t, "main", branches[0].Name)
+
+	prs, err := provider.ListPullRequests(ctx, "owner", "repo", settings,
+		models.PullRequestListOptions{State: "open", Page: 1, PerPage: 20})
+	require.NoError(t, err)
+	assert.Empty(t, prs.Data)
+
+	pipelines, err := provider.ListPipelines(ctx, "owner/repo", settings,
+		models.PipelineListOptions{Page: 1, PerPage: 20})
+	require.NoError(t, err)
+	assert.Empty(t, pipelines.Data)
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"log/slog"
 	"net/http"
 	"sort"
@@ -16,6 +15,7 @@ import (
 
 	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
 )
 
@@ -24,16 +24,6 @@ func newGitlabClient(settings krci.GitServerSettings) (*gitlab.Client, error) {
 	return gitlab.NewClient(settings.Token, gitlab.WithBaseURL(settings.Url))
 }
 
-// maxTraceBytes caps the job trace read to prevent OOM on runaway logs (4 MiB).
-const maxTraceBytes = 4 * 1024 * 1024
-
-// traceRequestTimeout bounds a single job-trace fetch. The read itself is capped at
-// maxTraceBytes, so this only guards against a hung or extremely slow connection.
-const traceRequestTimeout = 30 * time.Second
-
-// maxJobsTotal is the pagination cap for ListPipelineJobs (5 pages × 100).
-const maxJobsTotal = 500
-
 const (
 	glStateOpened = "opened"
 	glStateClosed = "closed"
@@ -195,24 +185,27 @@ func (g *GitlabProvider) ListBranches(
 func (g *GitlabProvider) TriggerPipeline(
 	ctx context.Context,
 	project string,
-	ref string,
-	variables []models.PipelineVariable,
 	settings krci.GitServerSettings,
+	opts models.PipelineTriggerOptions,
 ) (*models.PipelineResponse, error) {
+	// GitLab runs a single .gitlab-ci.yml per ref, so there is no named pipeline to select.
+	if opts.Pipeline != nil && *opts.Pipeline != "" {
+		return nil, fmt.Errorf("named pipelines are not supported for GitLab: %w", gferrors.ErrBadRequest)
+	}
+
 	client, err := newGitlabClient(settings)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
 	}
 
-	// Create pipeline
-	opts := &gitlab.CreatePipelineOptions{
-		Ref:       gitlab.Ptr(ref),
-		Variables: convertToPipelineVariables(variables),
-	}
+	ref := opts.Ref
 
 	pipeline, resp, err := client.Pipelines.CreatePipeline(
 		project,
-		opts,
+		&gitlab.CreatePipelineOptions{
+			Ref:       gitlab.Ptr(ref),
+			Variables: convertToPipelineVariables(opts.Variables),
+		},
 		gitlab.WithContext(ctx),
 	)
 
@@ -229,7 +222,7 @@ func (g *GitlabProvider) TriggerPipeline(
 	}
 
 	result := &models.PipelineResponse{
-		Id:     pipeline.ID,
+		Id:     strconv.Itoa(pipeline.ID),
 		WebUrl: pipeline.WebURL,
 		Status: pipeline.Status,
 		Ref:    pipeline.Ref,
@@ -241,7 +234,9 @@ func (g *GitlabProvider) TriggerPipeline(
 	return result, nil
 }
 
-// ListPipelines lists CI/CD pipelines for a GitLab project.
+// ListPipelines lists CI/CD pipelines for a GitLab project. Every filter maps to a GitLab query
+// parameter except the source "other", which is applied to each page after listing, so such pages
+// may hold fewer pipelines than requested.
 func (g *GitlabProvider) ListPipelines(
 	ctx context.Context,
 	project string,
@@ -271,6 +266,19 @@ func (g *GitlabProvider) ListPipelines(
 		}
 	}
 
+	if opts.Source != nil {
+		glOpts.Source = mapPipelineSourceToGitLab(*opts.Source)
+	}
+
+	glOpts.SHA = opts.Sha
+	glOpts.Username = opts.TriggeredBy
+	glOpts.UpdatedAfter = opts.UpdatedAfter
+	glOpts.UpdatedBefore = opts.UpdatedBefore
+
+	if opts.Ascending {
+		glOpts.Sort = gitlab.Ptr("asc")
+	}
+
 	pipelines, resp, err := client.Pipelines.ListProjectPipelines(
 		project,
 		glOpts,
@@ -293,32 +301,12 @@ func (g *GitlabProvider) ListPipelines(
 	result := make([]models.Pipeline, 0, len(pipelines))
 
 	for _, p := range pipelines {
-		var createdAt time.Time
-		if p.CreatedAt != nil {
-			createdAt = *p.CreatedAt
-		}
-
-		pipeline := models.Pipeline{
-			Id:        strconv.Itoa(p.ID),
-			Status:    normalizeGitLabPipelineStatus(p.Status),
-			Ref:       p.Ref,
-			Sha:       p.SHA,
-			WebUrl:    p.WebURL,
-			CreatedAt: createdAt,
-		}
+		pipeline := mapGitLabPipelineInfo(p)
 
-		if p.ProjectID != 0 {
-			projectID := strconv.Itoa(p.ProjectID)
-			pipeline.ProjectId = &projectID
-		}
-
-		if p.Source != "" {
-			source := normalizeGitLabPipelineSource(p.Source)
-			pipeline.Source = &source
-		}
-
-		if p.UpdatedAt != nil {
-			pipeline.UpdatedAt = p.UpdatedAt
+		// Sources with no GitLab counterpart are filtered here; see mapPipelineSourceToGitLab.
+		if opts.Source != nil && glOpts.Source == nil &&
+			(pipeline.Source == nil || string(*pipeline.Source) != *opts.Source) {
+			continue
 		}
 
 		result = append(result, pipeline)
@@ -334,19 +322,78 @@ func (g *GitlabProvider) ListPipelines(
 	}, nil
 }
 
+// mapGitLabPipelineInfo converts the pipeline summary GitLab returns in lists and for
+// downstream pipelines to the unified Pipeline model.
+func mapGitLabPipelineInfo(p *gitlab.PipelineInfo) models.Pipeline {
+	var createdAt time.Time
+	if p.CreatedAt != nil {
+		createdAt = *p.CreatedAt
+	}
+
+	pipeline := models.Pipeline{
+		Id:        strconv.Itoa(p.ID),
+		Status:    normalizeGitLabPipelineStatus(p.Status),
+		Ref:       p.Ref,
+		Sha:       p.SHA,
+		WebUrl:    p.WebURL,
+		CreatedAt: createdAt,
+	}
+
+	if p.ProjectID != 0 {
+		projectID := strconv.Itoa(p.ProjectID)
+		pipeline.ProjectId = &projectID
+	}
+
+	if p.Source != "" {
+		source := normalizeGitLabPipelineSource(p.Source)
+		pipeline.Source = &source
+	}
+
+	if p.UpdatedAt != nil {
+		pipeline.UpdatedAt = p.UpdatedAt
+	}
+
+	return pipeline
+}
+
 // ListPipelineJobs lists the jobs of a GitLab CI pipeline, ordered by job ID ascending.
-// It fetches up to maxJobsTotal jobs using autopagination; a warning is logged if the cap is hit.
+// It fetches up to common.MaxJobsTotal jobs using autopagination; a warning is logged if the cap is hit.
 func (g *GitlabProvider) ListPipelineJobs(
 	ctx context.Context,
 	project string,
-	pipelineID int,
+	rawPipelineID string,
 	settings krci.GitServerSettings,
 ) ([]models.PipelineJob, error) {
+	pipelineID, err := parseGitLabID("pipeline", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
 	client, err := newGitlabClient(settings)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
 	}
 
+	rawJobs, err := listGitLabPipelineJobs(ctx, client, project, pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]models.PipelineJob, 0, len(rawJobs))
+	for _, j := range rawJobs {
+		result = append(result, mapGitLabJob(j))
+	}
+
+	return result, nil
+}
+
+// listGitLabPipelineJobs returns up to common.MaxJobsTotal jobs of a pipeline in ascending job ID order.
+func listGitLabPipelineJobs(
+	ctx context.Context,
+	client *gitlab.Client,
+	project string,
+	pipelineID int,
+) ([]*gitlab.Job, error) {
 	it := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Job, *gitlab.Response, error) {
 		return client.Jobs.ListPipelineJobs(
 			project,
@@ -357,7 +404,7 @@ func (g *GitlabProvider) ListPipelineJobs(
 		)
 	})
 
-	rawJobs := make([]*gitlab.Job, 0, maxJobsTotal)
+	rawJobs := make([]*gitlab.Job, 0, common.MaxJobsTotal)
 
 	for j, err := range it {
 		if err != nil {
@@ -366,11 +413,11 @@ func (g *GitlabProvider) ListPipelineJobs(
 
 		rawJobs = append(rawJobs, j)
 
-		if len(rawJobs) >= maxJobsTotal {
-			slog.Warn("ListPipelineJobs reached pagination cap; some jobs may be omitted",
+		if len(rawJobs) >= common.MaxJobsTotal {
+			slog.Warn("Pipeline jobs list reached pagination cap; some jobs may be omitted",
 				"project", project,
 				"pipelineID", pipelineID,
-				"cap", maxJobsTotal,
+				"cap", common.MaxJobsTotal,
 			)
 
 			break
@@ -384,30 +431,48 @@ func (g *GitlabProvider) ListPipelineJobs(
 		return rawJobs[i].ID < rawJobs[k].ID
 	})
 
-	result := make([]models.PipelineJob, 0, len(rawJobs))
-	for _, j := range rawJobs {
-		result = append(result, mapGitLabJob(j))
-	}
-
-	return result, nil
+	return rawJobs, nil
 }
 
 // GetJobTrace returns the raw trace (log) text of a GitLab CI job and whether it was
-// truncated to maxTraceBytes.
+// truncated to common.MaxTraceBytes.
 //
 // We deliberately bypass go-gitlab's Jobs.GetTraceFile: that helper buffers the entire
 // response into memory (bytes.Buffer) before returning a reader, so an io.LimitReader
 // over its result would cap only the copied string, not the allocation — a multi-hundred-MB
 // log would still be fully resident. Instead we issue the documented
 // GET /projects/:id/jobs/:job_id/trace request and stream resp.Body through an
-// io.LimitReader, so at most maxTraceBytes+1 bytes are ever read off the socket. The extra
+// io.LimitReader, so at most common.MaxTraceBytes+1 bytes are ever read off the socket. The extra
 // byte lets us detect truncation authoritatively rather than inferring it from length.
 func (g *GitlabProvider) GetJobTrace(
 	ctx context.Context,
 	project string,
-	jobID int,
+	rawJobID string,
 	settings krci.GitServerSettings,
 ) (string, bool, error) {
+	jobID, err := parseGitLabID("job", rawJobID)
+	if err != nil {
+		return "", false, err
+	}
+
+	trace, err := readGitLabTrace(ctx, project, jobID, settings, models.JobTraceWindow{})
+	if err != nil {
+		return "", false, err
+	}
+
+	return trace.Content, trace.Truncated, nil
+}
+
+// readGitLabTrace reads window w of a job trace, at most common.MaxTraceBytes of it. Windows other than
+// the whole trace are requested with a Range header; a server that ignores it answers with the
+// whole trace, which is then windowed as it streams.
+func readGitLabTrace(
+	ctx context.Context,
+	project string,
+	jobID int,
+	settings krci.GitServerSettings,
+	w models.JobTraceWindow,
+) (*models.JobTrace, error) {
 	apiURL := fmt.Sprintf("%s/api/v4/projects/%s/jobs/%d/trace",
 		strings.TrimRight(settings.Url, "/"),
 		gitlab.PathEscape(project),
@@ -416,37 +481,46 @@ func (g *GitlabProvider) GetJobTrace(
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
 	if err != nil {
-		return "", false, fmt.Errorf("failed to build job trace request for %s job %d: %w", project, jobID, err)
+		return nil, fmt.Errorf("failed to build job trace request for %s job %d: %w", project, jobID, err)
 	}
 
 	req.Header.Set("PRIVATE-TOKEN", settings.Token)
 
-	httpClient := &http.Client{Timeout: traceRequestTimeout}
+	if rng := common.TraceRangeHeader(w, common.MaxTraceBytes); rng != "" {
+		req.Header.Set("Range", rng)
+	}
+
+	httpClient := &http.Client{Timeout: common.TraceRequestTimeout}
 
 	resp, err := httpClient.Do(req)
 	if err != nil {
-		return "", false, fmt.Errorf("failed to fetch job trace for %s job %d: %w", project, jobID, err)
+		return nil, fmt.Errorf("failed to fetch job trace for %s job %d: %w", project, jobID, err)
 	}
 
 	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode != http.StatusOK {
-		return "", false, mapGitLabTraceStatus(resp.StatusCode, project, jobID)
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusPartialContent, http.StatusRequestedRangeNotSatisfiable:
+	default:
+		return nil, mapGitLabTraceStatus(resp.StatusCode, project, jobID)
 	}
 
-	// resp.Body streams, so the LimitReader stops the socket read at the cap; a runaway
-	// log never fully allocates. We intentionally do not drain the remainder on truncation
-	// (closing the body aborts the connection instead of downloading the rest).
-	data, err := io.ReadAll(io.LimitReader(resp.Body, maxTraceBytes+1))
+	trace, err := common.ReadTraceWindow(resp, w, common.MaxTraceBytes)
 	if err != nil {
-		return "", false, fmt.Errorf("failed to read job trace for %s job %d: %w", project, jobID, err)
+		return nil, fmt.Errorf("failed to read job trace for %s job %d: %w", project, jobID, err)
 	}
 
-	if len(data) > maxTraceBytes {
-		return string(data[:maxTraceBytes]), true, nil
+	return trace, nil
+}
+
+// parseGitLabID parses a numeric GitLab pipeline or job ID received as an opaque string.
+func parseGitLabID(kind, id string) (int, error) {
+	n, err := strconv.Atoi(id)
+	if err != nil {
+		return 0, fmt.Errorf("%s ID %q must be a numeric value: %w", kind, id, gferrors.ErrBadRequest)
 	}
 
-	return string(data), false, nil
+	return n, nil
 }
 
 // mapGitLabTraceStatus maps a job-trace HTTP status to a GitFusion sentinel error.
@@ -470,6 +544,11 @@ func mapGitLabJob(j *gitlab.Job) models.PipelineJob {
 		Status: j.Status,
 	}
 
+	if j.Pipeline.ID != 0 {
+		pipelineID := strconv.Itoa(j.Pipeline.ID)
+		job.PipelineId = &pipelineID
+	}
+
 	if j.Ref != "" {
 		ref := j.Ref
 		job.Ref = &ref
@@ -564,6 +643,33 @@ func normalizeGitLabPipelineSource(source string) models.PipelineSource {
 	}
 }
 
+// mapPipelineSourceToGitLab maps a unified source filter to a GitLab pipeline source, or nil for
+// "other", which covers many GitLab sources and is filtered after listing instead.
+// NOTE: GitLab filters by a single source, so unified sources normalized from several GitLab
+// sources (see normalizeGitLabPipelineSource) query the most common one:
+//   - "manual" queries web (misses chat)
+//   - "trigger" queries trigger (misses pipeline and api)
+func mapPipelineSourceToGitLab(source string) *string {
+	var v string
+
+	switch source {
+	case "push":
+		v = "push"
+	case "merge_request":
+		v = "merge_request_event"
+	case "schedule":
+		v = "schedule"
+	case "manual":
+		v = "web"
+	case "trigger":
+		v = "trigger"
+	default:
+		return nil
+	}
+
+	return &v
+}
+
 // mapPipelineStatusToGitLab maps the unified status filter to a GitLab BuildStateValue.
 func mapPipelineStatusToGitLab(status string) *gitlab.BuildStateValue {
 	var v gitlab.BuildStateValue
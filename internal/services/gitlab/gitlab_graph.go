@@ -0,0 +1,259 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// maxGraphPipelines caps the number of pipelines one graph resolves, bounding the requests it
+// costs when bridges fan out; bridges past the cap are left unresolved like those past the depth.
+const maxGraphPipelines = 50
+
+// GetPipelineGraph returns a GitLab pipeline with its jobs and bridges, following each bridge to
+// the child or multi-project pipeline it started, up to depth levels below the root. A downstream
+// pipeline in a project the token cannot read keeps the summary its bridge reports, without jobs.
+func (g *GitlabProvider) GetPipelineGraph(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+	depth int,
+) (*models.PipelineGraphNode, error) {
+	pipelineID, err := parseGitLabID("pipeline", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	p, _, err := client.Pipelines.GetPipeline(project, pipelineID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabJobsError(err, project, pipelineID)
+	}
+
+	root := &models.PipelineGraphNode{
+		Kind:     models.Root,
+		Project:  project,
+		Pipeline: mapGitLabPipelineDetail(p),
+	}
+
+	b := &gitLabGraphBuilder{
+		client:    client,
+		basePath:  gitLabBasePath(settings.Url),
+		depth:     depth,
+		remaining: maxGraphPipelines - 1,
+	}
+
+	if err := b.resolve(ctx, root, project, pipelineID, p.ProjectID, 0); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// gitLabGraphBuilder resolves the bridges of a pipeline graph depth-first.
+type gitLabGraphBuilder struct {
+	client    *gitlab.Client
+	basePath  string // Path of the GitLab instance URL, stripped from web URLs to get project paths
+	depth     int
+	remaining int // Pipelines still allowed below the root; see maxGraphPipelines
+}
+
+// resolve fills in the jobs and children of node, whose pipeline pipelineID belongs to project
+// (a path or numeric ID) with the numeric ID projectID, at the given level below the root.
+func (b *gitLabGraphBuilder) resolve(
+	ctx context.Context,
+	node *models.PipelineGraphNode,
+	project string,
+	pipelineID, projectID, level int,
+) error {
+	jobs, err := listGitLabPipelineJobs(ctx, b.client, project, pipelineID)
+	if err != nil {
+		return err
+	}
+
+	bridges, err := listGitLabPipelineBridges(ctx, b.client, project, pipelineID)
+	if err != nil {
+		return err
+	}
+
+	node.Jobs = mergeGitLabJobsAndBridges(jobs, bridges)
+	node.Children = make([]models.PipelineGraphNode, 0)
+
+	for _, bridge := range bridges {
+		downstream := bridge.DownstreamPipeline
+		if downstream == nil || downstream.ID == 0 {
+			continue
+		}
+
+		if level >= b.depth || b.remaining == 0 {
+			node.Truncated = true
+
+			continue
+		}
+
+		b.remaining--
+
+		triggerJobID := strconv.Itoa(bridge.ID)
+		pipeline := mapGitLabPipelineInfo(downstream)
+
+		child := models.PipelineGraphNode{
+			Kind:         models.Child,
+			Project:      project,
+			Name:         &bridge.Name,
+			TriggerJobId: &triggerJobID,
+			Pipeline:     &pipeline,
+		}
+
+		childProject := project
+		if downstream.ProjectID != projectID {
+			child.Kind = models.MultiProject
+			child.Project = gitLabProjectPath(downstream.WebURL, b.basePath, downstream.ProjectID)
+			childProject = strconv.Itoa(downstream.ProjectID)
+		}
+
+		err := b.resolve(ctx, &child, childProject, downstream.ID, downstream.ProjectID, level+1)
+		if err != nil {
+			if !errors.Is(err, gferrors.ErrNotFound) && !errors.Is(err, gferrors.ErrUnauthorized) {
+				return err
+			}
+
+			slog.Warn("Failed to read downstream pipeline; leaving its jobs out",
+				"project", child.Project,
+				"pipelineID", downstream.ID,
+				"error", err,
+			)
+
+			child.Jobs = make([]models.PipelineJob, 0)
+			child.Children = make([]models.PipelineGraphNode, 0)
+		}
+
+		node.Children = append(node.Children, child)
+	}
+
+	return nil
+}
+
+// listGitLabPipelineBridges returns up to common.MaxJobsTotal bridge jobs of a pipeline in ascending
+// job ID order.
+func listGitLabPipelineBridges(
+	ctx context.Context,
+	client *gitlab.Client,
+	project string,
+	pipelineID int,
+) ([]*gitlab.Bridge, error) {
+	it := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.Bridge, *gitlab.Response, error) {
+		return client.Jobs.ListPipelineBridges(
+			project,
+			pipelineID,
+			&gitlab.ListJobsOptions{ListOptions: gitlab.ListOptions{PerPage: 100}},
+			gitlab.WithContext(ctx),
+			p,
+		)
+	})
+
+	bridges := make([]*gitlab.Bridge, 0)
+
+	for bridge, err := range it {
+		if err != nil {
+			return nil, mapGitLabJobsError(err, project, pipelineID)
+		}
+
+		bridges = append(bridges, bridge)
+
+		if len(bridges) >= common.MaxJobsTotal {
+			break
+		}
+	}
+
+	sort.SliceStable(bridges, func(i, k int) bool {
+		return bridges[i].ID < bridges[k].ID
+	})
+
+	return bridges, nil
+}
+
+// mergeGitLabJobsAndBridges maps the jobs and bridges of a pipeline into one list in ascending
+// job ID order, the order GitLab created them in.
+func mergeGitLabJobsAndBridges(jobs []*gitlab.Job, bridges []*gitlab.Bridge) []models.PipelineJob {
+	all := make([]*gitlab.Job, 0, len(jobs)+len(bridges))
+	all = append(all, jobs...)
+
+	for _, bridge := range bridges {
+		all = append(all, bridgeAsGitLabJob(bridge))
+	}
+
+	sort.SliceStable(all, func(i, k int) bool {
+		return all[i].ID < all[k].ID
+	})
+
+	result := make([]models.PipelineJob, 0, len(all))
+	for _, j := range all {
+		result = append(result, mapGitLabJob(j))
+	}
+
+	return result
+}
+
+// bridgeAsGitLabJob copies the fields a bridge shares with a job, so bridges map like jobs.
+func bridgeAsGitLabJob(b *gitlab.Bridge) *gitlab.Job {
+	j := &gitlab.Job{
+		ID:            b.ID,
+		Name:          b.Name,
+		Stage:         b.Stage,
+		Status:        b.Status,
+		Ref:           b.Ref,
+		WebURL:        b.WebURL,
+		AllowFailure:  b.AllowFailure,
+		Duration:      b.Duration,
+		CreatedAt:     b.CreatedAt,
+		StartedAt:     b.StartedAt,
+		FinishedAt:    b.FinishedAt,
+		FailureReason: b.FailureReason,
+	}
+	j.Pipeline.ID = b.Pipeline.ID
+
+	return j
+}
+
+// gitLabBasePath returns the path of a GitLab instance URL, e.g. "/gitlab" for an instance
+// served under https://example.com/gitlab, or "" when it is served at the root.
+func gitLabBasePath(instanceURL string) string {
+	u, err := url.Parse(instanceURL)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimRight(u.Path, "/")
+}
+
+// gitLabProjectPath derives a project path from the web URL of one of its pipelines, such as
+// https://gitlab.example.com/group/app/-/pipelines/7. It falls back to the numeric project ID
+// when the URL has no such form.
+func gitLabProjectPath(webURL, basePath string, projectID int) string {
+	u, err := url.Parse(webURL)
+	if err == nil {
+		path, _, found := strings.Cut(strings.TrimPrefix(u.Path, basePath), "/-/")
+		if path = strings.Trim(path, "/"); found && path != "" {
+			return path
+		}
+	}
+
+	return strconv.Itoa(projectID)
+}
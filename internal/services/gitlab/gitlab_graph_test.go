@@ -0,0 +1,135 @@
+package gitlab
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// newGraphTestServer serves pipeline 5 of owner/repo (project 1), which has a build job, a bridge
+// to child pipeline 6 and a bridge to pipeline 7 of project 2, which the token cannot read.
+// Child pipeline 6 has a test job and a bridge to pipeline 8.
+func newGraphTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	writeJSON := func(body string) http.HandlerFunc {
+		return func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(body))
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipelines/5", writeJSON(`{"id": 5, "project_id": 1,
+		"status": "running", "ref": "main", "sha": "abc", "web_url": "https://gitlab.example.com/owner/repo/-/pipelines/5"}`))
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipelines/5/jobs", writeJSON(`[
+		{"id": 10, "name": "build", "stage": "build", "status": "success", "pipeline": {"id": 5}}]`))
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipelines/5/bridges", writeJSON(`[
+		{"id": 12, "name": "deploy", "stage": "deploy", "status": "success", "pipeline": {"id": 5},
+			"downstream_pipeline": {"id": 7, "project_id": 2, "status": "failed", "ref": "main",
+				"web_url": "https://gitlab.example.com/group/deploy/-/pipelines/7"}},
+		{"id": 11, "name": "child", "stage": "test", "status": "running", "pipeline": {"id": 5},
+			"downstream_pipeline": {"id": 6, "project_id": 1, "status": "running", "ref": "main",
+				"web_url": "https://gitlab.example.com/owner/repo/-/pipelines/6"}}]`))
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipelines/6/jobs", writeJSON(`[
+		{"id": 20, "name": "test", "stage": "test", "status": "running", "pipeline": {"id": 6}}]`))
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipelines/6/bridges", writeJSON(`[
+		{"id": 21, "name": "grandchild", "stage": "test", "status": "success", "pipeline": {"id": 6},
+			"downstream_pipeline": {"id": 8, "project_id": 1, "status": "success"}}]`))
+	mux.HandleFunc("GET /api/v4/projects/2/pipelines/7/jobs", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"message": "403 Forbidden"}`))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestGitLabProviderGetPipelineGraph(t *testing.T) {
+	server := newGraphTestServer(t)
+	defer server.Close()
+
+	graph, err := NewGitlabProvider().GetPipelineGraph(
+		context.Background(),
+		"owner/repo",
+		"5",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		1,
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.Root, graph.Kind)
+	require.NotNil(t, graph.Pipeline)
+	assert.Equal(t, "5", graph.Pipeline.Id)
+	assert.False(t, graph.Truncated)
+
+	require.Len(t, graph.Jobs, 3, "bridges are listed among the jobs")
+	assert.Equal(t, []string{"build", "child", "deploy"},
+		[]string{graph.Jobs[0].Name, graph.Jobs[1].Name, graph.Jobs[2].Name})
+
+	require.Len(t, graph.Children, 2)
+
+	child := graph.Children[0]
+	assert.Equal(t, models.Child, child.Kind)
+	assert.Equal(t, "owner/repo", child.Project)
+	assert.Equal(t, "child", *child.Name)
+	assert.Equal(t, "11", *child.TriggerJobId)
+	assert.Equal(t, "6", child.Pipeline.Id)
+	require.Len(t, child.Jobs, 2)
+	assert.Empty(t, child.Children)
+	assert.True(t, child.Truncated, "pipeline 8 lies past the depth limit")
+
+	multi := graph.Children[1]
+	assert.Equal(t, models.MultiProject, multi.Kind)
+	assert.Equal(t, "group/deploy", multi.Project)
+	assert.Equal(t, models.PipelineStatusFailed, multi.Pipeline.Status)
+	assert.Empty(t, multi.Jobs, "the token cannot read the downstream project")
+}
+
+func TestGitLabProviderGetPipelineGraphDepthZero(t *testing.T) {
+	server := newGraphTestServer(t)
+	defer server.Close()
+
+	graph, err := NewGitlabProvider().GetPipelineGraph(
+		context.Background(),
+		"owner/repo",
+		"5",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		0,
+	)
+
+	require.NoError(t, err)
+	assert.Empty(t, graph.Children)
+	assert.True(t, graph.Truncated)
+	assert.Len(t, graph.Jobs, 3)
+}
+
+func TestGitLabProviderGetPipelineGraphNotFound(t *testing.T) {
+	server := newGraphTestServer(t)
+	defer server.Close()
+
+	_, err := NewGitlabProvider().GetPipelineGraph(
+		context.Background(),
+		"owner/repo",
+		"9",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		2,
+	)
+
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+func TestGitLabProjectPath(t *testing.T) {
+	assert.Equal(t, "group/app", gitLabProjectPath("https://gitlab.example.com/group/app/-/pipelines/7", "", 3))
+	assert.Equal(t, "group/app", gitLabProjectPath("https://example.com/gitlab/group/app/-/pipelines/7",
+		gitLabBasePath("https://example.com/gitlab/"), 3))
+	assert.Equal(t, "3", gitLabProjectPath("", "", 3))
+}
@@ -0,0 +1,718 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// GetPipeline returns a single GitLab pipeline with its duration, queued duration, triggering
+// user and coverage. The commit title needs a second request; a failed commit lookup (e.g. a
+// commit gone after a force push) only leaves the title out.
+func (g *GitlabProvider) GetPipeline(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+) (*models.Pipeline, error) {
+	pipelineID, err := parseGitLabID("pipeline", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	p, _, err := client.Pipelines.GetPipeline(project, pipelineID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabJobsError(err, project, pipelineID)
+	}
+
+	result := mapGitLabPipelineDetail(p)
+
+	commit, _, err := client.Commits.GetCommit(project, p.SHA, nil, gitlab.WithContext(ctx))
+	if err != nil {
+		slog.Warn("Failed to get pipeline commit title",
+			"project", project,
+			"pipelineID", pipelineID,
+			"sha", p.SHA,
+			"error", err,
+		)
+	} else if commit.Title != "" {
+		result.CommitTitle = &commit.Title
+	}
+
+	return result, nil
+}
+
+// mapGitLabPipelineDetail converts a single GitLab pipeline to the unified Pipeline model.
+func mapGitLabPipelineDetail(p *gitlab.Pipeline) *models.Pipeline {
+	result := &models.Pipeline{
+		Id:        strconv.Itoa(p.ID),
+		Status:    normalizeGitLabPipelineStatus(p.Status),
+		Ref:       p.Ref,
+		Sha:       p.SHA,
+		WebUrl:    p.WebURL,
+		UpdatedAt: p.UpdatedAt,
+	}
+
+	if p.CreatedAt != nil {
+		result.CreatedAt = *p.CreatedAt
+	}
+
+	if p.ProjectID != 0 {
+		projectID := strconv.Itoa(p.ProjectID)
+		result.ProjectId = &projectID
+	}
+
+	if p.Source != "" {
+		source := normalizeGitLabPipelineSource(string(p.Source))
+		result.Source = &source
+	}
+
+	// GitLab reports the duration only once the pipeline has finished.
+	if p.FinishedAt != nil {
+		duration := float32(p.Duration)
+		result.Duration = &duration
+	}
+
+	if p.QueuedDuration != 0 {
+		queued := float32(p.QueuedDuration)
+		result.QueuedDuration = &queued
+	}
+
+	if p.User != nil {
+		result.TriggeredBy = &models.Owner{
+			Id:   strconv.Itoa(p.User.ID),
+			Name: p.User.Username,
+		}
+
+		if p.User.AvatarURL != "" {
+			result.TriggeredBy.AvatarUrl = &p.User.AvatarURL
+		}
+	}
+
+	if coverage, err := strconv.ParseFloat(p.Coverage, 32); err == nil {
+		c := float32(coverage)
+		result.Coverage = &c
+	}
+
+	return result
+}
+
+// ListPullRequestPipelines returns the pipelines GitLab associates with a merge request, given by
+// its IID, newest first.
+func (g *GitlabProvider) ListPullRequestPipelines(
+	ctx context.Context,
+	project string,
+	pullRequest int,
+	settings krci.GitServerSettings,
+	opts models.PipelineListOptions,
+) (*models.PipelinesResponse, error) {
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	// MergeRequests.ListMergeRequestPipelines takes no list options, so the request is built here
+	// with them.
+	req, err := client.NewRequest(
+		http.MethodGet,
+		fmt.Sprintf("projects/%s/merge_requests/%d/pipelines", gitlab.PathEscape(project), pullRequest),
+		&gitlab.ListOptions{Page: opts.Page, PerPage: opts.PerPage},
+		[]gitlab.RequestOptionFunc{gitlab.WithContext(ctx)},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create merge request pipelines request: %w", err)
+	}
+
+	var pipelines []*gitlab.PipelineInfo
+
+	resp, err := client.Do(req, &pipelines)
+	if err != nil {
+		if errors.Is(err, gitlab.ErrNotFound) || (resp != nil && resp.StatusCode == http.StatusNotFound) {
+			return nil, fmt.Errorf("project %s or merge request %d: %w", project, pullRequest, gferrors.ErrNotFound)
+		}
+
+		if resp != nil && (resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden) {
+			return nil, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+		}
+
+		return nil, fmt.Errorf("failed to list pipelines of merge request %d for %s: %w", pullRequest, project, err)
+	}
+
+	result := make([]models.Pipeline, 0, len(pipelines))
+	for _, p := range pipelines {
+		result = append(result, mapGitLabPipelineInfo(p))
+	}
+
+	return &models.PipelinesResponse{
+		Data: result,
+		Pagination: models.Pagination{
+			Total:   resp.TotalItems,
+			Page:    &opts.Page,
+			PerPage: &opts.PerPage,
+		},
+	}, nil
+}
+
+// CancelPipeline cancels a GitLab pipeline and all of its running and pending jobs.
+// Cancelling a finished pipeline is a no-op on GitLab's side and reports its current status.
+func (g *GitlabProvider) CancelPipeline(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineActionResponse, error) {
+	pipelineID, err := parseGitLabID("pipeline", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	pipeline, resp, err := client.Pipelines.CancelPipelineBuild(project, pipelineID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabPipelineActionError(err, resp, "cancel", project, pipelineID)
+	}
+
+	return gitLabPipelineActionResponse(pipeline, models.PipelineActionCancel), nil
+}
+
+// RetryPipeline retries the failed and cancelled jobs of a GitLab pipeline. GitLab has no
+// whole-pipeline re-run, so opts.FailedOnly does not change the behavior.
+func (g *GitlabProvider) RetryPipeline(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+	_ models.PipelineRetryOptions,
+) (*models.PipelineActionResponse, error) {
+	pipelineID, err := parseGitLabID("pipeline", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	pipeline, resp, err := client.Pipelines.RetryPipelineBuild(project, pipelineID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabPipelineActionError(err, resp, "retry", project, pipelineID)
+	}
+
+	return gitLabPipelineActionResponse(pipeline, models.PipelineActionRetry), nil
+}
+
+func gitLabPipelineActionResponse(
+	pipeline *gitlab.Pipeline,
+	action models.PipelineActionResponseAction,
+) *models.PipelineActionResponse {
+	status := string(normalizeGitLabPipelineStatus(pipeline.Status))
+
+	result := &models.PipelineActionResponse{
+		PipelineId: strconv.Itoa(pipeline.ID),
+		Action:     action,
+		Status:     &status,
+	}
+
+	if pipeline.WebURL != "" {
+		result.WebUrl = &pipeline.WebURL
+	}
+
+	return result
+}
+
+// mapGitLabPipelineActionError maps a failed cancel/retry call to a GitFusion sentinel error.
+// GitLab answers 400/422 when the pipeline is in a state that does not allow the action.
+func mapGitLabPipelineActionError(err error, resp *gitlab.Response, action, project string, id int) error {
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+
+	switch {
+	case errors.Is(err, gitlab.ErrNotFound) || statusCode == http.StatusNotFound:
+		return fmt.Errorf("project %s or pipeline %d: %w", project, id, gferrors.ErrNotFound)
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case statusCode == http.StatusBadRequest || statusCode == http.StatusUnprocessableEntity:
+		return fmt.Errorf("%s pipeline %d for %s: %v: %w", action, id, project, err, gferrors.ErrBadRequest)
+	default:
+		return fmt.Errorf("failed to %s pipeline %d for %s: %w", action, id, project, err)
+	}
+}
+
+// PlayJob starts a GitLab manual job, passing opts.Variables as job variables.
+func (g *GitlabProvider) PlayJob(
+	ctx context.Context,
+	project string,
+	rawJobID string,
+	settings krci.GitServerSettings,
+	opts models.PipelineJobPlayOptions,
+) (*models.PipelineJob, error) {
+	jobID, err := parseGitLabID("job", rawJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	job, resp, err := client.Jobs.PlayJob(project, jobID, &gitlab.PlayJobOptions{
+		JobVariablesAttributes: convertToJobVariables(opts.Variables),
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabJobActionError(err, resp, "play", project, jobID)
+	}
+
+	result := mapGitLabJob(job)
+
+	return &result, nil
+}
+
+// RetryJob retries a finished GitLab job. GitLab retries by creating a new job, which is returned.
+func (g *GitlabProvider) RetryJob(
+	ctx context.Context,
+	project string,
+	rawJobID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineJob, error) {
+	jobID, err := parseGitLabID("job", rawJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	job, resp, err := client.Jobs.RetryJob(project, jobID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabJobActionError(err, resp, "retry", project, jobID)
+	}
+
+	result := mapGitLabJob(job)
+
+	return &result, nil
+}
+
+// CancelJob cancels a pending or running GitLab job.
+func (g *GitlabProvider) CancelJob(
+	ctx context.Context,
+	project string,
+	rawJobID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineJob, error) {
+	jobID, err := parseGitLabID("job", rawJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	job, resp, err := client.Jobs.CancelJob(project, jobID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabJobActionError(err, resp, "cancel", project, jobID)
+	}
+
+	result := mapGitLabJob(job)
+
+	return &result, nil
+}
+
+// GetJobTraceRange reads a GitLab job trace from offset on, at most common.MaxTraceBytes per call. The job
+// status is read before the trace, so once it is terminal the trace read after it is complete.
+func (g *GitlabProvider) GetJobTraceRange(
+	ctx context.Context,
+	project string,
+	rawJobID string,
+	settings krci.GitServerSettings,
+	offset int64,
+) (*models.JobTraceRange, error) {
+	jobID, err := parseGitLabID("job", rawJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	job, _, err := client.Jobs.GetJob(project, jobID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabJobsError(err, project, jobID)
+	}
+
+	trace, err := readGitLabTrace(ctx, project, jobID, settings, models.JobTraceWindow{Offset: offset})
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.JobTraceRange{
+		Content:   trace.Content,
+		Status:    job.Status,
+		Truncated: trace.Truncated,
+	}, nil
+}
+
+// GetJobTraceWindow reads a window of a GitLab job trace with a Range request, so any part of a
+// trace larger than common.MaxTraceBytes can be reached.
+func (g *GitlabProvider) GetJobTraceWindow(
+	ctx context.Context,
+	project string,
+	rawJobID string,
+	settings krci.GitServerSettings,
+	w models.JobTraceWindow,
+) (*models.JobTrace, error) {
+	jobID, err := parseGitLabID("job", rawJobID)
+	if err != nil {
+		return nil, err
+	}
+
+	return readGitLabTrace(ctx, project, jobID, settings, w)
+}
+
+// mapGitLabJobActionError maps a failed play/retry/cancel job call to a GitFusion sentinel error.
+// GitLab answers 400 for a job that is not playable and 403 for one that is not retryable or
+// cancelable; the latter cannot be told apart from missing permissions and maps to unauthorized.
+func mapGitLabJobActionError(err error, resp *gitlab.Response, action, project string, id int) error {
+	statusCode := 0
+	if resp != nil {
+		statusCode = resp.StatusCode
+	}
+
+	switch {
+	case errors.Is(err, gitlab.ErrNotFound) || statusCode == http.StatusNotFound:
+		return fmt.Errorf("project %s or job %d: %w", project, id, gferrors.ErrNotFound)
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return fmt.Errorf("%s job %d for %s is not allowed: %w", action, id, project, gferrors.ErrUnauthorized)
+	case statusCode == http.StatusBadRequest || statusCode == http.StatusUnprocessableEntity:
+		return fmt.Errorf("%s job %d for %s: %v: %w", action, id, project, err, gferrors.ErrBadRequest)
+	default:
+		return fmt.Errorf("failed to %s job %d for %s: %w", action, id, project, err)
+	}
+}
+
+// convertToJobVariables maps pipeline variables to GitLab job variables for a manual job.
+func convertToJobVariables(variables []models.PipelineVariable) *[]*gitlab.JobVariableOptions {
+	if len(variables) == 0 {
+		return nil
+	}
+
+	vars := make([]*gitlab.JobVariableOptions, len(variables))
+	for i, v := range variables {
+		vars[i] = &gitlab.JobVariableOptions{
+			Key:   gitlab.Ptr(v.Key),
+			Value: gitlab.Ptr(v.Value),
+		}
+
+		if v.VariableType != nil {
+			varType := gitlab.VariableTypeValue(*v.VariableType)
+			vars[i].VariableType = &varType
+		}
+	}
+
+	return &vars
+}
+
+// GetPipelineTestReport returns the test report GitLab builds from the JUnit reports of a
+// pipeline's jobs, one suite per job. The pipeline status is read before the report, so once it is
+// terminal the report is final.
+func (g *GitlabProvider) GetPipelineTestReport(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineTestReport, error) {
+	pipelineID, err := parseGitLabID("pipeline", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	p, _, err := client.Pipelines.GetPipeline(project, pipelineID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabJobsError(err, project, pipelineID)
+	}
+
+	report, _, err := client.Pipelines.GetPipelineTestReport(project, pipelineID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabJobsError(err, project, pipelineID)
+	}
+
+	suites := make([]models.PipelineTestSuite, 0, len(report.TestSuites))
+	for _, s := range report.TestSuites {
+		suites = append(suites, mapGitLabTestSuite(s))
+	}
+
+	return &models.PipelineTestReport{
+		PipelineId:     rawPipelineID,
+		PipelineStatus: string(normalizeGitLabPipelineStatus(p.Status)),
+		Totals: models.PipelineTestTotals{
+			Count:    report.TotalCount,
+			Success:  report.SuccessCount,
+			Failed:   report.FailedCount,
+			Skipped:  report.SkippedCount,
+			Error:    report.ErrorCount,
+			Duration: report.TotalTime,
+		},
+		Suites: suites,
+	}, nil
+}
+
+// mapGitLabTestSuite converts a GitLab test suite, keeping the totals GitLab counted.
+func mapGitLabTestSuite(s *gitlab.PipelineTestSuites) models.PipelineTestSuite {
+	cases := make([]models.PipelineTestCase, 0, len(s.TestCases))
+	for _, c := range s.TestCases {
+		cases = append(cases, mapGitLabTestCase(c))
+	}
+
+	return models.PipelineTestSuite{
+		Name: s.Name,
+		Totals: models.PipelineTestTotals{
+			Count:    s.TotalCount,
+			Success:  s.SuccessCount,
+			Failed:   s.FailedCount,
+			Skipped:  s.SkippedCount,
+			Error:    s.ErrorCount,
+			Duration: s.TotalTime,
+		},
+		Cases: cases,
+	}
+}
+
+// mapGitLabTestCase converts a GitLab test case. GitLab keeps the text of a case's <failure> or
+// <error> element in system_output, so for a case that did not pass its first line becomes the
+// message and, unless stack_trace is set, the whole text the stack trace.
+func mapGitLabTestCase(c *gitlab.PipelineTestCases) models.PipelineTestCase {
+	tc := models.PipelineTestCase{
+		Name:     c.Name,
+		Duration: &c.ExecutionTime,
+	}
+
+	switch c.Status {
+	case "failed":
+		tc.Status = models.PipelineTestCaseStatusFailed
+	case "error":
+		tc.Status = models.PipelineTestCaseStatusError
+	case "skipped":
+		tc.Status = models.PipelineTestCaseStatusSkipped
+	default:
+		tc.Status = models.PipelineTestCaseStatusSuccess
+	}
+
+	if c.Classname != "" {
+		tc.Classname = &c.Classname
+	}
+
+	if c.File != "" {
+		tc.File = &c.File
+	}
+
+	output, _ := c.SystemOutput.(string)
+	output = strings.TrimSpace(output)
+
+	if tc.Status == models.PipelineTestCaseStatusSuccess {
+		if output != "" {
+			tc.SystemOutput = &output
+		}
+
+		return tc
+	}
+
+	details := strings.TrimSpace(c.StackTrace)
+	if details == "" {
+		details = output
+	}
+
+	if output != "" {
+		message, _, _ := strings.Cut(output, "\n")
+		tc.Message = &message
+	}
+
+	if details != "" {
+		tc.StackTrace = &details
+	}
+
+	return tc
+}
+
+// ListPipelineArtifacts lists the artifact archives of a GitLab pipeline's jobs, or of the one job
+// opts selects. GitLab keeps one archive per job, so the job ID is the artifact ID. Jobs without
+// an archive are left out.
+func (g *GitlabProvider) ListPipelineArtifacts(
+	ctx context.Context,
+	project string,
+	rawPipelineID string,
+	settings krci.GitServerSettings,
+	opts models.PipelineArtifactListOptions,
+) ([]models.PipelineArtifact, error) {
+	pipelineID, err := parseGitLabID("pipeline", rawPipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	jobs, err := listGitLabPipelineJobs(ctx, client, project, pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := make([]models.PipelineArtifact, 0)
+
+	for _, j := range jobs {
+		if j.ArtifactsFile.Filename == "" {
+			continue
+		}
+
+		if opts.JobID != nil && strconv.Itoa(j.ID) != *opts.JobID {
+			continue
+		}
+
+		result = append(result, mapGitLabArtifact(j, now))
+	}
+
+	return result, nil
+}
+
+// mapGitLabArtifact converts the artifact archive of a job; now decides whether it has expired.
+func mapGitLabArtifact(j *gitlab.Job, now time.Time) models.PipelineArtifact {
+	jobID := strconv.Itoa(j.ID)
+	jobName := j.Name
+	size := int64(j.ArtifactsFile.Size)
+
+	return models.PipelineArtifact{
+		Id:        jobID,
+		Name:      j.ArtifactsFile.Filename,
+		Size:      &size,
+		JobId:     &jobID,
+		JobName:   &jobName,
+		CreatedAt: j.FinishedAt,
+		ExpiresAt: j.ArtifactsExpireAt,
+		Expired:   j.ArtifactsExpireAt != nil && j.ArtifactsExpireAt.Before(now),
+		Scope:     models.PipelineArtifactScopeJob,
+	}
+}
+
+// DownloadArtifact opens the artifact archive of a GitLab job. Like GetJobTrace it issues the
+// documented GET /projects/:id/jobs/:job_id/artifacts request itself, because go-gitlab buffers
+// the whole archive. The request has no timeout, as archives may be large; it ends with ctx.
+func (g *GitlabProvider) DownloadArtifact(
+	ctx context.Context,
+	project string,
+	artifactID string,
+	settings krci.GitServerSettings,
+) (*models.ArtifactDownload, error) {
+	jobID, err := parseGitLabID("artifact", artifactID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiURL := fmt.Sprintf("%s/api/v4/projects/%s/jobs/%d/artifacts",
+		strings.TrimRight(settings.Url, "/"),
+		gitlab.PathEscape(project),
+		jobID,
+	)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, apiURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build artifact request for %s job %d: %w", project, jobID, err)
+	}
+
+	req.Header.Set("PRIVATE-TOKEN", settings.Token)
+
+	httpClient := &http.Client{CheckRedirect: dropTokenOnRedirect}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download artifacts of %s job %d: %w", project, jobID, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		_ = resp.Body.Close()
+
+		switch resp.StatusCode {
+		case http.StatusNotFound:
+			return nil, fmt.Errorf("project %s or artifacts of job %d: %w", project, jobID, gferrors.ErrNotFound)
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return nil, fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+		default:
+			return nil, fmt.Errorf("gitlab artifact download failed for %s job %d: status %d",
+				project, jobID, resp.StatusCode)
+		}
+	}
+
+	return &models.ArtifactDownload{
+		Body:        resp.Body,
+		Name:        artifactFileName(resp.Header.Get("Content-Disposition"), jobID),
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+	}, nil
+}
+
+// maxDownloadRedirects matches the redirect limit of net/http's default policy.
+const maxDownloadRedirects = 10
+
+// dropTokenOnRedirect keeps the GitLab token from following a redirect to another host, such as
+// the object storage GitLab hands artifact downloads to. net/http only strips standard
+// credential headers on such redirects, not PRIVATE-TOKEN.
+func dropTokenOnRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxDownloadRedirects {
+		return fmt.Errorf("stopped after %d redirects", maxDownloadRedirects)
+	}
+
+	if req.URL.Host != via[0].URL.Host {
+		req.Header.Del("PRIVATE-TOKEN")
+	}
+
+	return nil
+}
+
+// artifactFileName returns the file name GitLab sent in contentDisposition, or a name built from
+// the job ID when there is none.
+func artifactFileName(contentDisposition string, jobID int) string {
+	if _, params, err := mime.ParseMediaType(contentDisposition); err == nil {
+		if name := path.Base(params["filename"]); name != "" && name != "." && name != "/" {
+			return name
+		}
+	}
+
+	return fmt.Sprintf("job-%d-artifacts.zip", jobID)
+}
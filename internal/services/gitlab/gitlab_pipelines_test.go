@@ -3,17 +3,21 @@ package gitlab
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
 )
 
 func TestNormalizeGitLabPipelineStatus(t *testing.T) {
@@ -273,6 +277,77 @@ func TestGitLabProviderListPipelinesFilterParams(t *testing.T) {
 	assert.Equal(t, "100", result.Data[0].Id)
 }
 
+func TestGitLabProviderListPipelinesCommitUserAndDateFilters(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/owner%2Frepo/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		assert.Equal(t, "abc123", q.Get("sha"))
+		assert.Equal(t, "merge_request_event", q.Get("source"))
+		assert.Equal(t, "jdoe", q.Get("username"))
+		assert.Equal(t, "2026-01-01T00:00:00Z", q.Get("updated_after"))
+		assert.Equal(t, "2026-01-31T00:00:00Z", q.Get("updated_before"))
+		assert.Equal(t, "asc", q.Get("sort"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": 100, "status": "success", "source": "merge_request_event",
+			"ref": "main", "sha": "abc123", "created_at": "2026-01-15T10:30:00.000Z"}]`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	sha, source, user := "abc123", "merge_request", "jdoe"
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	before := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+
+	result, err := NewGitlabProvider().ListPipelines(
+		context.Background(),
+		"owner/repo",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.PipelineListOptions{
+			Sha:           &sha,
+			Source:        &source,
+			TriggeredBy:   &user,
+			UpdatedAfter:  &after,
+			UpdatedBefore: &before,
+			Ascending:     true,
+			Page:          1,
+			PerPage:       20,
+		},
+	)
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+}
+
+func TestGitLabProviderListPipelinesOtherSourceFiltersPage(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/owner%2Frepo/pipelines", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.Query().Get("source"))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"id": 101, "status": "success", "source": "push", "created_at": "2026-01-15T10:30:00.000Z"},
+			{"id": 100, "status": "success", "source": "external", "created_at": "2026-01-15T10:00:00.000Z"}]`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	source := "other"
+
+	result, err := NewGitlabProvider().ListPipelines(
+		context.Background(),
+		"owner/repo",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.PipelineListOptions{Source: &source, Page: 1, PerPage: 20},
+	)
+
+	require.NoError(t, err)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, "100", result.Data[0].Id)
+}
+
 func TestGitLabProviderListPipelinesNotFound(t *testing.T) {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/api/v4/projects/nonexistent%2Fproject/pipelines", func(w http.ResponseWriter, r *http.Request) {
@@ -346,7 +421,7 @@ func TestGitLabProviderListPipelineJobsAscendingNumericOrder(t *testing.T) {
 	result, err := provider.ListPipelineJobs(
 		context.Background(),
 		"owner/repo",
-		42,
+		"42",
 		krci.GitServerSettings{Token: "test-token", Url: server.URL},
 	)
 
@@ -391,7 +466,7 @@ func TestGitLabProviderListPipelineJobsFieldMapping(t *testing.T) {
 	result, err := provider.ListPipelineJobs(
 		context.Background(),
 		"owner/repo",
-		1,
+		"1",
 		krci.GitServerSettings{Token: "test-token", Url: server.URL},
 	)
 
@@ -432,7 +507,7 @@ func TestGitLabProviderListPipelineJobsNotFound(t *testing.T) {
 	result, err := provider.ListPipelineJobs(
 		context.Background(),
 		"owner/repo",
-		999,
+		"999",
 		krci.GitServerSettings{Token: "test-token", Url: server.URL},
 	)
 
@@ -461,7 +536,7 @@ func TestGitLabProviderGetJobTrace(t *testing.T) {
 	result, truncated, err := provider.GetJobTrace(
 		context.Background(),
 		"owner/repo",
-		7,
+		"7",
 		krci.GitServerSettings{Token: "test-token", Url: server.URL},
 	)
 
@@ -486,7 +561,7 @@ func TestGitLabProviderGetJobTraceNotFound(t *testing.T) {
 	result, truncated, err := provider.GetJobTrace(
 		context.Background(),
 		"owner/repo",
-		404,
+		"404",
 		krci.GitServerSettings{Token: "test-token", Url: server.URL},
 	)
 
@@ -515,7 +590,7 @@ func TestGitLabProviderGetJobTraceSetsPrivateTokenHeader(t *testing.T) {
 	_, _, err := provider.GetJobTrace(
 		context.Background(),
 		"owner/repo",
-		7,
+		"7",
 		krci.GitServerSettings{Token: "secret-token", Url: server.URL},
 	)
 
@@ -525,8 +600,8 @@ func TestGitLabProviderGetJobTraceSetsPrivateTokenHeader(t *testing.T) {
 
 func TestGitLabProviderGetJobTraceTruncated(t *testing.T) {
 	// Serve a log larger than the cap to exercise the OOM guard: the result must be
-	// exactly maxTraceBytes and flagged truncated.
-	largeTrace := strings.Repeat("a", maxTraceBytes+1024)
+	// exactly common.MaxTraceBytes and flagged truncated.
+	largeTrace := strings.Repeat("a", common.MaxTraceBytes+1024)
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/api/v4/projects/owner%2Frepo/jobs/7/trace", func(w http.ResponseWriter, r *http.Request) {
@@ -543,13 +618,87 @@ func TestGitLabProviderGetJobTraceTruncated(t *testing.T) {
 	result, truncated, err := provider.GetJobTrace(
 		context.Background(),
 		"owner/repo",
-		7,
+		"7",
 		krci.GitServerSettings{Token: "test-token", Url: server.URL},
 	)
 
 	require.NoError(t, err)
 	assert.True(t, truncated)
-	assert.Len(t, result, maxTraceBytes)
+	assert.Len(t, result, common.MaxTraceBytes)
+}
+
+// --- GetJobTraceRange tests ---
+
+func TestGitLabProviderGetJobTraceRange(t *testing.T) {
+	trace := "Running with gitlab-runner...\nJob succeeded\n"
+
+	tests := []struct {
+		name        string
+		offset      int64
+		honorRange  bool
+		wantRange   string
+		wantContent string
+	}{
+		{name: "from the start", offset: 0, honorRange: true, wantContent: trace},
+		{
+			name: "partial content", offset: 30, honorRange: true,
+			wantRange: "bytes=30-4194333", wantContent: "Job succeeded\n",
+		},
+		{
+			name: "range ignored", offset: 30, honorRange: false,
+			wantRange: "bytes=30-4194333", wantContent: "Job succeeded\n",
+		},
+		{name: "nothing new", offset: 44, honorRange: true, wantRange: "bytes=44-4194347"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mux := http.NewServeMux()
+			mux.HandleFunc("/api/v4/projects/owner%2Frepo/jobs/7", func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"id": 7, "name": "build", "status": "running"}`))
+			})
+			mux.HandleFunc("/api/v4/projects/owner%2Frepo/jobs/7/trace", func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, tt.wantRange, r.Header.Get("Range"))
+
+				if !tt.honorRange {
+					_, _ = w.Write([]byte(trace))
+
+					return
+				}
+
+				http.ServeContent(w, r, "", time.Time{}, strings.NewReader(trace))
+			})
+
+			server := httptest.NewServer(mux)
+			defer server.Close()
+
+			result, err := NewGitlabProvider().GetJobTraceRange(context.Background(), "owner/repo", "7",
+				krci.GitServerSettings{Token: "test-token", Url: server.URL}, tt.offset)
+
+			require.NoError(t, err)
+			assert.Equal(t, "running", result.Status)
+			assert.Equal(t, tt.wantContent, result.Content)
+			assert.False(t, result.Truncated)
+		})
+	}
+}
+
+func TestGitLabProviderGetJobTraceRangeJobNotFound(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/owner%2Frepo/jobs/404", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message":"404 Job Not Found"}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	result, err := NewGitlabProvider().GetJobTraceRange(context.Background(), "owner/repo", "404",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL}, 0)
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
 }
 
 func TestGitLabProviderGetJobTraceUnauthorized(t *testing.T) {
@@ -567,7 +716,7 @@ func TestGitLabProviderGetJobTraceUnauthorized(t *testing.T) {
 	result, truncated, err := provider.GetJobTrace(
 		context.Background(),
 		"owner/repo",
-		7,
+		"7",
 		krci.GitServerSettings{Token: "bad-token", Url: server.URL},
 	)
 
@@ -576,3 +725,513 @@ func TestGitLabProviderGetJobTraceUnauthorized(t *testing.T) {
 	assert.False(t, truncated)
 	assert.True(t, errors.Is(err, gferrors.ErrUnauthorized), "expected ErrUnauthorized, got: %v", err)
 }
+
+func TestGitLabProviderNonNumericIDs(t *testing.T) {
+	provider := NewGitlabProvider()
+	settings := krci.GitServerSettings{Token: "test-token", Url: "http://127.0.0.1:0"}
+
+	jobs, err := provider.ListPipelineJobs(context.Background(), "owner/repo", "abc", settings)
+	require.Error(t, err)
+	assert.Nil(t, jobs)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+
+	_, _, err = provider.GetJobTrace(context.Background(), "owner/repo", "pipe-1/step-1", settings)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
+
+// --- CancelPipeline / RetryPipeline tests ---
+
+func TestGitLabProviderGetPipeline(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipelines/100", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{
+			"id": 100,
+			"project_id": 42,
+			"status": "failed",
+			"source": "web",
+			"ref": "main",
+			"sha": "abc123",
+			"web_url": "https://gitlab.com/owner/repo/-/pipelines/100",
+			"created_at": "2026-01-15T10:30:00.000Z",
+			"finished_at": "2026-01-15T10:40:00.000Z",
+			"duration": 540,
+			"queued_duration": 60,
+			"coverage": "87.5",
+			"user": {"id": 7, "username": "jdoe", "avatar_url": "https://gitlab.com/jdoe.png"}
+		}`))
+	})
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/repository/commits/abc123",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id": "abc123", "title": "Fix the build"}`))
+		},
+	)
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	p, err := NewGitlabProvider().GetPipeline(context.Background(), "owner/repo", "100",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL})
+
+	require.NoError(t, err)
+	assert.Equal(t, "100", p.Id)
+	assert.Equal(t, models.PipelineStatusFailed, p.Status)
+	require.NotNil(t, p.Source)
+	assert.Equal(t, models.PipelineSourceManual, *p.Source)
+	require.NotNil(t, p.Duration)
+	assert.InDelta(t, 540, *p.Duration, 0)
+	require.NotNil(t, p.QueuedDuration)
+	assert.InDelta(t, 60, *p.QueuedDuration, 0)
+	require.NotNil(t, p.Coverage)
+	assert.InDelta(t, 87.5, *p.Coverage, 0.001)
+	require.NotNil(t, p.TriggeredBy)
+	assert.Equal(t, "jdoe", p.TriggeredBy.Name)
+	require.NotNil(t, p.CommitTitle)
+	assert.Equal(t, "Fix the build", *p.CommitTitle)
+}
+
+func TestGitLabProviderGetPipelineWithoutCommit(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipelines/100", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 100, "status": "running", "ref": "main", "sha": "gone"}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	p, err := NewGitlabProvider().GetPipeline(context.Background(), "owner/repo", "100",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL})
+
+	require.NoError(t, err, "a missing commit only leaves the title out")
+	assert.Equal(t, models.PipelineStatusRunning, p.Status)
+	assert.Nil(t, p.CommitTitle)
+	assert.Nil(t, p.Duration, "running pipelines have no duration yet")
+	assert.Nil(t, p.Coverage)
+}
+
+func TestGitLabProviderGetPipelineNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "404 Not found"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewGitlabProvider().GetPipeline(context.Background(), "owner/repo", "100",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL})
+
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+func TestGitLabProviderGetPipelineTestReport(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipelines/100", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 100, "status": "failed", "ref": "main", "sha": "abc123"}`))
+	})
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipelines/100/test_report",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{
+				"total_time": 3.5, "total_count": 2, "success_count": 1, "failed_count": 1,
+				"skipped_count": 0, "error_count": 0,
+				"test_suites": [{
+					"name": "unit", "total_time": 3.5, "total_count": 2, "success_count": 1,
+					"failed_count": 1, "skipped_count": 0, "error_count": 0,
+					"test_cases": [
+						{"status": "success", "name": "TestOK", "classname": "pkg", "execution_time": 0.5},
+						{"status": "failed", "name": "TestBad", "classname": "pkg", "file": "pkg/bad_test.go",
+						 "execution_time": 3, "system_output": "expected 1, got 2\nbad_test.go:10"}
+					]
+				}]
+			}`))
+		},
+	)
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	report, err := NewGitlabProvider().GetPipelineTestReport(context.Background(), "owner/repo", "100",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL})
+	require.NoError(t, err)
+
+	assert.Equal(t, "100", report.PipelineId)
+	assert.Equal(t, string(models.PipelineStatusFailed), report.PipelineStatus)
+	assert.Equal(t, models.PipelineTestTotals{Count: 2, Success: 1, Failed: 1, Duration: 3.5}, report.Totals)
+	require.Len(t, report.Suites, 1)
+	assert.Equal(t, "unit", report.Suites[0].Name)
+	require.Len(t, report.Suites[0].Cases, 2)
+
+	passed := report.Suites[0].Cases[0]
+	assert.Equal(t, models.PipelineTestCaseStatusSuccess, passed.Status)
+	assert.Nil(t, passed.Message)
+
+	failed := report.Suites[0].Cases[1]
+	assert.Equal(t, models.PipelineTestCaseStatusFailed, failed.Status)
+	require.NotNil(t, failed.Message)
+	assert.Equal(t, "expected 1, got 2", *failed.Message)
+	require.NotNil(t, failed.StackTrace)
+	assert.Equal(t, "expected 1, got 2\nbad_test.go:10", *failed.StackTrace)
+	require.NotNil(t, failed.File)
+	assert.Equal(t, "pkg/bad_test.go", *failed.File)
+}
+
+func TestGitLabProviderGetPipelineTestReportNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "404 Not found"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewGitlabProvider().GetPipelineTestReport(context.Background(), "owner/repo", "100",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL})
+
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+func TestGitLabProviderListPipelineArtifacts(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipelines/100/jobs", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[
+			{"id": 12, "name": "test", "artifacts_file": {"filename": "artifacts.zip", "size": 2048},
+			 "artifacts_expire_at": "2000-01-01T00:00:00Z"},
+			{"id": 11, "name": "build", "artifacts_file": {"filename": "artifacts.zip", "size": 1024}},
+			{"id": 13, "name": "lint"}
+		]`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	settings := krci.GitServerSettings{Token: "test-token", Url: server.URL}
+
+	artifacts, err := NewGitlabProvider().ListPipelineArtifacts(context.Background(), "owner/repo", "100",
+		settings, models.PipelineArtifactListOptions{})
+	require.NoError(t, err)
+	require.Len(t, artifacts, 2)
+
+	assert.Equal(t, "11", artifacts[0].Id)
+	assert.Equal(t, "artifacts.zip", artifacts[0].Name)
+	require.NotNil(t, artifacts[0].JobName)
+	assert.Equal(t, "build", *artifacts[0].JobName)
+	require.NotNil(t, artifacts[0].Size)
+	assert.Equal(t, int64(1024), *artifacts[0].Size)
+	assert.False(t, artifacts[0].Expired)
+
+	assert.Equal(t, models.PipelineArtifactScopeJob, artifacts[0].Scope)
+
+	assert.Equal(t, "12", artifacts[1].Id)
+	assert.True(t, artifacts[1].Expired)
+
+	artifacts, err = NewGitlabProvider().ListPipelineArtifacts(context.Background(), "owner/repo", "100",
+		settings, models.PipelineArtifactListOptions{JobID: pointer.To("12")})
+	require.NoError(t, err)
+	require.Len(t, artifacts, 1)
+	assert.Equal(t, "12", artifacts[0].Id)
+}
+
+func TestGitLabProviderDownloadArtifact(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/jobs/12/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "test-token", r.Header.Get("PRIVATE-TOKEN"))
+		w.Header().Set("Content-Type", "application/zip")
+		w.Header().Set("Content-Disposition", `attachment; filename="artifacts.zip"`)
+		_, _ = w.Write([]byte("zip-bytes"))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	download, err := NewGitlabProvider().DownloadArtifact(context.Background(), "owner/repo", "12",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL})
+	require.NoError(t, err)
+
+	defer func() { _ = download.Body.Close() }()
+
+	content, err := io.ReadAll(download.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "zip-bytes", string(content))
+	assert.Equal(t, "artifacts.zip", download.Name)
+	assert.Equal(t, int64(9), download.Size)
+	assert.Equal(t, "application/zip", download.ContentType)
+}
+
+func TestGitLabProviderDownloadArtifactRedirectDropsToken(t *testing.T) {
+	storage := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("PRIVATE-TOKEN"), "the storage download must not carry the token")
+		_, _ = w.Write([]byte("zip-bytes"))
+	}))
+	defer storage.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, storage.URL+"/artifacts.zip", http.StatusFound)
+	}))
+	defer server.Close()
+
+	download, err := NewGitlabProvider().DownloadArtifact(context.Background(), "owner/repo", "12",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL})
+	require.NoError(t, err)
+
+	_ = download.Body.Close()
+
+	assert.Equal(t, "job-12-artifacts.zip", download.Name)
+}
+
+func TestGitLabProviderDownloadArtifactErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "missing archive", status: http.StatusNotFound, wantErr: gferrors.ErrNotFound},
+		{name: "forbidden", status: http.StatusForbidden, wantErr: gferrors.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			_, err := NewGitlabProvider().DownloadArtifact(context.Background(), "owner/repo", "12",
+				krci.GitServerSettings{Token: "test-token", Url: server.URL})
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+
+	t.Run("non-numeric ID", func(t *testing.T) {
+		_, err := NewGitlabProvider().DownloadArtifact(context.Background(), "owner/repo", "abc",
+			krci.GitServerSettings{Token: "test-token"})
+		assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+	})
+}
+
+func TestGitLabProviderListPullRequestPipelines(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/merge_requests/7/pipelines",
+		func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "2", r.URL.Query().Get("page"))
+			assert.Equal(t, "10", r.URL.Query().Get("per_page"))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.Header().Set("X-Total", "11")
+			_, _ = w.Write([]byte(`[{"id": 100, "status": "running", "source": "merge_request_event",
+				"ref": "refs/merge-requests/7/head", "sha": "abc123", "created_at": "2026-01-15T10:30:00.000Z"}]`))
+		})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	result, err := NewGitlabProvider().ListPullRequestPipelines(
+		context.Background(),
+		"owner/repo",
+		7,
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.PipelineListOptions{Page: 2, PerPage: 10},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, 11, result.Pagination.Total)
+	require.Len(t, result.Data, 1)
+	assert.Equal(t, "100", result.Data[0].Id)
+	assert.Equal(t, models.PipelineSourceMergeRequest, *result.Data[0].Source)
+}
+
+func TestGitLabProviderListPullRequestPipelinesNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"message": "404 Not found"}`))
+	}))
+	defer server.Close()
+
+	_, err := NewGitlabProvider().ListPullRequestPipelines(
+		context.Background(),
+		"owner/repo",
+		7,
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.PipelineListOptions{Page: 1, PerPage: 20},
+	)
+
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+func TestGitLabProviderCancelPipeline(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/owner%2Frepo/pipelines/12/cancel", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 12, "status": "canceled", "web_url": "https://gitlab.com/owner/repo/-/pipelines/12"}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	result, err := NewGitlabProvider().CancelPipeline(
+		context.Background(),
+		"owner/repo",
+		"12",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "12", result.PipelineId)
+	assert.Equal(t, models.PipelineActionCancel, result.Action)
+	require.NotNil(t, result.Status)
+	assert.Equal(t, string(models.PipelineStatusCancelled), *result.Status)
+	require.NotNil(t, result.WebUrl)
+	assert.Equal(t, "https://gitlab.com/owner/repo/-/pipelines/12", *result.WebUrl)
+}
+
+func TestGitLabProviderRetryPipeline(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/owner%2Frepo/pipelines/12/retry", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 12, "status": "pending"}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	result, err := NewGitlabProvider().RetryPipeline(
+		context.Background(),
+		"owner/repo",
+		"12",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.PipelineRetryOptions{FailedOnly: true},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, models.PipelineActionRetry, result.Action)
+	require.NotNil(t, result.Status)
+	assert.Equal(t, string(models.PipelineStatusPending), *result.Status)
+	assert.Nil(t, result.WebUrl)
+}
+
+func TestGitLabProviderPipelineActionErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   error
+	}{
+		{name: "not found", status: http.StatusNotFound, want: gferrors.ErrNotFound},
+		{name: "forbidden", status: http.StatusForbidden, want: gferrors.ErrUnauthorized},
+		{name: "not retryable", status: http.StatusBadRequest, want: gferrors.ErrBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"message":"error"}`))
+			}))
+			defer server.Close()
+
+			result, err := NewGitlabProvider().RetryPipeline(
+				context.Background(),
+				"owner/repo",
+				"12",
+				krci.GitServerSettings{Token: "test-token", Url: server.URL},
+				models.PipelineRetryOptions{},
+			)
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+
+	_, err := NewGitlabProvider().CancelPipeline(
+		context.Background(), "owner/repo", "abc", krci.GitServerSettings{Url: "http://127.0.0.1:0"},
+	)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
+
+// --- Job action tests ---
+
+func TestGitLabProviderPlayJob(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v4/projects/owner%2Frepo/jobs/9/play", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"job_variables_attributes":[{"key":"ENV","value":"prod"}]}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 9, "name": "deploy", "stage": "deploy", "status": "pending",
+			"pipeline": {"id": 12}, "allow_failure": false}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	job, err := NewGitlabProvider().PlayJob(
+		context.Background(),
+		"owner/repo",
+		"9",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.PipelineJobPlayOptions{Variables: []models.PipelineVariable{{Key: "ENV", Value: "prod"}}},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "9", job.Id)
+	assert.Equal(t, "pending", job.Status)
+	require.NotNil(t, job.PipelineId)
+	assert.Equal(t, "12", *job.PipelineId)
+}
+
+func TestGitLabProviderRetryAndCancelJob(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects/owner%2Frepo/jobs/9/retry", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 10, "name": "test", "stage": "test", "status": "pending", "pipeline": {"id": 12}}`))
+	})
+	mux.HandleFunc("POST /api/v4/projects/owner%2Frepo/jobs/10/cancel", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 10, "name": "test", "stage": "test", "status": "canceled", "pipeline": {"id": 12}}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	settings := krci.GitServerSettings{Token: "test-token", Url: server.URL}
+
+	retried, err := NewGitlabProvider().RetryJob(context.Background(), "owner/repo", "9", settings)
+	require.NoError(t, err)
+	assert.Equal(t, "10", retried.Id, "GitLab retries by creating a new job")
+
+	canceled, err := NewGitlabProvider().CancelJob(context.Background(), "owner/repo", "10", settings)
+	require.NoError(t, err)
+	assert.Equal(t, "canceled", canceled.Status)
+}
+
+func TestGitLabProviderPlayJobNotPlayable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"400 Bad request - Unplayable Job"}`))
+	}))
+	defer server.Close()
+
+	job, err := NewGitlabProvider().PlayJob(
+		context.Background(),
+		"owner/repo",
+		"9",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.PipelineJobPlayOptions{},
+	)
+
+	require.Error(t, err)
+	assert.Nil(t, job)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
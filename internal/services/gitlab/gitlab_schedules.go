@@ -0,0 +1,385 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// ListPipelineSchedules lists the pipeline schedules of a GitLab project. GitLab leaves the
+// variables out of the list; GetPipelineSchedule returns them.
+func (g *GitlabProvider) ListPipelineSchedules(
+	ctx context.Context,
+	project string,
+	settings krci.GitServerSettings,
+) ([]models.PipelineSchedule, error) {
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	it := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.PipelineSchedule, *gitlab.Response, error) {
+		return client.PipelineSchedules.ListPipelineSchedules(
+			project,
+			&gitlab.ListPipelineSchedulesOptions{PerPage: 100},
+			gitlab.WithContext(ctx),
+			p,
+		)
+	})
+
+	result := make([]models.PipelineSchedule, 0)
+
+	for s, err := range it {
+		if err != nil {
+			return nil, mapGitLabScheduleError(err, "list", project, 0)
+		}
+
+		result = append(result, mapGitLabSchedule(s))
+	}
+
+	return result, nil
+}
+
+// GetPipelineSchedule returns a GitLab pipeline schedule with its variables.
+func (g *GitlabProvider) GetPipelineSchedule(
+	ctx context.Context,
+	project string,
+	rawScheduleID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineSchedule, error) {
+	scheduleID, err := parseGitLabID("schedule", rawScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	s, _, err := client.PipelineSchedules.GetPipelineSchedule(project, scheduleID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabScheduleError(err, "get", project, scheduleID)
+	}
+
+	schedule := mapGitLabSchedule(s)
+
+	return &schedule, nil
+}
+
+// CreatePipelineSchedule creates a GitLab pipeline schedule and then its variables, one request
+// each. If a variable cannot be created the schedule is deleted again, so a failed create leaves
+// no schedule that would run without its variables.
+func (g *GitlabProvider) CreatePipelineSchedule(
+	ctx context.Context,
+	project string,
+	settings krci.GitServerSettings,
+	opts models.PipelineScheduleOptions,
+) (*models.PipelineSchedule, error) {
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	s, _, err := client.PipelineSchedules.CreatePipelineSchedule(project, &gitlab.CreatePipelineScheduleOptions{
+		Description:  opts.Description,
+		Ref:          opts.Ref,
+		Cron:         opts.Cron,
+		CronTimezone: opts.CronTimezone,
+		Active:       opts.Active,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabScheduleError(err, "create", project, 0)
+	}
+
+	for _, v := range opts.Variables {
+		created, _, err := client.PipelineSchedules.CreatePipelineScheduleVariable(project, s.ID,
+			&gitlab.CreatePipelineScheduleVariableOptions{
+				Key:          gitlab.Ptr(v.Key),
+				Value:        gitlab.Ptr(v.Value),
+				VariableType: gitLabVariableType(v.VariableType),
+			}, gitlab.WithContext(ctx))
+		if err != nil {
+			if _, derr := client.PipelineSchedules.DeletePipelineSchedule(project, s.ID,
+				gitlab.WithContext(ctx)); derr != nil {
+				slog.Warn("Failed to delete pipeline schedule after a variable was rejected",
+					"project", project, "scheduleID", s.ID, "error", derr)
+			}
+
+			return nil, mapGitLabScheduleError(err, "create variable "+v.Key+" of", project, s.ID)
+		}
+
+		s.Variables = append(s.Variables, created)
+	}
+
+	schedule := mapGitLabSchedule(s)
+
+	return &schedule, nil
+}
+
+// UpdatePipelineSchedule changes the fields of a GitLab pipeline schedule that opts sets. Non-nil
+// opts.Variables replace the schedule's variables: missing ones are created, changed ones edited
+// and the rest deleted. The schedule is read back afterwards, so the result shows its variables.
+func (g *GitlabProvider) UpdatePipelineSchedule(
+	ctx context.Context,
+	project string,
+	rawScheduleID string,
+	settings krci.GitServerSettings,
+	opts models.PipelineScheduleOptions,
+) (*models.PipelineSchedule, error) {
+	scheduleID, err := parseGitLabID("schedule", rawScheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	edit := &gitlab.EditPipelineScheduleOptions{
+		Description:  opts.Description,
+		Ref:          opts.Ref,
+		Cron:         opts.Cron,
+		CronTimezone: opts.CronTimezone,
+		Active:       opts.Active,
+	}
+
+	if *edit != (gitlab.EditPipelineScheduleOptions{}) {
+		if _, _, err := client.PipelineSchedules.EditPipelineSchedule(project, scheduleID, edit,
+			gitlab.WithContext(ctx)); err != nil {
+			return nil, mapGitLabScheduleError(err, "update", project, scheduleID)
+		}
+	}
+
+	s, _, err := client.PipelineSchedules.GetPipelineSchedule(project, scheduleID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabScheduleError(err, "get", project, scheduleID)
+	}
+
+	if opts.Variables == nil {
+		schedule := mapGitLabSchedule(s)
+
+		return &schedule, nil
+	}
+
+	if err := replaceGitLabScheduleVariables(ctx, client, project, scheduleID, s.Variables, opts.Variables); err != nil {
+		return nil, err
+	}
+
+	s, _, err = client.PipelineSchedules.GetPipelineSchedule(project, scheduleID, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, mapGitLabScheduleError(err, "get", project, scheduleID)
+	}
+
+	schedule := mapGitLabSchedule(s)
+
+	return &schedule, nil
+}
+
+// replaceGitLabScheduleVariables turns the current variables of a schedule into want.
+func replaceGitLabScheduleVariables(
+	ctx context.Context,
+	client *gitlab.Client,
+	project string,
+	scheduleID int,
+	current []*gitlab.PipelineVariable,
+	want []models.PipelineVariable,
+) error {
+	existing := make(map[string]*gitlab.PipelineVariable, len(current))
+	for _, v := range current {
+		existing[v.Key] = v
+	}
+
+	for _, v := range want {
+		varType := gitLabVariableType(v.VariableType)
+
+		old, ok := existing[v.Key]
+		delete(existing, v.Key)
+
+		var err error
+
+		switch {
+		case !ok:
+			_, _, err = client.PipelineSchedules.CreatePipelineScheduleVariable(project, scheduleID,
+				&gitlab.CreatePipelineScheduleVariableOptions{
+					Key:          gitlab.Ptr(v.Key),
+					Value:        gitlab.Ptr(v.Value),
+					VariableType: varType,
+				}, gitlab.WithContext(ctx))
+		case old.Value != v.Value || (varType != nil && *varType != old.VariableType):
+			_, _, err = client.PipelineSchedules.EditPipelineScheduleVariable(project, scheduleID, v.Key,
+				&gitlab.EditPipelineScheduleVariableOptions{
+					Value:        gitlab.Ptr(v.Value),
+					VariableType: varType,
+				}, gitlab.WithContext(ctx))
+		}
+
+		if err != nil {
+			return mapGitLabScheduleError(err, "set variable "+v.Key+" of", project, scheduleID)
+		}
+	}
+
+	for key := range existing {
+		if _, _, err := client.PipelineSchedules.DeletePipelineScheduleVariable(project, scheduleID, key,
+			gitlab.WithContext(ctx)); err != nil {
+			return mapGitLabScheduleError(err, "delete variable "+key+" of", project, scheduleID)
+		}
+	}
+
+	return nil
+}
+
+// DeletePipelineSchedule deletes a GitLab pipeline schedule.
+func (g *GitlabProvider) DeletePipelineSchedule(
+	ctx context.Context,
+	project string,
+	rawScheduleID string,
+	settings krci.GitServerSettings,
+) error {
+	scheduleID, err := parseGitLabID("schedule", rawScheduleID)
+	if err != nil {
+		return err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	_, err = client.PipelineSchedules.DeletePipelineSchedule(project, scheduleID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mapGitLabScheduleError(err, "delete", project, scheduleID)
+	}
+
+	return nil
+}
+
+// RunPipelineSchedule starts a pipeline of a GitLab schedule now. GitLab queues the pipeline and
+// answers without it.
+func (g *GitlabProvider) RunPipelineSchedule(
+	ctx context.Context,
+	project string,
+	rawScheduleID string,
+	settings krci.GitServerSettings,
+) error {
+	scheduleID, err := parseGitLabID("schedule", rawScheduleID)
+	if err != nil {
+		return err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return fmt.Errorf("failed to create gitlab client: %w", err)
+	}
+
+	_, err = client.PipelineSchedules.RunPipelineSchedule(project, scheduleID, gitlab.WithContext(ctx))
+	if err != nil {
+		return mapGitLabScheduleError(err, "run", project, scheduleID)
+	}
+
+	return nil
+}
+
+// mapGitLabSchedule converts a go-gitlab PipelineSchedule to the unified PipelineSchedule model.
+func mapGitLabSchedule(s *gitlab.PipelineSchedule) models.PipelineSchedule {
+	schedule := models.PipelineSchedule{
+		Id:           strconv.Itoa(s.ID),
+		Description:  s.Description,
+		Ref:          s.Ref,
+		Cron:         s.Cron,
+		CronTimezone: s.CronTimezone,
+		Active:       s.Active,
+		NextRunAt:    s.NextRunAt,
+		CreatedAt:    s.CreatedAt,
+		UpdatedAt:    s.UpdatedAt,
+	}
+
+	if s.Owner != nil {
+		schedule.Owner = &models.Owner{
+			Id:   strconv.Itoa(s.Owner.ID),
+			Name: s.Owner.Username,
+		}
+
+		if s.Owner.AvatarURL != "" {
+			schedule.Owner.AvatarUrl = &s.Owner.AvatarURL
+		}
+	}
+
+	if s.LastPipeline != nil {
+		schedule.LastPipeline = &models.PipelineScheduleLastPipeline{
+			Id:     strconv.Itoa(s.LastPipeline.ID),
+			Status: models.PipelineScheduleLastPipelineStatus(normalizeGitLabPipelineStatus(s.LastPipeline.Status)),
+		}
+
+		if s.LastPipeline.WebURL != "" {
+			schedule.LastPipeline.WebUrl = &s.LastPipeline.WebURL
+		}
+	}
+
+	if s.Variables != nil {
+		variables := make([]models.PipelineVariable, 0, len(s.Variables))
+		for _, v := range s.Variables {
+			variable := models.PipelineVariable{Key: v.Key, Value: v.Value}
+
+			if v.VariableType != "" {
+				varType := models.PipelineVariableVariableType(v.VariableType)
+				variable.VariableType = &varType
+			}
+
+			variables = append(variables, variable)
+		}
+
+		schedule.Variables = &variables
+	}
+
+	return schedule
+}
+
+// gitLabVariableType converts an optional unified variable type to go-gitlab's.
+func gitLabVariableType(t *models.PipelineVariableVariableType) *gitlab.VariableTypeValue {
+	if t == nil {
+		return nil
+	}
+
+	varType := gitlab.VariableTypeValue(*t)
+
+	return &varType
+}
+
+// mapGitLabScheduleError maps a failed pipeline schedule call to a GitFusion sentinel error;
+// scheduleID is 0 for calls on the project's schedules as a whole. GitLab answers 400 for
+// invalid schedule fields, such as a malformed cron expression.
+func mapGitLabScheduleError(err error, action, project string, scheduleID int) error {
+	statusCode := 0
+
+	var errResp *gitlab.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		statusCode = errResp.Response.StatusCode
+	}
+
+	target := "pipeline schedules"
+	if scheduleID != 0 {
+		target = fmt.Sprintf("pipeline schedule %d", scheduleID)
+	}
+
+	switch {
+	case errors.Is(err, gitlab.ErrNotFound) || statusCode == http.StatusNotFound:
+		return fmt.Errorf("project %s or %s: %w", project, target, gferrors.ErrNotFound)
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	case statusCode == http.StatusBadRequest || statusCode == http.StatusUnprocessableEntity:
+		return fmt.Errorf("%s %s for %s: %v: %w", action, target, project, err, gferrors.ErrBadRequest)
+	default:
+		return fmt.Errorf("failed to %s %s for %s: %w", action, target, project, err)
+	}
+}
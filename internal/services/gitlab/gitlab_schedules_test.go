@@ -0,0 +1,314 @@
+package gitlab
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+)
+
+const gitLabScheduleJSON = `{
+	"id": 7, "description": "nightly", "ref": "main", "cron": "0 1 * * *",
+	"cron_timezone": "Europe/Kyiv", "next_run_at": "2026-01-02T01:00:00Z", "active": true,
+	"owner": {"id": 3, "username": "jdoe", "avatar_url": "https://gitlab.example.com/jdoe.png"},
+	"last_pipeline": {"id": 12, "status": "canceled", "web_url": "https://gitlab.example.com/p/-/pipelines/12"}`
+
+func TestGitLabProviderListPipelineSchedules(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipeline_schedules", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[` + gitLabScheduleJSON + `}]`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	schedules, err := NewGitlabProvider().ListPipelineSchedules(
+		context.Background(),
+		"owner/repo",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+	)
+
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+
+	s := schedules[0]
+	assert.Equal(t, "7", s.Id)
+	assert.Equal(t, "0 1 * * *", s.Cron)
+	assert.Equal(t, "Europe/Kyiv", s.CronTimezone)
+	assert.True(t, s.Active)
+	require.NotNil(t, s.NextRunAt)
+	require.NotNil(t, s.Owner)
+	assert.Equal(t, "jdoe", s.Owner.Name)
+	require.NotNil(t, s.LastPipeline)
+	assert.Equal(t, "12", s.LastPipeline.Id)
+	assert.Equal(t, models.PipelineScheduleLastPipelineStatus("cancelled"), s.LastPipeline.Status)
+	assert.Nil(t, s.Variables, "the list leaves the variables out")
+}
+
+func TestGitLabProviderGetPipelineSchedule(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipeline_schedules/7", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(gitLabScheduleJSON +
+			`, "variables": [{"key": "ENV", "value": "dev", "variable_type": "env_var"}]}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	settings := krci.GitServerSettings{Token: "test-token", Url: server.URL}
+
+	schedule, err := NewGitlabProvider().GetPipelineSchedule(context.Background(), "owner/repo", "7", settings)
+	require.NoError(t, err)
+	require.NotNil(t, schedule.Variables)
+	require.Len(t, *schedule.Variables, 1)
+	assert.Equal(t, "ENV", (*schedule.Variables)[0].Key)
+	require.NotNil(t, (*schedule.Variables)[0].VariableType)
+	assert.Equal(t, models.PipelineVariableVariableType("env_var"), *(*schedule.Variables)[0].VariableType)
+
+	_, err = NewGitlabProvider().GetPipelineSchedule(context.Background(), "owner/repo", "x", settings)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
+
+func TestGitLabProviderCreatePipelineSchedule(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects/owner%2Frepo/pipeline_schedules", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"description":"nightly","ref":"main","cron":"0 1 * * *","active":false}`, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 7, "description": "nightly", "ref": "main", "cron": "0 1 * * *",
+			"cron_timezone": "UTC", "active": false}`))
+	})
+	mux.HandleFunc("POST /api/v4/projects/owner%2Frepo/pipeline_schedules/7/variables",
+		func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"key":"ENV","value":"dev"}`, string(body))
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key": "ENV", "value": "dev", "variable_type": "env_var"}`))
+		})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	schedule, err := NewGitlabProvider().CreatePipelineSchedule(
+		context.Background(),
+		"owner/repo",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.PipelineScheduleOptions{
+			Description: pointer.To("nightly"),
+			Ref:         pointer.To("main"),
+			Cron:        pointer.To("0 1 * * *"),
+			Active:      pointer.To(false),
+			Variables:   []models.PipelineVariable{{Key: "ENV", Value: "dev"}},
+		},
+	)
+
+	require.NoError(t, err)
+	assert.Equal(t, "7", schedule.Id)
+	assert.False(t, schedule.Active)
+	require.NotNil(t, schedule.Variables)
+	assert.Len(t, *schedule.Variables, 1)
+}
+
+func TestGitLabProviderCreatePipelineScheduleRejectedVariable(t *testing.T) {
+	var deleted bool
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects/owner%2Frepo/pipeline_schedules", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 7, "description": "nightly", "ref": "main", "cron": "0 1 * * *"}`))
+	})
+	mux.HandleFunc("POST /api/v4/projects/owner%2Frepo/pipeline_schedules/7/variables",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"message": {"key": ["is invalid"]}}`))
+		})
+	mux.HandleFunc("DELETE /api/v4/projects/owner%2Frepo/pipeline_schedules/7",
+		func(w http.ResponseWriter, _ *http.Request) {
+			deleted = true
+
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	schedule, err := NewGitlabProvider().CreatePipelineSchedule(
+		context.Background(),
+		"owner/repo",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.PipelineScheduleOptions{
+			Description: pointer.To("nightly"),
+			Ref:         pointer.To("main"),
+			Cron:        pointer.To("0 1 * * *"),
+			Variables:   []models.PipelineVariable{{Key: "BAD KEY", Value: "x"}},
+		},
+	)
+
+	require.Error(t, err)
+	assert.Nil(t, schedule)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+	assert.True(t, deleted, "the schedule must not outlive its rejected variable")
+}
+
+func TestGitLabProviderUpdatePipelineScheduleReplacesVariables(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls []string
+		gets  int
+	)
+
+	record := func(call string) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		calls = append(calls, call)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /api/v4/projects/owner%2Frepo/pipeline_schedules/7", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"cron":"0 2 * * *"}`, string(body))
+		record("edit schedule")
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 7, "cron": "0 2 * * *"}`))
+	})
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/pipeline_schedules/7", func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		gets++
+		first := gets == 1
+		mu.Unlock()
+
+		variables := `[{"key": "A", "value": "1"}, {"key": "B", "value": "old"}, {"key": "C", "value": "3"}]`
+		if !first {
+			variables = `[{"key": "A", "value": "1"}, {"key": "B", "value": "new"}, {"key": "D", "value": "4"}]`
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 7, "cron": "0 2 * * *", "variables": ` + variables + `}`))
+	})
+	mux.HandleFunc("POST /api/v4/projects/owner%2Frepo/pipeline_schedules/7/variables",
+		func(w http.ResponseWriter, _ *http.Request) {
+			record("create D")
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"key": "D", "value": "4"}`))
+		})
+	mux.HandleFunc("PUT /api/v4/projects/owner%2Frepo/pipeline_schedules/7/variables/B",
+		func(w http.ResponseWriter, _ *http.Request) {
+			record("edit B")
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"key": "B", "value": "new"}`))
+		})
+	mux.HandleFunc("DELETE /api/v4/projects/owner%2Frepo/pipeline_schedules/7/variables/C",
+		func(w http.ResponseWriter, _ *http.Request) {
+			record("delete C")
+
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"key": "C", "value": "3"}`))
+		})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	schedule, err := NewGitlabProvider().UpdatePipelineSchedule(
+		context.Background(),
+		"owner/repo",
+		"7",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.PipelineScheduleOptions{
+			Cron: pointer.To("0 2 * * *"),
+			Variables: []models.PipelineVariable{
+				{Key: "A", Value: "1"},
+				{Key: "B", Value: "new"},
+				{Key: "D", Value: "4"},
+			},
+		},
+	)
+
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"edit schedule", "edit B", "create D", "delete C"}, calls,
+		"A is unchanged and must not be touched")
+	require.NotNil(t, schedule.Variables)
+	assert.Len(t, *schedule.Variables, 3)
+}
+
+func TestGitLabProviderRunAndDeletePipelineSchedule(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects/owner%2Frepo/pipeline_schedules/7/play",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"message": "201 Created"}`))
+		})
+	mux.HandleFunc("DELETE /api/v4/projects/owner%2Frepo/pipeline_schedules/7",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	settings := krci.GitServerSettings{Token: "test-token", Url: server.URL}
+
+	require.NoError(t, NewGitlabProvider().RunPipelineSchedule(context.Background(), "owner/repo", "7", settings))
+	require.NoError(t, NewGitlabProvider().DeletePipelineSchedule(context.Background(), "owner/repo", "7", settings))
+}
+
+func TestGitLabProviderPipelineScheduleErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   error
+	}{
+		{name: "not found", status: http.StatusNotFound, want: gferrors.ErrNotFound},
+		{name: "forbidden", status: http.StatusForbidden, want: gferrors.ErrUnauthorized},
+		{name: "invalid cron", status: http.StatusBadRequest, want: gferrors.ErrBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"message":"error"}`))
+			}))
+			defer server.Close()
+
+			schedule, err := NewGitlabProvider().UpdatePipelineSchedule(
+				context.Background(),
+				"owner/repo",
+				"7",
+				krci.GitServerSettings{Token: "test-token", Url: server.URL},
+				models.PipelineScheduleOptions{Cron: pointer.To("not a cron")},
+			)
+
+			require.Error(t, err)
+			assert.Nil(t, schedule)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
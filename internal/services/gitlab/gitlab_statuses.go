@@ -0,0 +1,174 @@
+package gitlab
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	gitlab "gitlab.com/gitlab-org/api/client-go"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// ListCommitStatuses returns the latest status of each name reported for a commit. GitLab
+// reports the jobs of its own pipelines as commit statuses too.
+func (g *GitlabProvider) ListCommitStatuses(
+	ctx context.Context,
+	project string,
+	sha string,
+	settings krci.GitServerSettings,
+) ([]models.CommitStatus, error) {
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitLab client: %w", err)
+	}
+
+	it := gitlab.Scan2(func(p gitlab.PaginationOptionFunc) ([]*gitlab.CommitStatus, *gitlab.Response, error) {
+		return client.Commits.GetCommitStatuses(
+			project,
+			sha,
+			&gitlab.GetCommitStatusesOptions{ListOptions: gitlab.ListOptions{PerPage: 100}},
+			gitlab.WithContext(ctx),
+			p,
+		)
+	})
+
+	result := make([]models.CommitStatus, 0)
+
+	for s, err := range it {
+		if err != nil {
+			return nil, mapGitLabCommitStatusesError(err, project, sha)
+		}
+
+		result = append(result, mapGitLabCommitStatus(s))
+	}
+
+	return result, nil
+}
+
+// CreateCommitStatus writes a commit status. GitLab keeps external statuses in a pipeline of
+// their own, so the status is also visible in the project's pipeline list.
+func (g *GitlabProvider) CreateCommitStatus(
+	ctx context.Context,
+	project string,
+	sha string,
+	settings krci.GitServerSettings,
+	opts models.CommitStatusOptions,
+) (*models.CommitStatus, error) {
+	state, err := mapCommitStateToGitLab(opts.State)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := newGitlabClient(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GitLab client: %w", err)
+	}
+
+	created, resp, err := client.Commits.SetCommitStatus(project, sha, &gitlab.SetCommitStatusOptions{
+		State:       state,
+		Name:        &opts.Context,
+		Description: opts.Description,
+		TargetURL:   opts.TargetURL,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		// GitLab answers 400 when the state cannot follow the current one (e.g. running after success).
+		if resp != nil && resp.StatusCode == http.StatusBadRequest {
+			return nil, fmt.Errorf("failed to create status of commit %s for %s: %w: %w",
+				sha, project, gferrors.ErrBadRequest, err)
+		}
+
+		return nil, mapGitLabCommitStatusesError(err, project, sha)
+	}
+
+	status := mapGitLabCommitStatus(created)
+
+	return &status, nil
+}
+
+// mapCommitStateToGitLab maps a unified commit state to the state of a GitLab commit status.
+func mapCommitStateToGitLab(state models.CommitState) (gitlab.BuildStateValue, error) {
+	switch state {
+	case models.CommitStatePending:
+		return gitlab.Pending, nil
+	case models.CommitStateRunning:
+		return gitlab.Running, nil
+	case models.CommitStateSuccess:
+		return gitlab.Success, nil
+	case models.CommitStateFailed:
+		return gitlab.Failed, nil
+	case models.CommitStateCancelled:
+		return gitlab.Canceled, nil
+	default:
+		return "", fmt.Errorf("commit state %q cannot be written to GitLab: %w", state, gferrors.ErrBadRequest)
+	}
+}
+
+// mapGitLabCommitStatus converts a GitLab commit status to the unified CommitStatus model.
+func mapGitLabCommitStatus(s *gitlab.CommitStatus) models.CommitStatus {
+	status := models.CommitStatus{
+		Kind:      models.CommitStatusKindStatus,
+		Context:   s.Name,
+		State:     normalizeGitLabCommitState(s.Status),
+		CreatedAt: s.CreatedAt,
+		UpdatedAt: s.FinishedAt,
+	}
+
+	if status.UpdatedAt == nil {
+		status.UpdatedAt = s.StartedAt
+	}
+
+	if s.Description != "" {
+		status.Description = &s.Description
+	}
+
+	if s.TargetURL != "" {
+		status.TargetUrl = &s.TargetURL
+	}
+
+	return status
+}
+
+// normalizeGitLabCommitState maps a GitLab commit status to the unified commit state. States
+// waiting for something to happen (created, manual, scheduled, ...) are reported as pending.
+func normalizeGitLabCommitState(status string) models.CommitState {
+	switch status {
+	case glStatusRunning:
+		return models.CommitStateRunning
+	case glStatusSuccess:
+		return models.CommitStateSuccess
+	case glStatusFailed:
+		return models.CommitStateFailed
+	case glStatusCanceled:
+		return models.CommitStateCancelled
+	case glStatusSkipped:
+		return models.CommitStateSkipped
+	default:
+		return models.CommitStatePending
+	}
+}
+
+// mapGitLabCommitStatusesError maps an error reading or writing the statuses of a commit to a
+// GitFusion sentinel error. Like mapGitLabJobsError it recovers the HTTP status from the error, as
+// gitlab.Scan2 does not surface the *gitlab.Response.
+func mapGitLabCommitStatusesError(err error, project, sha string) error {
+	statusCode := 0
+
+	var errResp *gitlab.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		statusCode = errResp.Response.StatusCode
+	}
+
+	if errors.Is(err, gitlab.ErrNotFound) || statusCode == http.StatusNotFound {
+		return fmt.Errorf("project %s or commit %s: %w", project, sha, gferrors.ErrNotFound)
+	}
+
+	if statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden {
+		return fmt.Errorf("invalid credentials: %w", gferrors.ErrUnauthorized)
+	}
+
+	return fmt.Errorf("gitlab commit status request failed for %s commit %s: %w", project, sha, err)
+}
@@ -0,0 +1,140 @@
+package gitlab
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
+)
+
+func TestGitLabProviderListCommitStatuses(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v4/projects/owner%2Frepo/repository/commits/abc123/statuses",
+		func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`[
+				{"id": 1, "name": "build", "status": "success", "description": "Build passed",
+				 "target_url": "https://gitlab.example.com/owner/repo/-/jobs/1",
+				 "created_at": "2024-01-15T10:00:00Z", "started_at": "2024-01-15T10:01:00Z",
+				 "finished_at": "2024-01-15T10:04:00Z"},
+				{"id": 2, "name": "deploy", "status": "manual"},
+				{"id": 3, "name": "external/scan", "status": "running", "started_at": "2024-01-15T10:02:00Z"},
+				{"id": 4, "name": "cleanup", "status": "canceled"}
+			]`))
+		})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	statuses, err := NewGitlabProvider().ListCommitStatuses(context.Background(), "owner/repo", "abc123",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL})
+
+	require.NoError(t, err)
+	require.Len(t, statuses, 4)
+
+	assert.Equal(t, models.CommitStatusKindStatus, statuses[0].Kind)
+	assert.Equal(t, "build", statuses[0].Context)
+	assert.Equal(t, models.CommitStateSuccess, statuses[0].State)
+	assert.Equal(t, "Build passed", *statuses[0].Description)
+	assert.Equal(t, "https://gitlab.example.com/owner/repo/-/jobs/1", *statuses[0].TargetUrl)
+	assert.Equal(t, time.Date(2024, 1, 15, 10, 4, 0, 0, time.UTC), statuses[0].UpdatedAt.UTC())
+
+	assert.Equal(t, models.CommitStatePending, statuses[1].State)
+	assert.Nil(t, statuses[1].Description)
+	assert.Nil(t, statuses[1].TargetUrl)
+
+	assert.Equal(t, models.CommitStateRunning, statuses[2].State)
+	assert.Equal(t, time.Date(2024, 1, 15, 10, 2, 0, 0, time.UTC), statuses[2].UpdatedAt.UTC())
+	assert.Equal(t, models.CommitStateCancelled, statuses[3].State)
+}
+
+func TestGitLabProviderListCommitStatusesErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   error
+	}{
+		{name: "not found", status: http.StatusNotFound, want: gferrors.ErrNotFound},
+		{name: "forbidden", status: http.StatusForbidden, want: gferrors.ErrUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(`{"message":"error"}`))
+			}))
+			defer server.Close()
+
+			statuses, err := NewGitlabProvider().ListCommitStatuses(context.Background(), "owner/repo", "abc123",
+				krci.GitServerSettings{Token: "test-token", Url: server.URL})
+
+			require.Error(t, err)
+			assert.Nil(t, statuses)
+			assert.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestGitLabProviderCreateCommitStatus(t *testing.T) {
+	var gotBody string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /api/v4/projects/owner%2Frepo/statuses/abc123", func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		gotBody = string(body)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id": 9, "name": "tekton/build", "status": "canceled",
+			"target_url": "https://tekton.example.com/runs/1", "created_at": "2024-01-15T10:00:00Z"}`))
+	})
+
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	status, err := NewGitlabProvider().CreateCommitStatus(context.Background(), "owner/repo", "abc123",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.CommitStatusOptions{
+			State:     models.CommitStateCancelled,
+			Context:   "tekton/build",
+			TargetURL: pointer.To("https://tekton.example.com/runs/1"),
+		})
+
+	require.NoError(t, err)
+	assert.Contains(t, gotBody, `"state":"canceled"`)
+	assert.Contains(t, gotBody, `"name":"tekton/build"`)
+	assert.Contains(t, gotBody, `"target_url":"https://tekton.example.com/runs/1"`)
+	assert.Equal(t, models.CommitStateCancelled, status.State)
+	assert.Equal(t, "tekton/build", status.Context)
+}
+
+func TestGitLabProviderCreateCommitStatusInvalidTransition(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"message":"Cannot transition status via :run from :success"}`))
+	}))
+	defer server.Close()
+
+	status, err := NewGitlabProvider().CreateCommitStatus(context.Background(), "owner/repo", "abc123",
+		krci.GitServerSettings{Token: "test-token", Url: server.URL},
+		models.CommitStatusOptions{State: models.CommitStateRunning, Context: "tekton/build"})
+
+	require.Error(t, err)
+	assert.Nil(t, status)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
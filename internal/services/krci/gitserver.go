@@ -30,8 +30,12 @@ type GitServerService struct {
 }
 
 type GitServerSettings struct {
-	Url           string
-	Token         string
+	Url   string
+	Token string
+	// GitUser is the GitServer user name, used by providers that authenticate with a user name
+	// and the token as password (Gerrit HTTP credentials). For Gerrit it must be the account
+	// owning the token's HTTP password, even where spec.gitUser also serves as the SSH user.
+	GitUser       string
 	GitProvider   string
 	GitServerName string
 }
@@ -114,6 +118,7 @@ func (g *GitServerService) getGitProviderSettingsForServer(
 	return GitServerSettings{
 		Url:           gitprovider.GetGitProviderAPIURL(gitServer),
 		Token:         token,
+		GitUser:       gitServer.Spec.GitUser,
 		GitProvider:   gitServer.Spec.GitProvider,
 		GitServerName: gitServer.Name,
 	}, nil
@@ -49,6 +49,7 @@ func gitServerFixture() (*codebaseApi.GitServer, *corev1.Secret) {
 		Spec: codebaseApi.GitServerSpec{
 			GitProvider:      codebaseApi.GitProviderGitlab,
 			GitHost:          "gitlab.example.com",
+			GitUser:          "git",
 			NameSshKeySecret: "gitlab-secret",
 		},
 	}
@@ -68,6 +69,7 @@ func TestGetGitProviderSettings_CachesAcrossCalls(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "gitlab", s1.GitProvider)
 	assert.Equal(t, "t0ken", s1.Token)
+	assert.Equal(t, "git", s1.GitUser)
 	assert.Equal(t, 2, cc.gets, "cold call should read GitServer CR + Secret")
 
 	s2, err := svc.GetGitProviderSettings(context.Background(), "gitlab")
@@ -9,10 +9,8 @@ import (
 
 	"github.com/KubeRocketCI/gitfusion/internal/cache"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
-	"github.com/KubeRocketCI/gitfusion/internal/services/bitbucket"
-	"github.com/KubeRocketCI/gitfusion/internal/services/github"
-	"github.com/KubeRocketCI/gitfusion/internal/services/gitlab"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
 )
 
 type OrganizationsProvider interface {
@@ -28,15 +26,12 @@ type MultiProviderOrganizationsService struct {
 }
 
 func NewMultiProviderOrganizationsService(
+	reg *registry.Registry,
 	gitServerService *krci.GitServerService,
 ) *MultiProviderOrganizationsService {
 	service := &MultiProviderOrganizationsService{
-		providers: map[string]OrganizationsProvider{
-			"github":    github.NewGitHubProvider(),
-			"gitlab":    gitlab.NewGitlabProvider(),
-			"bitbucket": bitbucket.NewBitbucketProvider(),
-		},
-		cache: cache.NewOrganizationCache(),
+		providers: registry.Providers[OrganizationsProvider](reg, registry.CapabilityOrganizations),
+		cache:     cache.NewOrganizationCache(),
 	}
 
 	ctx := context.Background()
@@ -0,0 +1,101 @@
+package pipelines
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// maxArtifactDownloadBytes caps the size of an artifact download.
+const maxArtifactDownloadBytes = 1 << 30
+
+// ListPipelineArtifacts lists the artifacts of a pipeline. Artifact lists are not cached: they are
+// read on demand and a stale list would offer artifacts that have since expired.
+func (m *MultiProviderPipelineService) ListPipelineArtifacts(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+	opts models.PipelineArtifactListOptions,
+) ([]models.PipelineArtifact, error) {
+	artifactsProvider, err := capability[PipelineArtifactsProvider](m, settings.GitProvider, "pipeline artifacts")
+	if err != nil {
+		return nil, err
+	}
+
+	return artifactsProvider.ListPipelineArtifacts(ctx, project, pipelineID, settings, opts)
+}
+
+// DownloadArtifact opens an artifact for streaming to the client. An artifact the provider reports
+// as larger than maxArtifactDownloadBytes is refused up front; otherwise reading the returned body
+// fails once it passes the limit.
+func (m *MultiProviderPipelineService) DownloadArtifact(
+	ctx context.Context,
+	project string,
+	artifactID string,
+	settings krci.GitServerSettings,
+) (*models.ArtifactDownload, error) {
+	artifactsProvider, err := capability[PipelineArtifactsProvider](m, settings.GitProvider, "pipeline artifacts")
+	if err != nil {
+		return nil, err
+	}
+
+	download, err := artifactsProvider.DownloadArtifact(ctx, project, artifactID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	if download.Size > maxArtifactDownloadBytes {
+		_ = download.Body.Close()
+
+		return nil, fmt.Errorf("artifact %s is %d bytes, over the %d byte download limit: %w",
+			artifactID, download.Size, maxArtifactDownloadBytes, gferrors.ErrBadRequest)
+	}
+
+	download.Body = newLimitedBody(download.Body, maxArtifactDownloadBytes)
+
+	return download, nil
+}
+
+// limitedBody reads from rc until limit bytes are used up, then fails instead of reporting EOF, so
+// a download cut off at the limit is not mistaken for a complete one.
+type limitedBody struct {
+	rc        io.ReadCloser
+	limit     int64
+	remaining int64
+}
+
+func newLimitedBody(rc io.ReadCloser, limit int64) *limitedBody {
+	return &limitedBody{rc: rc, limit: limit, remaining: limit}
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.remaining <= 0 {
+		// Probe for a byte past the limit: the body may end exactly at it.
+		var probe [1]byte
+
+		n, err := b.rc.Read(probe[:])
+		if n > 0 {
+			return 0, fmt.Errorf("artifact exceeds the %d byte download limit", b.limit)
+		}
+
+		return 0, err
+	}
+
+	if int64(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+
+	n, err := b.rc.Read(p)
+	b.remaining -= int64(n)
+
+	return n, err
+}
+
+func (b *limitedBody) Close() error {
+	return b.rc.Close()
+}
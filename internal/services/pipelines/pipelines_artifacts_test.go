@@ -0,0 +1,103 @@
+package pipelines
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+)
+
+// fakeArtifactsProvider implements PipelineArtifactsProvider on top of fakeJobsProvider and serves
+// one download.
+type fakeArtifactsProvider struct {
+	fakeJobsProvider
+
+	download *models.ArtifactDownload
+}
+
+func (f *fakeArtifactsProvider) ListPipelineArtifacts(
+	_ context.Context, _ string, _ string, _ krci.GitServerSettings, _ models.PipelineArtifactListOptions,
+) ([]models.PipelineArtifact, error) {
+	return []models.PipelineArtifact{{Id: "1", Name: "artifacts.zip"}}, nil
+}
+
+func (f *fakeArtifactsProvider) DownloadArtifact(
+	_ context.Context, _ string, _ string, _ krci.GitServerSettings,
+) (*models.ArtifactDownload, error) {
+	return f.download, nil
+}
+
+// closeTracker records whether the download body was closed.
+type closeTracker struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeTracker) Close() error {
+	c.closed = true
+
+	return nil
+}
+
+func TestMultiProviderPipelineService_DownloadArtifact(t *testing.T) {
+	t.Run("streams an artifact within the limit", func(t *testing.T) {
+		svc := NewMultiProviderPipelineService(registry.NewDefault())
+		svc.providers["gitlab"] = &fakeArtifactsProvider{download: &models.ArtifactDownload{
+			Body: &closeTracker{Reader: strings.NewReader("zip")},
+			Name: "artifacts.zip",
+			Size: 3,
+		}}
+
+		download, err := svc.DownloadArtifact(context.Background(), "proj", "1", gitlabSettings())
+		require.NoError(t, err)
+
+		content, err := io.ReadAll(download.Body)
+		require.NoError(t, err)
+		assert.Equal(t, "zip", string(content))
+		assert.Equal(t, "artifacts.zip", download.Name)
+	})
+
+	t.Run("refuses an artifact reported over the limit", func(t *testing.T) {
+		body := &closeTracker{Reader: strings.NewReader("")}
+		svc := NewMultiProviderPipelineService(registry.NewDefault())
+		svc.providers["gitlab"] = &fakeArtifactsProvider{download: &models.ArtifactDownload{
+			Body: body,
+			Size: maxArtifactDownloadBytes + 1,
+		}}
+
+		_, err := svc.DownloadArtifact(context.Background(), "proj", "1", gitlabSettings())
+		require.ErrorIs(t, err, gferrors.ErrBadRequest)
+		assert.True(t, body.closed)
+	})
+
+	t.Run("provider without artifacts returns bad request", func(t *testing.T) {
+		svc := NewMultiProviderPipelineService(registry.NewDefault())
+		svc.providers["gitlab"] = &fakeJobsProvider{}
+
+		_, err := svc.ListPipelineArtifacts(context.Background(), "proj", "7", gitlabSettings(),
+			models.PipelineArtifactListOptions{})
+		require.ErrorIs(t, err, gferrors.ErrBadRequest)
+	})
+}
+
+func TestLimitedBody(t *testing.T) {
+	t.Run("body ending at the limit reads to EOF", func(t *testing.T) {
+		content, err := io.ReadAll(newLimitedBody(io.NopCloser(strings.NewReader("12345")), 5))
+		require.NoError(t, err)
+		assert.Equal(t, "12345", string(content))
+	})
+
+	t.Run("body past the limit fails", func(t *testing.T) {
+		content, err := io.ReadAll(newLimitedBody(io.NopCloser(strings.NewReader("123456")), 5))
+		require.ErrorContains(t, err, "5 byte download limit")
+		assert.Equal(t, "12345", string(content))
+	})
+}
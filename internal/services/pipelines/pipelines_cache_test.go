@@ -12,8 +12,10 @@ import (
 	"github.com/viccon/sturdyc"
 
 	"github.com/KubeRocketCI/gitfusion/internal/cache"
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
 )
 
 // fakeJobsProvider implements both PipelineProvider and PipelineJobsProvider and counts
@@ -33,7 +35,7 @@ type fakeJobsProvider struct {
 }
 
 func (f *fakeJobsProvider) TriggerPipeline(
-	_ context.Context, _ string, _ string, _ []models.PipelineVariable, _ krci.GitServerSettings,
+	_ context.Context, _ string, _ krci.GitServerSettings, _ models.PipelineTriggerOptions,
 ) (*models.PipelineResponse, error) {
 	return nil, nil
 }
@@ -41,11 +43,11 @@ func (f *fakeJobsProvider) TriggerPipeline(
 func (f *fakeJobsProvider) ListPipelines(
 	_ context.Context, _ string, _ krci.GitServerSettings, _ models.PipelineListOptions,
 ) (*models.PipelinesResponse, error) {
-	return nil, nil
+	return &models.PipelinesResponse{}, nil
 }
 
 func (f *fakeJobsProvider) ListPipelineJobs(
-	_ context.Context, _ string, _ int, _ krci.GitServerSettings,
+	_ context.Context, _ string, _ string, _ krci.GitServerSettings,
 ) ([]models.PipelineJob, error) {
 	f.mu.Lock()
 	f.listCalls++
@@ -59,7 +61,7 @@ func (f *fakeJobsProvider) ListPipelineJobs(
 }
 
 func (f *fakeJobsProvider) GetJobTrace(
-	_ context.Context, _ string, _ int, _ krci.GitServerSettings,
+	_ context.Context, _ string, _ string, _ krci.GitServerSettings,
 ) (string, bool, error) {
 	f.mu.Lock()
 	f.traceCalls++
@@ -72,29 +74,67 @@ func (f *fakeJobsProvider) GetJobTrace(
 	return f.traceText, f.truncated, nil
 }
 
+func (f *fakeJobsProvider) GetPipeline(
+	_ context.Context, _ string, pipelineID string, _ krci.GitServerSettings,
+) (*models.Pipeline, error) {
+	return &models.Pipeline{Id: pipelineID, Status: models.PipelineStatusRunning}, nil
+}
+
+func (f *fakeJobsProvider) CancelPipeline(
+	_ context.Context, _ string, pipelineID string, _ krci.GitServerSettings,
+) (*models.PipelineActionResponse, error) {
+	return &models.PipelineActionResponse{PipelineId: pipelineID, Action: models.PipelineActionCancel}, nil
+}
+
+func (f *fakeJobsProvider) RetryPipeline(
+	_ context.Context, _ string, pipelineID string, _ krci.GitServerSettings, _ models.PipelineRetryOptions,
+) (*models.PipelineActionResponse, error) {
+	return &models.PipelineActionResponse{PipelineId: pipelineID, Action: models.PipelineActionRetry}, nil
+}
+
+func (f *fakeJobsProvider) PlayJob(
+	_ context.Context, _ string, jobID string, _ krci.GitServerSettings, _ models.PipelineJobPlayOptions,
+) (*models.PipelineJob, error) {
+	return &models.PipelineJob{Id: jobID, Status: "pending"}, nil
+}
+
+func (f *fakeJobsProvider) RetryJob(
+	_ context.Context, _ string, _ string, _ krci.GitServerSettings,
+) (*models.PipelineJob, error) {
+	pipelineID := "7"
+
+	return &models.PipelineJob{Id: "99", Status: "pending", PipelineId: &pipelineID}, nil
+}
+
+func (f *fakeJobsProvider) CancelJob(
+	_ context.Context, _ string, jobID string, _ krci.GitServerSettings,
+) (*models.PipelineJob, error) {
+	return &models.PipelineJob{Id: jobID, Status: "canceled"}, nil
+}
+
 func gitlabSettings() krci.GitServerSettings {
 	return krci.GitServerSettings{GitProvider: "gitlab", GitServerName: "gs"}
 }
 
 func TestIsTerminalJobStatus(t *testing.T) {
-	for _, s := range []string{"success", "failed", "canceled", "skipped"} {
+	for _, s := range []string{"success", "failed", "canceled", "skipped", "failure", "cancelled", "timed_out"} {
 		assert.True(t, isTerminalJobStatus(s), "%q should be terminal", s)
 	}
 
-	for _, s := range []string{"running", "pending", "manual", "created", ""} {
+	for _, s := range []string{"running", "pending", "manual", "created", "queued", "in_progress", "waiting", ""} {
 		assert.False(t, isTerminalJobStatus(s), "%q should not be terminal", s)
 	}
 }
 
 func TestMultiProviderPipelineService_ListPipelineJobs_MarksTerminalJobs(t *testing.T) {
-	svc := NewMultiProviderPipelineService()
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
 	fake := &fakeJobsProvider{jobs: []models.PipelineJob{
 		{Id: "5", Name: "build", Status: "success"},
 		{Id: "6", Name: "test", Status: "running"},
 	}}
 	svc.providers["gitlab"] = fake
 
-	_, err := svc.ListPipelineJobs(context.Background(), "proj", 7, gitlabSettings())
+	_, err := svc.ListPipelineJobs(context.Background(), "proj", "7", gitlabSettings())
 	require.NoError(t, err)
 
 	_, ok := svc.terminalJobs.Get(terminalJobKey("gs", "5"))
@@ -105,15 +145,15 @@ func TestMultiProviderPipelineService_ListPipelineJobs_MarksTerminalJobs(t *test
 }
 
 func TestMultiProviderPipelineService_ListPipelineJobs_CachesResult(t *testing.T) {
-	svc := NewMultiProviderPipelineService()
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
 	fake := &fakeJobsProvider{jobs: []models.PipelineJob{{Id: "1", Name: "build", Status: "success"}}}
 	svc.providers["gitlab"] = fake
 
-	first, err := svc.ListPipelineJobs(context.Background(), "proj", 7, gitlabSettings())
+	first, err := svc.ListPipelineJobs(context.Background(), "proj", "7", gitlabSettings())
 	require.NoError(t, err)
 	assert.Len(t, first, 1)
 
-	second, err := svc.ListPipelineJobs(context.Background(), "proj", 7, gitlabSettings())
+	second, err := svc.ListPipelineJobs(context.Background(), "proj", "7", gitlabSettings())
 	require.NoError(t, err)
 	assert.Equal(t, first, second)
 
@@ -121,16 +161,16 @@ func TestMultiProviderPipelineService_ListPipelineJobs_CachesResult(t *testing.T
 }
 
 func TestMultiProviderPipelineService_GetJobTrace_CachesSmallCompleteTrace(t *testing.T) {
-	svc := NewMultiProviderPipelineService()
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
 	fake := &fakeJobsProvider{traceText: "log line", truncated: false}
 	svc.providers["gitlab"] = fake
 
-	content, truncated, err := svc.GetJobTrace(context.Background(), "proj", 42, gitlabSettings())
+	content, truncated, err := svc.GetJobTrace(context.Background(), "proj", "42", gitlabSettings())
 	require.NoError(t, err)
 	assert.Equal(t, "log line", content)
 	assert.False(t, truncated)
 
-	content2, truncated2, err := svc.GetJobTrace(context.Background(), "proj", 42, gitlabSettings())
+	content2, truncated2, err := svc.GetJobTrace(context.Background(), "proj", "42", gitlabSettings())
 	require.NoError(t, err)
 	assert.Equal(t, content, content2)
 	assert.Equal(t, truncated, truncated2)
@@ -139,31 +179,31 @@ func TestMultiProviderPipelineService_GetJobTrace_CachesSmallCompleteTrace(t *te
 }
 
 func TestMultiProviderPipelineService_GetJobTrace_DoesNotCacheTruncatedTrace(t *testing.T) {
-	svc := NewMultiProviderPipelineService()
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
 	fake := &fakeJobsProvider{traceText: "partial", truncated: true}
 	svc.providers["gitlab"] = fake
 
-	_, _, err := svc.GetJobTrace(context.Background(), "proj", 42, gitlabSettings())
+	_, _, err := svc.GetJobTrace(context.Background(), "proj", "42", gitlabSettings())
 	require.NoError(t, err)
 
-	_, _, err = svc.GetJobTrace(context.Background(), "proj", 42, gitlabSettings())
+	_, _, err = svc.GetJobTrace(context.Background(), "proj", "42", gitlabSettings())
 	require.NoError(t, err)
 
 	assert.Equal(t, 2, fake.traceCalls, "truncated trace must not be cached")
 }
 
 func TestMultiProviderPipelineService_GetJobTrace_DoesNotCacheOversizedTrace(t *testing.T) {
-	svc := NewMultiProviderPipelineService()
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
 	fake := &fakeJobsProvider{
 		traceText: strings.Repeat("a", cache.MaxCacheableTraceBytes+1),
 		truncated: false,
 	}
 	svc.providers["gitlab"] = fake
 
-	_, _, err := svc.GetJobTrace(context.Background(), "proj", 42, gitlabSettings())
+	_, _, err := svc.GetJobTrace(context.Background(), "proj", "42", gitlabSettings())
 	require.NoError(t, err)
 
-	_, _, err = svc.GetJobTrace(context.Background(), "proj", 42, gitlabSettings())
+	_, _, err = svc.GetJobTrace(context.Background(), "proj", "42", gitlabSettings())
 	require.NoError(t, err)
 
 	assert.Equal(t, 2, fake.traceCalls, "trace larger than the cache cap must not be cached")
@@ -175,7 +215,7 @@ func TestMultiProviderPipelineService_GetJobTrace_DoesNotCacheOversizedTrace(t *
 // clock-controlled cache, prove the terminal snapshot is served within the TTL, then advance past
 // the TTL and prove the resurrected pipeline is fetched fresh.
 func TestMultiProviderPipelineService_ListPipelineJobs_TerminalNotCachedLong(t *testing.T) {
-	svc := NewMultiProviderPipelineService()
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
 	fake := &fakeJobsProvider{jobs: []models.PipelineJob{{Id: "1", Name: "build", Status: "success"}}}
 	svc.providers["gitlab"] = fake
 
@@ -184,7 +224,7 @@ func TestMultiProviderPipelineService_ListPipelineJobs_TerminalNotCachedLong(t *
 	svc.jobsCache = sturdyc.New[[]models.PipelineJob](100, 4, ttl, 10,
 		sturdyc.WithClock(clock), sturdyc.WithNoContinuousEvictions())
 
-	first, err := svc.ListPipelineJobs(context.Background(), "proj", 7, gitlabSettings())
+	first, err := svc.ListPipelineJobs(context.Background(), "proj", "7", gitlabSettings())
 	require.NoError(t, err)
 	assert.Len(t, first, 1)
 	assert.Equal(t, 1, fake.listCalls)
@@ -195,14 +235,14 @@ func TestMultiProviderPipelineService_ListPipelineJobs_TerminalNotCachedLong(t *
 		{Id: "2", Name: "build", Status: "running"},
 	}
 
-	within, err := svc.ListPipelineJobs(context.Background(), "proj", 7, gitlabSettings())
+	within, err := svc.ListPipelineJobs(context.Background(), "proj", "7", gitlabSettings())
 	require.NoError(t, err)
 	assert.Len(t, within, 1, "served from cache within TTL")
 	assert.Equal(t, 1, fake.listCalls)
 
 	clock.Add(ttl + time.Second)
 
-	after, err := svc.ListPipelineJobs(context.Background(), "proj", 7, gitlabSettings())
+	after, err := svc.ListPipelineJobs(context.Background(), "proj", "7", gitlabSettings())
 	require.NoError(t, err)
 	assert.Len(t, after, 2, "resurrected pipeline must be re-fetched after the short TTL")
 	assert.Equal(t, 2, fake.listCalls)
@@ -211,7 +251,7 @@ func TestMultiProviderPipelineService_ListPipelineJobs_TerminalNotCachedLong(t *
 // TestMultiProviderPipelineService_ListPipelineJobs_DeduplicatesConcurrent proves a burst of
 // concurrent requests for the same uncached pipeline collapses to a single provider call.
 func TestMultiProviderPipelineService_ListPipelineJobs_DeduplicatesConcurrent(t *testing.T) {
-	svc := NewMultiProviderPipelineService()
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
 	fake := &fakeJobsProvider{
 		jobs:  []models.PipelineJob{{Id: "1", Name: "build", Status: "running"}},
 		block: make(chan struct{}),
@@ -228,7 +268,7 @@ func TestMultiProviderPipelineService_ListPipelineJobs_DeduplicatesConcurrent(t
 		go func() {
 			defer wg.Done()
 
-			_, _ = svc.ListPipelineJobs(context.Background(), "proj", 7, gitlabSettings())
+			_, _ = svc.ListPipelineJobs(context.Background(), "proj", "7", gitlabSettings())
 		}()
 	}
 
@@ -245,7 +285,7 @@ func TestMultiProviderPipelineService_ListPipelineJobs_DeduplicatesConcurrent(t
 // TestMultiProviderPipelineService_GetJobTrace_DeduplicatesConcurrent proves the same for traces,
 // which de-duplicate via singleflight rather than sturdyc's GetOrFetch.
 func TestMultiProviderPipelineService_GetJobTrace_DeduplicatesConcurrent(t *testing.T) {
-	svc := NewMultiProviderPipelineService()
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
 	fake := &fakeJobsProvider{traceText: "log", truncated: false, block: make(chan struct{})}
 	svc.providers["gitlab"] = fake
 
@@ -259,7 +299,7 @@ func TestMultiProviderPipelineService_GetJobTrace_DeduplicatesConcurrent(t *test
 		go func() {
 			defer wg.Done()
 
-			_, _, _ = svc.GetJobTrace(context.Background(), "proj", 42, gitlabSettings())
+			_, _, _ = svc.GetJobTrace(context.Background(), "proj", "42", gitlabSettings())
 		}()
 	}
 
@@ -271,3 +311,122 @@ func TestMultiProviderPipelineService_GetJobTrace_DeduplicatesConcurrent(t *test
 	defer fake.mu.Unlock()
 	assert.Equal(t, 1, fake.traceCalls, "concurrent trace misses must collapse to a single fetch")
 }
+
+func TestMultiProviderPipelineService_PipelineActions_EvictCaches(t *testing.T) {
+	for _, action := range []string{"cancel", "retry"} {
+		t.Run(action, func(t *testing.T) {
+			svc := NewMultiProviderPipelineService(registry.NewDefault())
+			fake := &fakeJobsProvider{jobs: []models.PipelineJob{{Id: "1", Name: "build", Status: "running"}}}
+			svc.providers["gitlab"] = fake
+
+			_, err := svc.ListPipelineJobs(context.Background(), "proj", "7", gitlabSettings())
+			require.NoError(t, err)
+
+			svc.cache.Set("gs|proj|main||1|20", models.PipelinesResponse{})
+			svc.cache.Set("gs|proj|||2|20", models.PipelinesResponse{})
+			svc.cache.Set("gs|other|||1|20", models.PipelinesResponse{})
+			svc.cache.Set("other-gs|proj|||1|20", models.PipelinesResponse{})
+
+			if action == "cancel" {
+				_, err = svc.CancelPipeline(context.Background(), "proj", "7", gitlabSettings())
+			} else {
+				_, err = svc.RetryPipeline(context.Background(), "proj", "7", gitlabSettings(), models.PipelineRetryOptions{})
+			}
+
+			require.NoError(t, err)
+
+			assert.ElementsMatch(t, []string{"gs|other|||1|20", "other-gs|proj|||1|20"}, svc.cache.ScanKeys(),
+				"only the project's pipeline lists should be evicted")
+
+			_, err = svc.ListPipelineJobs(context.Background(), "proj", "7", gitlabSettings())
+			require.NoError(t, err)
+			assert.Equal(t, 2, fake.listCalls, "jobs of the pipeline should be refetched after the action")
+		})
+	}
+}
+
+func TestMultiProviderPipelineService_ListPipelines_CachesPerFilter(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+	svc.providers["gitlab"] = &fakeJobsProvider{}
+
+	sha, user := "abc", "jdoe"
+	after := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, opts := range []models.PipelineListOptions{
+		{Page: 1, PerPage: 20},
+		{Sha: &sha, Page: 1, PerPage: 20},
+		{TriggeredBy: &user, Page: 1, PerPage: 20},
+		{UpdatedAfter: &after, Page: 1, PerPage: 20},
+		{Ascending: true, Page: 1, PerPage: 20},
+	} {
+		_, err := svc.ListPipelines(context.Background(), "proj", gitlabSettings(), opts)
+		require.NoError(t, err)
+	}
+
+	keys := svc.cache.ScanKeys()
+	assert.Len(t, keys, 5, "each filter combination should be cached on its own")
+
+	for _, key := range keys {
+		assert.True(t, strings.HasPrefix(key, "gs|proj|"), "pipeline actions evict lists by this prefix")
+	}
+}
+
+func TestMultiProviderPipelineService_GetPipeline_SummarizesStages(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+	fake := &fakeJobsProvider{jobs: []models.PipelineJob{
+		{Id: "1", Name: "compile", Stage: "build", Status: "success"},
+		{Id: "2", Name: "unit", Stage: "test", Status: "running"},
+		{Id: "3", Name: "lint", Stage: "test", Status: "success"},
+	}}
+	svc.providers["gitlab"] = fake
+
+	pipeline, err := svc.GetPipeline(context.Background(), "proj", "7", gitlabSettings())
+	require.NoError(t, err)
+
+	require.NotNil(t, pipeline.Stages)
+	assert.Equal(t, []models.PipelineStage{
+		{Name: "build", Status: "success", Jobs: 1},
+		{Name: "test", Status: "running", Jobs: 2},
+	}, *pipeline.Stages)
+
+	_, err = svc.ListPipelineJobs(context.Background(), "proj", "7", gitlabSettings())
+	require.NoError(t, err)
+	assert.Equal(t, 1, fake.listCalls, "stages should be built from the cached job list")
+}
+
+func TestMultiProviderPipelineService_RetryJob_ForgetsTerminalJob(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+	fake := &fakeJobsProvider{
+		jobs:      []models.PipelineJob{{Id: "5", Name: "build", Status: "failed"}},
+		traceText: "old attempt",
+	}
+	svc.providers["gitlab"] = fake
+
+	_, err := svc.ListPipelineJobs(context.Background(), "proj", "7", gitlabSettings())
+	require.NoError(t, err)
+
+	_, _, err = svc.GetJobTrace(context.Background(), "proj", "5", gitlabSettings())
+	require.NoError(t, err)
+
+	job, err := svc.RetryJob(context.Background(), "proj", "5", gitlabSettings())
+	require.NoError(t, err)
+	assert.Equal(t, "99", job.Id)
+
+	_, ok := svc.terminalJobs.Get(terminalJobKey("gs", "5"))
+	assert.False(t, ok, "retried job should no longer be marked terminal")
+
+	_, ok = svc.traceCache.Get("gs|proj|5")
+	assert.False(t, ok, "retried job's trace should be evicted")
+
+	_, ok = svc.jobsCache.Get("gs|proj|7")
+	assert.False(t, ok, "the job's pipeline should be evicted when the provider reports it")
+}
+
+func TestMultiProviderPipelineService_JobActions_UnsupportedProviderReturnsBadRequest(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+
+	_, err := svc.PlayJob(context.Background(), "owner/repo", "1",
+		krci.GitServerSettings{GitProvider: "bitbucket"}, models.PipelineJobPlayOptions{})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
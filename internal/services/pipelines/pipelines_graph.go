@@ -0,0 +1,60 @@
+package pipelines
+
+import (
+	"context"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// GetPipelineGraph returns a pipeline with the pipelines it started, resolved up to depth levels
+// below it, and aggregates the status of every node over the node and its descendants.
+func (m *MultiProviderPipelineService) GetPipelineGraph(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+	depth int,
+) (*models.PipelineGraphNode, error) {
+	graphProvider, err := capability[PipelineGraphProvider](m, settings.GitProvider, "pipeline graphs")
+	if err != nil {
+		return nil, err
+	}
+
+	root, err := graphProvider.GetPipelineGraph(ctx, project, pipelineID, settings, depth)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregateGraphStatus(root)
+
+	return root, nil
+}
+
+// aggregateGraphStatus sets the status of node and its descendants and returns node's. A node
+// contributes its pipeline's status, or the status of its jobs when it has no pipeline of its own
+// (a GitHub reusable-workflow call); the statuses are reduced like the jobs of a stage.
+func aggregateGraphStatus(node *models.PipelineGraphNode) models.PipelineStatus {
+	statuses := make([]models.PipelineStatus, 0, len(node.Children)+1)
+
+	switch {
+	case node.Pipeline != nil:
+		statuses = append(statuses, node.Pipeline.Status)
+	case len(node.Jobs) > 0:
+		jobStatuses := make([]models.PipelineStatus, 0, len(node.Jobs))
+		for i := range node.Jobs {
+			jobStatuses = append(jobStatuses, jobOutcome(&node.Jobs[i]))
+		}
+
+		statuses = append(statuses, aggregateStageStatus(jobStatuses))
+	}
+
+	for i := range node.Children {
+		statuses = append(statuses, aggregateGraphStatus(&node.Children[i]))
+	}
+
+	status := aggregateStageStatus(statuses)
+	node.Status = string(status)
+
+	return status
+}
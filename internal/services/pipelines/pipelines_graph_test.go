@@ -0,0 +1,52 @@
+package pipelines
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+)
+
+func TestAggregateGraphStatus(t *testing.T) {
+	allowFailure := true
+
+	root := &models.PipelineGraphNode{
+		Kind:     models.Root,
+		Pipeline: &models.Pipeline{Status: models.PipelineStatusSuccess},
+		Children: []models.PipelineGraphNode{
+			{
+				Kind:     models.Child,
+				Pipeline: &models.Pipeline{Status: models.PipelineStatusSuccess},
+				Children: []models.PipelineGraphNode{
+					{Kind: models.MultiProject, Pipeline: &models.Pipeline{Status: models.PipelineStatusFailed}},
+				},
+			},
+			{
+				Kind: models.ReusableWorkflow,
+				Jobs: []models.PipelineJob{
+					{Name: "call / lint", Status: "failure", AllowFailure: &allowFailure},
+					{Name: "call / test", Status: "success"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, models.PipelineStatusFailed, aggregateGraphStatus(root))
+	assert.Equal(t, "failed", root.Status, "a failed grandchild fails the root")
+	assert.Equal(t, "failed", root.Children[0].Status)
+	assert.Equal(t, "failed", root.Children[0].Children[0].Status)
+	assert.Equal(t, "success", root.Children[1].Status, "a job allowed to fail does not fail its call")
+}
+
+func TestMultiProviderPipelineService_GetPipelineGraph_UnsupportedProviderReturnsBadRequest(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+
+	_, err := svc.GetPipelineGraph(context.Background(), "owner/repo", "7",
+		krci.GitServerSettings{GitProvider: "bitbucket"}, 2)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
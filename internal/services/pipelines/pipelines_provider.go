@@ -3,7 +3,8 @@ package pipelines
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"strings"
+	"time"
 
 	"github.com/viccon/sturdyc"
 	"golang.org/x/sync/singleflight"
@@ -11,19 +12,17 @@ import (
 	"github.com/KubeRocketCI/gitfusion/internal/cache"
 	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
-	"github.com/KubeRocketCI/gitfusion/internal/services/bitbucket"
-	"github.com/KubeRocketCI/gitfusion/internal/services/github"
-	"github.com/KubeRocketCI/gitfusion/internal/services/gitlab"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
 )
 
 type PipelineProvider interface {
 	TriggerPipeline(
 		ctx context.Context,
 		project string,
-		ref string,
-		variables []models.PipelineVariable,
 		settings krci.GitServerSettings,
+		opts models.PipelineTriggerOptions,
 	) (*models.PipelineResponse, error)
 
 	ListPipelines(
@@ -40,58 +39,299 @@ type PipelineJobsProvider interface {
 	ListPipelineJobs(
 		ctx context.Context,
 		project string,
-		pipelineID int,
+		pipelineID string,
 		settings krci.GitServerSettings,
 	) ([]models.PipelineJob, error)
 
 	GetJobTrace(
 		ctx context.Context,
 		project string,
-		jobID int,
+		jobID string,
 		settings krci.GitServerSettings,
 	) (content string, truncated bool, err error)
 }
 
+// PipelineCancelProvider is an optional capability for cancelling a running pipeline; like
+// PipelineJobsProvider, providers without it make the dispatch return a bad-request error.
+type PipelineCancelProvider interface {
+	CancelPipeline(
+		ctx context.Context,
+		project string,
+		pipelineID string,
+		settings krci.GitServerSettings,
+	) (*models.PipelineActionResponse, error)
+}
+
+// PipelineRetryProvider is an optional capability for re-running a finished pipeline in place.
+type PipelineRetryProvider interface {
+	RetryPipeline(
+		ctx context.Context,
+		project string,
+		pipelineID string,
+		settings krci.GitServerSettings,
+		opts models.PipelineRetryOptions,
+	) (*models.PipelineActionResponse, error)
+}
+
+// PipelineJobPlayProvider is an optional capability for starting a job that waits for a manual
+// action. Like the other job actions it returns the resulting job.
+type PipelineJobPlayProvider interface {
+	PlayJob(
+		ctx context.Context,
+		project string,
+		jobID string,
+		settings krci.GitServerSettings,
+		opts models.PipelineJobPlayOptions,
+	) (*models.PipelineJob, error)
+}
+
+// PipelineJobRetryProvider is an optional capability for retrying a finished job. The returned
+// job is a new job when the provider retries by copy.
+type PipelineJobRetryProvider interface {
+	RetryJob(
+		ctx context.Context,
+		project string,
+		jobID string,
+		settings krci.GitServerSettings,
+	) (*models.PipelineJob, error)
+}
+
+// PipelineJobCancelProvider is an optional capability for cancelling a single running job;
+// providers that only cancel whole pipelines implement PipelineCancelProvider alone.
+type PipelineJobCancelProvider interface {
+	CancelJob(
+		ctx context.Context,
+		project string,
+		jobID string,
+		settings krci.GitServerSettings,
+	) (*models.PipelineJob, error)
+}
+
+// PipelineDetailProvider is an optional capability for fetching a single pipeline with the
+// details the list omits (duration, triggering user, commit title, coverage). Stages are not
+// part of it: the service aggregates them from the pipeline's jobs.
+type PipelineDetailProvider interface {
+	GetPipeline(
+		ctx context.Context,
+		project string,
+		pipelineID string,
+		settings krci.GitServerSettings,
+	) (*models.Pipeline, error)
+}
+
+// PipelineJobTraceStreamProvider is an optional capability for reading a job trace from a byte
+// offset, which lets trace streams poll only for new bytes.
+type PipelineJobTraceStreamProvider interface {
+	GetJobTraceRange(
+		ctx context.Context,
+		project string,
+		jobID string,
+		settings krci.GitServerSettings,
+		offset int64,
+	) (*models.JobTraceRange, error)
+}
+
+// PipelineJobTraceWindowProvider is an optional capability for reading any window of a job trace,
+// including the parts past the provider's read cap. Providers without it have windows cut from the
+// capped trace returned by PipelineJobsProvider.GetJobTrace.
+type PipelineJobTraceWindowProvider interface {
+	GetJobTraceWindow(
+		ctx context.Context,
+		project string,
+		jobID string,
+		settings krci.GitServerSettings,
+		w models.JobTraceWindow,
+	) (*models.JobTrace, error)
+}
+
+// PipelineTestReportProvider is an optional capability for reading the test report of a pipeline.
+// The report carries the pipeline status read before it, so a report of a finished pipeline can
+// be cached long.
+type PipelineTestReportProvider interface {
+	GetPipelineTestReport(
+		ctx context.Context,
+		project string,
+		pipelineID string,
+		settings krci.GitServerSettings,
+	) (*models.PipelineTestReport, error)
+}
+
+// PipelineArtifactsProvider is an optional capability for listing the artifacts of a pipeline and
+// downloading one of them.
+type PipelineArtifactsProvider interface {
+	ListPipelineArtifacts(
+		ctx context.Context,
+		project string,
+		pipelineID string,
+		settings krci.GitServerSettings,
+		opts models.PipelineArtifactListOptions,
+	) ([]models.PipelineArtifact, error)
+
+	DownloadArtifact(
+		ctx context.Context,
+		project string,
+		artifactID string,
+		settings krci.GitServerSettings,
+	) (*models.ArtifactDownload, error)
+}
+
+// PipelineSchedulesProvider is an optional capability for reading the schedules that run a
+// project's pipelines periodically.
+type PipelineSchedulesProvider interface {
+	ListPipelineSchedules(
+		ctx context.Context,
+		project string,
+		settings krci.GitServerSettings,
+	) ([]models.PipelineSchedule, error)
+
+	GetPipelineSchedule(
+		ctx context.Context,
+		project string,
+		scheduleID string,
+		settings krci.GitServerSettings,
+	) (*models.PipelineSchedule, error)
+}
+
+// PipelineScheduleActionsProvider is an optional capability for managing pipeline schedules;
+// providers whose schedules are defined in files only implement PipelineSchedulesProvider.
+type PipelineScheduleActionsProvider interface {
+	CreatePipelineSchedule(
+		ctx context.Context,
+		project string,
+		settings krci.GitServerSettings,
+		opts models.PipelineScheduleOptions,
+	) (*models.PipelineSchedule, error)
+
+	UpdatePipelineSchedule(
+		ctx context.Context,
+		project string,
+		scheduleID string,
+		settings krci.GitServerSettings,
+		opts models.PipelineScheduleOptions,
+	) (*models.PipelineSchedule, error)
+
+	DeletePipelineSchedule(
+		ctx context.Context,
+		project string,
+		scheduleID string,
+		settings krci.GitServerSettings,
+	) error
+
+	RunPipelineSchedule(
+		ctx context.Context,
+		project string,
+		scheduleID string,
+		settings krci.GitServerSettings,
+	) error
+}
+
+// PipelineGraphProvider is an optional capability for returning a pipeline together with the
+// pipelines it started, such as GitLab child and multi-project pipelines.
+type PipelineGraphProvider interface {
+	// GetPipelineGraph returns the pipeline as the root of a graph resolved up to depth levels
+	// below it. Node statuses are left empty; the service aggregates them.
+	GetPipelineGraph(
+		ctx context.Context,
+		project string,
+		pipelineID string,
+		settings krci.GitServerSettings,
+		depth int,
+	) (*models.PipelineGraphNode, error)
+}
+
+// PullRequestPipelinesProvider is an optional capability for listing the pipelines run for a pull
+// request.
+type PullRequestPipelinesProvider interface {
+	// ListPullRequestPipelines returns a page of the pipelines of the pull request with the given
+	// number, newest first; opts carries the page only.
+	ListPullRequestPipelines(
+		ctx context.Context,
+		project string,
+		pullRequest int,
+		settings krci.GitServerSettings,
+		opts models.PipelineListOptions,
+	) (*models.PipelinesResponse, error)
+}
+
 type MultiProviderPipelineService struct {
 	providers  map[string]PipelineProvider
 	cache      *sturdyc.Client[models.PipelinesResponse]
 	jobsCache  *sturdyc.Client[[]models.PipelineJob]
 	traceCache *cache.TerminalAwareCache[cache.JobTrace]
 
+	testReportCache *cache.TerminalAwareCache[models.PipelineTestReport]
+
 	// terminalJobs lets GetJobTrace (which receives only a job ID) tell whether a trace is final.
 	terminalJobs *sturdyc.Client[bool]
 
 	// traceGroup de-duplicates concurrent trace fetches; sturdyc does not de-duplicate the trace
 	// cache's Get/Set path (the jobs cache gets de-duplication from GetOrFetch).
 	traceGroup singleflight.Group
+
+	// testReportGroup de-duplicates concurrent test report fetches, which may download artifacts.
+	testReportGroup singleflight.Group
+
+	// traceStreams shares one upstream poller between the viewers of a streamed job trace.
+	traceStreams *traceStreamHub
 }
 
-func NewMultiProviderPipelineService() *MultiProviderPipelineService {
+func NewMultiProviderPipelineService(reg *registry.Registry) *MultiProviderPipelineService {
 	return &MultiProviderPipelineService{
-		providers: map[string]PipelineProvider{
-			"gitlab":    gitlab.NewGitlabProvider(),
-			"github":    github.NewGitHubProvider(),
-			"bitbucket": bitbucket.NewBitbucketProvider(),
-		},
+		providers:    registry.Providers[PipelineProvider](reg, registry.CapabilityPipelines),
 		cache:        cache.NewPipelineCache(),
 		jobsCache:    cache.NewPipelineJobsCache(),
 		traceCache:   cache.NewPipelineJobTraceCache(),
 		terminalJobs: cache.NewTerminalJobsCache(),
+		traceStreams: newTraceStreamHub(),
+
+		testReportCache: cache.NewPipelineTestReportCache(),
 	}
 }
 
-// terminalJobStatuses are GitLab job statuses that never change again (a retry yields a new job ID).
+// terminalJobStatuses are provider-native job statuses that never change again (a retry yields a
+// new job ID): GitLab statuses, GitHub Actions conclusions, Bitbucket step results and Azure
+// Pipelines timeline results. Values shared between providers ("success", "skipped", "failed")
+// are listed once.
 var terminalJobStatuses = map[string]bool{
 	"success":  true,
 	"failed":   true,
 	"canceled": true,
 	"skipped":  true,
+
+	"failure":         true,
+	"cancelled":       true,
+	"timed_out":       true,
+	"neutral":         true,
+	"action_required": true,
+	"stale":           true,
+	"startup_failure": true,
+
+	"successful": true,
+	"error":      true,
+	"stopped":    true,
+	"not_run":    true,
+
+	"succeeded":           true,
+	"succeededWithIssues": true,
+	"abandoned":           true,
 }
 
 func isTerminalJobStatus(status string) bool {
 	return terminalJobStatuses[status]
 }
 
+// isTerminalPipelineStatus reports whether a normalized pipeline status is final. "manual" is not:
+// the pipeline continues once its manual jobs are played.
+func isTerminalPipelineStatus(status models.PipelineStatus) bool {
+	switch status {
+	case models.PipelineStatusSuccess, models.PipelineStatusFailed,
+		models.PipelineStatusCancelled, models.PipelineStatusSkipped:
+		return true
+	default:
+		return false
+	}
+}
+
 // terminalJobKey namespaces the marker by git server so job IDs from different instances can't collide.
 func terminalJobKey(gitServerName string, jobID string) string {
 	return fmt.Sprintf("%s|%s", gitServerName, jobID)
@@ -100,16 +340,15 @@ func terminalJobKey(gitServerName string, jobID string) string {
 func (m *MultiProviderPipelineService) TriggerPipeline(
 	ctx context.Context,
 	project string,
-	ref string,
-	variables []models.PipelineVariable,
 	settings krci.GitServerSettings,
+	opts models.PipelineTriggerOptions,
 ) (*models.PipelineResponse, error) {
 	provider, ok := m.providers[settings.GitProvider]
 	if !ok {
 		return nil, fmt.Errorf("unsupported provider %s: %w", settings.GitProvider, gferrors.ErrBadRequest)
 	}
 
-	return provider.TriggerPipeline(ctx, project, ref, variables, settings)
+	return provider.TriggerPipeline(ctx, project, settings, opts)
 }
 
 func (m *MultiProviderPipelineService) ListPipelines(
@@ -123,17 +362,12 @@ func (m *MultiProviderPipelineService) ListPipelines(
 		return nil, fmt.Errorf("unsupported provider %s: %w", settings.GitProvider, gferrors.ErrBadRequest)
 	}
 
-	refKey := ""
-	if opts.Ref != nil {
-		refKey = *opts.Ref
-	}
-
-	statusKey := ""
-	if opts.Status != nil {
-		statusKey = *opts.Status
-	}
-
-	key := fmt.Sprintf("%s|%s|%s|%s|%d|%d", settings.GitServerName, project, refKey, statusKey, opts.Page, opts.PerPage)
+	key := fmt.Sprintf("%s|%s|%s|%s|%s|%s|%s|%s|%s|%t|%d|%d",
+		settings.GitServerName, project,
+		pointer.ValueOrEmpty(opts.Ref), pointer.ValueOrEmpty(opts.Status), pointer.ValueOrEmpty(opts.Sha),
+		pointer.ValueOrEmpty(opts.Source), pointer.ValueOrEmpty(opts.TriggeredBy),
+		timeKey(opts.UpdatedAfter), timeKey(opts.UpdatedBefore),
+		opts.Ascending, opts.Page, opts.PerPage)
 
 	fetchFn := func(ctx context.Context) (models.PipelinesResponse, error) {
 		resp, err := provider.ListPipelines(ctx, project, settings, opts)
@@ -152,20 +386,29 @@ func (m *MultiProviderPipelineService) ListPipelines(
 	return &result, nil
 }
 
+// timeKey formats an optional time filter for a cache key.
+func timeKey(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.UTC().Format(time.RFC3339Nano)
+}
+
 // ListPipelineJobs serves a pipeline's jobs from a short-TTL cache (GetOrFetch de-duplicates
 // concurrent misses) and records finished jobs so GetJobTrace can long-cache their traces.
 func (m *MultiProviderPipelineService) ListPipelineJobs(
 	ctx context.Context,
 	project string,
-	pipelineID int,
+	pipelineID string,
 	settings krci.GitServerSettings,
 ) ([]models.PipelineJob, error) {
-	jobsProvider, err := m.jobsProvider(settings.GitProvider)
+	jobsProvider, err := capability[PipelineJobsProvider](m, settings.GitProvider, "pipeline jobs")
 	if err != nil {
 		return nil, err
 	}
 
-	key := fmt.Sprintf("%s|%s|%d", settings.GitServerName, project, pipelineID)
+	key := fmt.Sprintf("%s|%s|%s", settings.GitServerName, project, pipelineID)
 
 	return m.jobsCache.GetOrFetch(ctx, key, func(ctx context.Context) ([]models.PipelineJob, error) {
 		jobs, err := jobsProvider.ListPipelineJobs(ctx, project, pipelineID, settings)
@@ -193,15 +436,15 @@ func (m *MultiProviderPipelineService) markTerminalJobs(gitServerName string, jo
 func (m *MultiProviderPipelineService) GetJobTrace(
 	ctx context.Context,
 	project string,
-	jobID int,
+	jobID string,
 	settings krci.GitServerSettings,
 ) (string, bool, error) {
-	jobsProvider, err := m.jobsProvider(settings.GitProvider)
+	jobsProvider, err := capability[PipelineJobsProvider](m, settings.GitProvider, "pipeline jobs")
 	if err != nil {
 		return "", false, err
 	}
 
-	key := fmt.Sprintf("%s|%s|%d", settings.GitServerName, project, jobID)
+	key := fmt.Sprintf("%s|%s|%s", settings.GitServerName, project, jobID)
 
 	if cached, ok := m.traceCache.Get(key); ok {
 		return cached.Content, cached.Truncated, nil
@@ -222,7 +465,7 @@ func (m *MultiProviderPipelineService) GetJobTrace(
 		trace := cache.JobTrace{Content: content, Truncated: truncated}
 
 		if !truncated && len(content) <= cache.MaxCacheableTraceBytes {
-			_, terminal := m.terminalJobs.Get(terminalJobKey(settings.GitServerName, strconv.Itoa(jobID)))
+			_, terminal := m.terminalJobs.Get(terminalJobKey(settings.GitServerName, jobID))
 			m.traceCache.Set(key, trace, terminal)
 		}
 
@@ -237,20 +480,371 @@ func (m *MultiProviderPipelineService) GetJobTrace(
 	return trace.Content, trace.Truncated, nil
 }
 
-// jobsProvider resolves a provider that supports per-pipeline jobs/logs, or a
-// bad-request error if the configured provider doesn't (e.g. GitHub/Bitbucket).
-func (m *MultiProviderPipelineService) jobsProvider(gitProvider string) (PipelineJobsProvider, error) {
+// GetJobTraceWindow serves a window of a job's trace. A trace cached complete by GetJobTrace
+// serves every window; otherwise the whole-trace window goes through GetJobTrace, and other windows
+// are read uncached from providers that implement PipelineJobTraceWindowProvider or cut from the
+// capped trace GetJobTrace returns.
+func (m *MultiProviderPipelineService) GetJobTraceWindow(
+	ctx context.Context,
+	project string,
+	jobID string,
+	settings krci.GitServerSettings,
+	w models.JobTraceWindow,
+) (*models.JobTrace, error) {
+	jobsProvider, err := capability[PipelineJobsProvider](m, settings.GitProvider, "pipeline jobs")
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s|%s|%s", settings.GitServerName, project, jobID)
+
+	if cached, ok := m.traceCache.Get(key); ok && !cached.Truncated {
+		return cutTraceWindow(cached.Content, true, w)
+	}
+
+	if windowProvider, ok := jobsProvider.(PipelineJobTraceWindowProvider); ok && w != (models.JobTraceWindow{}) {
+		return windowProvider.GetJobTraceWindow(ctx, project, jobID, settings, w)
+	}
+
+	content, truncated, err := m.GetJobTrace(ctx, project, jobID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return cutTraceWindow(content, !truncated, w)
+}
+
+// GetStructuredJobTrace reads a window of a job's trace like GetJobTraceWindow and parses it into
+// lines and sections. The structure of a finished job's whole trace is cached with the raw trace,
+// so it is parsed once.
+func (m *MultiProviderPipelineService) GetStructuredJobTrace(
+	ctx context.Context,
+	project string,
+	jobID string,
+	settings krci.GitServerSettings,
+	w models.JobTraceWindow,
+	stripANSI bool,
+) (*models.JobTrace, error) {
+	trace, err := m.GetJobTraceWindow(ctx, project, jobID, settings, w)
+	if err != nil {
+		return nil, err
+	}
+
+	structure := m.traceStructure(settings.GitServerName, project, jobID, w, trace)
+	if stripANSI {
+		structure = withoutSpans(structure)
+	}
+
+	trace.Content = ""
+	trace.Structure = &structure
+
+	return trace, nil
+}
+
+// traceStructure parses trace, a window w of a job's trace, reusing and filling the structure
+// cached with a finished job's whole trace.
+func (m *MultiProviderPipelineService) traceStructure(
+	gitServerName string,
+	project string,
+	jobID string,
+	w models.JobTraceWindow,
+	trace *models.JobTrace,
+) models.PipelineJobTraceStructure {
+	if w != (models.JobTraceWindow{}) || trace.Truncated {
+		return parseTrace(trace.Content)
+	}
+
+	key := fmt.Sprintf("%s|%s|%s", gitServerName, project, jobID)
+
+	cached, ok := m.traceCache.Get(key)
+	if ok && cached.Structure != nil {
+		return *cached.Structure
+	}
+
+	structure := parseTrace(trace.Content)
+
+	if _, terminal := m.terminalJobs.Get(terminalJobKey(gitServerName, jobID)); ok && terminal {
+		cached.Structure = &structure
+		m.traceCache.Set(key, cached, true)
+	}
+
+	return structure
+}
+
+// GetPipeline fetches a single pipeline uncached and adds a stage summary aggregated from its
+// jobs. The jobs come from the short-TTL jobs cache, so right after a state change the stages
+// may briefly lag behind the pipeline status.
+func (m *MultiProviderPipelineService) GetPipeline(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+) (*models.Pipeline, error) {
+	detailProvider, err := capability[PipelineDetailProvider](m, settings.GitProvider, "pipeline details")
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := detailProvider.GetPipeline(ctx, project, pipelineID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := detailProvider.(PipelineJobsProvider); !ok {
+		return pipeline, nil
+	}
+
+	jobs, err := m.ListPipelineJobs(ctx, project, pipelineID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	stages := summarizeStages(jobs)
+	pipeline.Stages = &stages
+
+	return pipeline, nil
+}
+
+// GetPipelineTestReport serves the test report of a pipeline. Reports of finished pipelines are
+// cached in the done tier, others briefly; concurrent misses share one provider read.
+func (m *MultiProviderPipelineService) GetPipelineTestReport(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineTestReport, error) {
+	testReportProvider, err := capability[PipelineTestReportProvider](m, settings.GitProvider, "pipeline test reports")
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s|%s|%s", settings.GitServerName, project, pipelineID)
+
+	if cached, ok := m.testReportCache.Get(key); ok {
+		return &cached, nil
+	}
+
+	v, err, _ := m.testReportGroup.Do(key, func() (any, error) {
+		if cached, ok := m.testReportCache.Get(key); ok {
+			return &cached, nil
+		}
+
+		report, ferr := testReportProvider.GetPipelineTestReport(ctx, project, pipelineID, settings)
+		if ferr != nil {
+			return nil, ferr
+		}
+
+		m.testReportCache.Set(key, *report, isTerminalPipelineStatus(models.PipelineStatus(report.PipelineStatus)))
+
+		return report, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	report, _ := v.(*models.PipelineTestReport)
+
+	return report, nil
+}
+
+// StreamJobTrace streams a job trace from offset on. Viewers of the same job share one poller;
+// an error that ends the poller at its first read is returned directly, later ones end the stream
+// with an error event. The returned channel is closed when the stream ends or ctx is cancelled.
+func (m *MultiProviderPipelineService) StreamJobTrace(
+	ctx context.Context,
+	project string,
+	jobID string,
+	settings krci.GitServerSettings,
+	offset int64,
+) (<-chan models.PipelineJobTraceEvent, error) {
+	streamProvider, err := capability[PipelineJobTraceStreamProvider](m, settings.GitProvider, "job trace streaming")
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s|%s|%s", settings.GitServerName, project, jobID)
+
+	read := func(ctx context.Context, offset int64) (*models.JobTraceRange, error) {
+		return streamProvider.GetJobTraceRange(ctx, project, jobID, settings, offset)
+	}
+
+	stream := m.traceStreams.subscribe(key, offset, read)
+
+	if err := stream.waitFirstPoll(ctx); err != nil {
+		m.traceStreams.unsubscribe(key, stream)
+
+		return nil, err
+	}
+
+	events := make(chan models.PipelineJobTraceEvent)
+
+	go func() {
+		defer m.traceStreams.unsubscribe(key, stream)
+
+		stream.follow(ctx, offset, events)
+	}()
+
+	return events, nil
+}
+
+// CancelPipeline cancels a pipeline and evicts its cached pipeline lists and jobs.
+func (m *MultiProviderPipelineService) CancelPipeline(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineActionResponse, error) {
+	cancelProvider, err := capability[PipelineCancelProvider](m, settings.GitProvider, "cancelling pipelines")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := cancelProvider.CancelPipeline(ctx, project, pipelineID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	m.evictPipeline(settings.GitServerName, project, pipelineID)
+
+	return resp, nil
+}
+
+// RetryPipeline retries a pipeline and evicts its cached pipeline lists and jobs.
+func (m *MultiProviderPipelineService) RetryPipeline(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+	opts models.PipelineRetryOptions,
+) (*models.PipelineActionResponse, error) {
+	retryProvider, err := capability[PipelineRetryProvider](m, settings.GitProvider, "retrying pipelines")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := retryProvider.RetryPipeline(ctx, project, pipelineID, settings, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	m.evictPipeline(settings.GitServerName, project, pipelineID)
+
+	return resp, nil
+}
+
+// PlayJob starts a manual job and evicts the caches that still describe it as waiting.
+func (m *MultiProviderPipelineService) PlayJob(
+	ctx context.Context,
+	project string,
+	jobID string,
+	settings krci.GitServerSettings,
+	opts models.PipelineJobPlayOptions,
+) (*models.PipelineJob, error) {
+	playProvider, err := capability[PipelineJobPlayProvider](m, settings.GitProvider, "playing jobs")
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := playProvider.PlayJob(ctx, project, jobID, settings, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	m.evictJob(settings.GitServerName, project, jobID, job)
+
+	return job, nil
+}
+
+// RetryJob retries a finished job and evicts the caches that still describe it as finished.
+func (m *MultiProviderPipelineService) RetryJob(
+	ctx context.Context,
+	project string,
+	jobID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineJob, error) {
+	retryProvider, err := capability[PipelineJobRetryProvider](m, settings.GitProvider, "retrying jobs")
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := retryProvider.RetryJob(ctx, project, jobID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	m.evictJob(settings.GitServerName, project, jobID, job)
+
+	return job, nil
+}
+
+// CancelJob cancels a running job and evicts the caches that still describe it as running.
+func (m *MultiProviderPipelineService) CancelJob(
+	ctx context.Context,
+	project string,
+	jobID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineJob, error) {
+	cancelProvider, err := capability[PipelineJobCancelProvider](m, settings.GitProvider, "cancelling jobs")
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := cancelProvider.CancelJob(ctx, project, jobID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	m.evictJob(settings.GitServerName, project, jobID, job)
+
+	return job, nil
+}
+
+// evictJob forgets that jobID is terminal and drops its cached trace, so a re-run job's trace is
+// not served from the done tier. The job's pipeline is evicted too when the provider reports it.
+func (m *MultiProviderPipelineService) evictJob(
+	gitServerName, project, jobID string,
+	job *models.PipelineJob,
+) {
+	m.terminalJobs.Delete(terminalJobKey(gitServerName, jobID))
+	m.traceCache.Delete(fmt.Sprintf("%s|%s|%s", gitServerName, project, jobID))
+
+	if job.PipelineId != nil {
+		m.evictPipeline(gitServerName, project, *job.PipelineId)
+	}
+}
+
+// evictPipeline drops the cached jobs of a pipeline and every cached pipeline list page of its
+// project (any page, ref or status filter may contain the pipeline), so the next read shows
+// the state change instead of a stale status for up to the cache TTL.
+func (m *MultiProviderPipelineService) evictPipeline(gitServerName, project, pipelineID string) {
+	m.jobsCache.Delete(fmt.Sprintf("%s|%s|%s", gitServerName, project, pipelineID))
+	m.testReportCache.Delete(fmt.Sprintf("%s|%s|%s", gitServerName, project, pipelineID))
+
+	listPrefix := fmt.Sprintf("%s|%s|", gitServerName, project)
+
+	for _, key := range m.cache.ScanKeys() {
+		if strings.HasPrefix(key, listPrefix) {
+			m.cache.Delete(key)
+		}
+	}
+}
+
+// capability resolves the configured provider as the optional capability T, or a bad-request error
+// naming what it does not support (e.g. "pipeline jobs") if it doesn't implement T.
+func capability[T any](m *MultiProviderPipelineService, gitProvider, what string) (T, error) {
+	var zero T
+
 	provider, ok := m.providers[gitProvider]
 	if !ok {
-		return nil, fmt.Errorf("unsupported provider %s: %w", gitProvider, gferrors.ErrBadRequest)
+		return zero, fmt.Errorf("unsupported provider %s: %w", gitProvider, gferrors.ErrBadRequest)
 	}
 
-	jobsProvider, ok := provider.(PipelineJobsProvider)
+	capable, ok := provider.(T)
 	if !ok {
-		return nil, fmt.Errorf("provider %s does not support pipeline jobs: %w", gitProvider, gferrors.ErrBadRequest)
+		return zero, fmt.Errorf("provider %s does not support %s: %w", gitProvider, what, gferrors.ErrBadRequest)
 	}
 
-	return jobsProvider, nil
+	return capable, nil
 }
 
 func (m *MultiProviderPipelineService) GetCache() *sturdyc.Client[models.PipelinesResponse] {
@@ -264,3 +858,7 @@ func (m *MultiProviderPipelineService) GetJobsCache() *sturdyc.Client[[]models.P
 func (m *MultiProviderPipelineService) GetTraceCache() *cache.TerminalAwareCache[cache.JobTrace] {
 	return m.traceCache
 }
+
+func (m *MultiProviderPipelineService) GetTestReportCache() *cache.TerminalAwareCache[models.PipelineTestReport] {
+	return m.testReportCache
+}
@@ -2,6 +2,7 @@ package pipelines
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"testing"
 
@@ -11,10 +12,12 @@ import (
 	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
 )
 
 func TestNewMultiProviderPipelineService(t *testing.T) {
-	service := NewMultiProviderPipelineService()
+	service := NewMultiProviderPipelineService(registry.NewDefault())
 
 	assert.NotNil(t, service)
 	assert.NotNil(t, service.providers)
@@ -29,12 +32,19 @@ func TestNewMultiProviderPipelineService(t *testing.T) {
 	_, ok = service.providers["bitbucket"]
 	assert.True(t, ok, "bitbucket provider should be registered")
 
+	_, ok = service.providers["gitea"]
+	assert.True(t, ok, "gitea provider should be registered")
+
+	_, ok = service.providers["azuredevops"]
+	assert.True(t, ok, "azuredevops provider should be registered")
+
 	// Verify only expected providers are registered
-	assert.Equal(t, 3, len(service.providers), "should have exactly 3 providers registered (gitlab, github, bitbucket)")
+	assert.Equal(t, 5, len(service.providers),
+		"should have exactly 5 providers registered (gitlab, github, bitbucket, gitea, azuredevops)")
 }
 
 func TestMultiProviderPipelineService_UnsupportedProvider(t *testing.T) {
-	service := NewMultiProviderPipelineService()
+	service := NewMultiProviderPipelineService(registry.NewDefault())
 
 	// Test unsupported providers
 	unsupported := []string{"unknown", ""}
@@ -67,14 +77,13 @@ func TestMultiProviderPipelineService_TriggerPipeline_UnsupportedProvider(t *tes
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			service := NewMultiProviderPipelineService()
+			service := NewMultiProviderPipelineService(registry.NewDefault())
 
 			result, err := service.TriggerPipeline(
 				context.Background(),
 				"test-project",
-				"main",
-				nil,
 				krci.GitServerSettings{GitProvider: tt.gitProvider},
+				models.PipelineTriggerOptions{Ref: "main"},
 			)
 
 			assert.Error(t, err)
@@ -87,7 +96,7 @@ func TestMultiProviderPipelineService_TriggerPipeline_UnsupportedProvider(t *tes
 }
 
 func TestMultiProviderPipelineService_GetCache(t *testing.T) {
-	service := NewMultiProviderPipelineService()
+	service := NewMultiProviderPipelineService(registry.NewDefault())
 
 	cache := service.GetCache()
 	assert.NotNil(t, cache, "cache should not be nil")
@@ -108,7 +117,7 @@ func TestMultiProviderPipelineService_ListPipelines_UnsupportedProvider(t *testi
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			service := NewMultiProviderPipelineService()
+			service := NewMultiProviderPipelineService(registry.NewDefault())
 
 			result, err := service.ListPipelines(
 				context.Background(),
@@ -137,12 +146,12 @@ func TestMultiProviderPipelineService_ListPipelineJobs_UnsupportedProviderReturn
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			service := NewMultiProviderPipelineService()
+			service := NewMultiProviderPipelineService(registry.NewDefault())
 
 			result, err := service.ListPipelineJobs(
 				context.Background(),
 				"test-project",
-				42,
+				"42",
 				krci.GitServerSettings{GitProvider: tt.gitProvider},
 			)
 
@@ -154,19 +163,210 @@ func TestMultiProviderPipelineService_ListPipelineJobs_UnsupportedProviderReturn
 	}
 }
 
-func TestMultiProviderPipelineService_ListPipelineJobs_GitHubReturnsBadRequest(t *testing.T) {
-	// GitHub is a registered provider but does not implement PipelineJobsProvider.
-	service := NewMultiProviderPipelineService()
+func TestMultiProviderPipelineService_AllProvidersSupportJobs(t *testing.T) {
+	service := NewMultiProviderPipelineService(registry.NewDefault())
+
+	for name := range service.providers {
+		_, err := capability[PipelineJobsProvider](service, name, "pipeline jobs")
+		assert.NoError(t, err, "%s provider should implement PipelineJobsProvider", name)
+	}
+}
+
+func TestPipelineJobsCapabilityDeclarations(t *testing.T) {
+	reg := registry.NewDefault()
+
+	assert.NotPanics(t, func() {
+		registry.Providers[PipelineJobsProvider](reg, registry.CapabilityPipelineJobs)
+	}, "every provider declaring pipeline jobs must implement PipelineJobsProvider")
+}
+
+// TestPipelineCapabilitiesDeclaredForImplementations checks the reverse of the per-capability
+// declaration tests: a provider implementing an optional interface declares its capability, so
+// the capabilities GET /api/v1/providers reports match what the endpoints accept. Declared actions
+// are also called on a cancelled context: a real implementation fails on the request it sends,
+// while a stub answering every call with a bad request never sends one.
+func TestPipelineCapabilitiesDeclaredForImplementations(t *testing.T) {
+	implements := map[registry.Capability]func(p PipelineProvider) bool{
+		registry.CapabilityPipelineJobs:            isA[PipelineJobsProvider],
+		registry.CapabilityPipelineDetail:          isA[PipelineDetailProvider],
+		registry.CapabilityPipelineCancel:          isA[PipelineCancelProvider],
+		registry.CapabilityPipelineRetry:           isA[PipelineRetryProvider],
+		registry.CapabilityPipelineJobPlay:         isA[PipelineJobPlayProvider],
+		registry.CapabilityPipelineJobRetry:        isA[PipelineJobRetryProvider],
+		registry.CapabilityPipelineJobCancel:       isA[PipelineJobCancelProvider],
+		registry.CapabilityPipelineJobTraceStream:  isA[PipelineJobTraceStreamProvider],
+		registry.CapabilityPipelineJobTraceWindow:  isA[PipelineJobTraceWindowProvider],
+		registry.CapabilityPipelineTestReport:      isA[PipelineTestReportProvider],
+		registry.CapabilityPipelineArtifacts:       isA[PipelineArtifactsProvider],
+		registry.CapabilityPipelineSchedules:       isA[PipelineSchedulesProvider],
+		registry.CapabilityPipelineScheduleActions: isA[PipelineScheduleActionsProvider],
+		registry.CapabilityPipelineGraph:           isA[PipelineGraphProvider],
+		registry.CapabilityPullRequestPipelines:    isA[PullRequestPipelinesProvider],
+	}
+
+	reg := registry.NewDefault()
+
+	for name, provider := range NewMultiProviderPipelineService(reg).providers {
+		for capability, implemented := range implements {
+			assert.Equal(t, implemented(provider), reg.Supports(name, capability),
+				"%s: the %s declaration does not match the implementation", name, capability)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	actions := map[registry.Capability]func(p PipelineProvider, settings krci.GitServerSettings) error{
+		registry.CapabilityPipelineCancel: func(p PipelineProvider, settings krci.GitServerSettings) error {
+			_, err := p.(PipelineCancelProvider).CancelPipeline(ctx, "owner/repo", "1", settings)
+			return err
+		},
+		registry.CapabilityPipelineRetry: func(p PipelineProvider, settings krci.GitServerSettings) error {
+			_, err := p.(PipelineRetryProvider).RetryPipeline(ctx, "owner/repo", "1", settings,
+				models.PipelineRetryOptions{})
+			return err
+		},
+		registry.CapabilityPipelineJobPlay: func(p PipelineProvider, settings krci.GitServerSettings) error {
+			_, err := p.(PipelineJobPlayProvider).PlayJob(ctx, "owner/repo", "1", settings,
+				models.PipelineJobPlayOptions{})
+			return err
+		},
+		registry.CapabilityPipelineJobRetry: func(p PipelineProvider, settings krci.GitServerSettings) error {
+			_, err := p.(PipelineJobRetryProvider).RetryJob(ctx, "owner/repo", "1", settings)
+			return err
+		},
+		registry.CapabilityPipelineJobCancel: func(p PipelineProvider, settings krci.GitServerSettings) error {
+			_, err := p.(PipelineJobCancelProvider).CancelJob(ctx, "owner/repo", "1", settings)
+			return err
+		},
+	}
+
+	for name, provider := range NewMultiProviderPipelineService(reg).providers {
+		settings := krci.GitServerSettings{
+			GitProvider: name,
+			Url:         "https://git.example.com",
+			Token:       base64.StdEncoding.EncodeToString([]byte("user:pass")),
+		}
+
+		for capability, call := range actions {
+			if reg.Supports(name, capability) {
+				assert.ErrorIs(t, call(provider, settings), context.Canceled,
+					"%s: %s is declared but does not call the provider", name, capability)
+			}
+		}
+	}
+}
+
+func isA[T any](p PipelineProvider) bool {
+	_, ok := p.(T)
+
+	return ok
+}
+
+func TestPipelineActionsCapabilityDeclarations(t *testing.T) {
+	reg := registry.NewDefault()
+
+	assert.NotPanics(t, func() {
+		registry.Providers[PipelineCancelProvider](reg, registry.CapabilityPipelineCancel)
+		registry.Providers[PipelineRetryProvider](reg, registry.CapabilityPipelineRetry)
+	}, "every provider declaring a pipeline action must implement its interface")
+}
+
+func TestMultiProviderPipelineService_CancelPipeline_UnsupportedProviderReturnsBadRequest(t *testing.T) {
+	service := NewMultiProviderPipelineService(registry.NewDefault())
+
+	for _, gitProvider := range []string{"gitea", "unknown"} {
+		result, err := service.CancelPipeline(context.Background(), "owner/repo", "42",
+			krci.GitServerSettings{GitProvider: gitProvider})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, gferrors.ErrBadRequest, gitProvider)
+	}
+}
+
+func TestPipelineJobActionsCapabilityDeclarations(t *testing.T) {
+	reg := registry.NewDefault()
+
+	assert.NotPanics(t, func() {
+		registry.Providers[PipelineJobPlayProvider](reg, registry.CapabilityPipelineJobPlay)
+		registry.Providers[PipelineJobRetryProvider](reg, registry.CapabilityPipelineJobRetry)
+		registry.Providers[PipelineJobCancelProvider](reg, registry.CapabilityPipelineJobCancel)
+	}, "every provider declaring a job action must implement its interface")
+}
+
+func TestPipelineDetailCapabilityDeclarations(t *testing.T) {
+	reg := registry.NewDefault()
+
+	assert.NotPanics(t, func() {
+		registry.Providers[PipelineDetailProvider](reg, registry.CapabilityPipelineDetail)
+	}, "every provider declaring pipeline details must implement PipelineDetailProvider")
+}
 
-	result, err := service.ListPipelineJobs(
-		context.Background(),
-		"test-project",
-		1,
-		krci.GitServerSettings{GitProvider: "github"},
-	)
+func TestMultiProviderPipelineService_GetPipeline_UnsupportedProviderReturnsBadRequest(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
 
-	require.Error(t, err)
-	assert.Nil(t, result)
-	assert.True(t, errors.Is(err, gferrors.ErrBadRequest),
-		"expected ErrBadRequest for github provider (no jobs support), got: %v", err)
+	_, err := svc.GetPipeline(context.Background(), "proj", "7", krci.GitServerSettings{GitProvider: "azuredevops"})
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
+
+func TestPipelineSchedulesCapabilityDeclarations(t *testing.T) {
+	reg := registry.NewDefault()
+
+	assert.NotPanics(t, func() {
+		registry.Providers[PipelineSchedulesProvider](reg, registry.CapabilityPipelineSchedules)
+		registry.Providers[PipelineScheduleActionsProvider](reg, registry.CapabilityPipelineScheduleActions)
+	}, "every provider declaring pipeline schedules must implement the matching interfaces")
+}
+
+func TestMultiProviderPipelineService_PipelineSchedules_UnsupportedProviderReturnsBadRequest(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+
+	_, err := svc.ListPipelineSchedules(context.Background(), "owner/repo",
+		krci.GitServerSettings{GitProvider: "bitbucket"})
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+
+	err = svc.RunPipelineSchedule(context.Background(), "owner/repo", "1:0", krci.GitServerSettings{GitProvider: "github"})
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest, "GitHub schedules live in workflow files")
+}
+
+func TestPipelineGraphCapabilityDeclarations(t *testing.T) {
+	reg := registry.NewDefault()
+
+	assert.NotPanics(t, func() {
+		registry.Providers[PipelineGraphProvider](reg, registry.CapabilityPipelineGraph)
+	}, "every provider declaring pipeline graphs must implement PipelineGraphProvider")
+}
+
+func TestPullRequestPipelinesCapabilityDeclarations(t *testing.T) {
+	reg := registry.NewDefault()
+
+	assert.NotPanics(t, func() {
+		registry.Providers[PullRequestPipelinesProvider](reg, registry.CapabilityPullRequestPipelines)
+	}, "every provider declaring pull request pipelines must implement PullRequestPipelinesProvider")
+}
+
+func TestMultiProviderPipelineService_ListPullRequestPipelines_UnsupportedProviderReturnsBadRequest(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+
+	_, err := svc.ListPullRequestPipelines(context.Background(), "owner/repo", 1,
+		krci.GitServerSettings{GitProvider: "gitea"}, models.PipelineListOptions{Page: 1, PerPage: 20})
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
+
+func TestMultiProviderPipelineService_ListPipelines_RejectsUnsupportedFilters(t *testing.T) {
+	service := NewMultiProviderPipelineService(registry.NewDefault())
+
+	for _, provider := range []string{"gitea", "azuredevops"} {
+		t.Run(provider, func(t *testing.T) {
+			resp, err := service.ListPipelines(context.Background(), "owner/repo",
+				krci.GitServerSettings{GitProvider: provider, GitServerName: provider + "-server"},
+				models.PipelineListOptions{Sha: pointer.To("abc123"), Page: 1, PerPage: 20})
+
+			require.Error(t, err)
+			assert.Nil(t, resp)
+			assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+			assert.Contains(t, err.Error(), "sha")
+		})
+	}
 }
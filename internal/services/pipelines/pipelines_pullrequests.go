@@ -0,0 +1,42 @@
+package pipelines
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// ListPullRequestPipelines returns a page of the pipelines of a pull request. Pages are cached
+// with the project's pipeline lists, so pipeline actions evict them as well.
+func (m *MultiProviderPipelineService) ListPullRequestPipelines(
+	ctx context.Context,
+	project string,
+	pullRequest int,
+	settings krci.GitServerSettings,
+	opts models.PipelineListOptions,
+) (*models.PipelinesResponse, error) {
+	prProvider, err := capability[PullRequestPipelinesProvider](m, settings.GitProvider, "pull request pipelines")
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("%s|%s|pr|%d|%d|%d", settings.GitServerName, project, pullRequest, opts.Page, opts.PerPage)
+
+	fetchFn := func(ctx context.Context) (models.PipelinesResponse, error) {
+		resp, err := prProvider.ListPullRequestPipelines(ctx, project, pullRequest, settings, opts)
+		if err != nil {
+			return models.PipelinesResponse{}, err
+		}
+
+		return *resp, nil
+	}
+
+	result, err := m.cache.GetOrFetch(ctx, key, fetchFn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
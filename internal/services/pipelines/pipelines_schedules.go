@@ -0,0 +1,103 @@
+package pipelines
+
+import (
+	"context"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+// ListPipelineSchedules lists the pipeline schedules of a project.
+func (m *MultiProviderPipelineService) ListPipelineSchedules(
+	ctx context.Context,
+	project string,
+	settings krci.GitServerSettings,
+) ([]models.PipelineSchedule, error) {
+	schedulesProvider, err := capability[PipelineSchedulesProvider](m, settings.GitProvider, "pipeline schedules")
+	if err != nil {
+		return nil, err
+	}
+
+	return schedulesProvider.ListPipelineSchedules(ctx, project, settings)
+}
+
+// GetPipelineSchedule returns a single pipeline schedule.
+func (m *MultiProviderPipelineService) GetPipelineSchedule(
+	ctx context.Context,
+	project string,
+	scheduleID string,
+	settings krci.GitServerSettings,
+) (*models.PipelineSchedule, error) {
+	schedulesProvider, err := capability[PipelineSchedulesProvider](m, settings.GitProvider, "pipeline schedules")
+	if err != nil {
+		return nil, err
+	}
+
+	return schedulesProvider.GetPipelineSchedule(ctx, project, scheduleID, settings)
+}
+
+// CreatePipelineSchedule creates a pipeline schedule with its variables.
+func (m *MultiProviderPipelineService) CreatePipelineSchedule(
+	ctx context.Context,
+	project string,
+	settings krci.GitServerSettings,
+	opts models.PipelineScheduleOptions,
+) (*models.PipelineSchedule, error) {
+	scheduleActionsProvider, err := capability[PipelineScheduleActionsProvider](
+		m, settings.GitProvider, "managing pipeline schedules")
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleActionsProvider.CreatePipelineSchedule(ctx, project, settings, opts)
+}
+
+// UpdatePipelineSchedule changes the fields of a pipeline schedule that opts sets.
+func (m *MultiProviderPipelineService) UpdatePipelineSchedule(
+	ctx context.Context,
+	project string,
+	scheduleID string,
+	settings krci.GitServerSettings,
+	opts models.PipelineScheduleOptions,
+) (*models.PipelineSchedule, error) {
+	scheduleActionsProvider, err := capability[PipelineScheduleActionsProvider](
+		m, settings.GitProvider, "managing pipeline schedules")
+	if err != nil {
+		return nil, err
+	}
+
+	return scheduleActionsProvider.UpdatePipelineSchedule(ctx, project, scheduleID, settings, opts)
+}
+
+// DeletePipelineSchedule deletes a pipeline schedule.
+func (m *MultiProviderPipelineService) DeletePipelineSchedule(
+	ctx context.Context,
+	project string,
+	scheduleID string,
+	settings krci.GitServerSettings,
+) error {
+	scheduleActionsProvider, err := capability[PipelineScheduleActionsProvider](
+		m, settings.GitProvider, "managing pipeline schedules")
+	if err != nil {
+		return err
+	}
+
+	return scheduleActionsProvider.DeletePipelineSchedule(ctx, project, scheduleID, settings)
+}
+
+// RunPipelineSchedule starts a pipeline of a schedule now. Like TriggerPipeline it leaves the
+// pipeline list cache alone; the new pipeline shows up once the cached pages expire.
+func (m *MultiProviderPipelineService) RunPipelineSchedule(
+	ctx context.Context,
+	project string,
+	scheduleID string,
+	settings krci.GitServerSettings,
+) error {
+	scheduleActionsProvider, err := capability[PipelineScheduleActionsProvider](
+		m, settings.GitProvider, "managing pipeline schedules")
+	if err != nil {
+		return err
+	}
+
+	return scheduleActionsProvider.RunPipelineSchedule(ctx, project, scheduleID, settings)
+}
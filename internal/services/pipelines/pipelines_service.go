@@ -32,8 +32,7 @@ func (s *PipelinesService) TriggerPipeline(
 	ctx context.Context,
 	gitServerName string,
 	project string,
-	ref string,
-	variables []models.PipelineVariable,
+	opts models.PipelineTriggerOptions,
 ) (*models.PipelineResponse, error) {
 	// Get settings from K8s (GitServer CR + Secret)
 	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
@@ -42,7 +41,7 @@ func (s *PipelinesService) TriggerPipeline(
 	}
 
 	// Delegate to multi-provider service
-	return s.pipelinesProvider.TriggerPipeline(ctx, project, ref, variables, settings)
+	return s.pipelinesProvider.TriggerPipeline(ctx, project, settings, opts)
 }
 
 // ListPipelines lists CI/CD pipelines for the specified git server and project.
@@ -61,12 +60,27 @@ func (s *PipelinesService) ListPipelines(
 	return s.pipelinesProvider.ListPipelines(ctx, project, settings, opts)
 }
 
+// GetPipeline returns a single CI/CD pipeline with its details for the specified git server and project.
+func (s *PipelinesService) GetPipeline(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	pipelineID string,
+) (*models.Pipeline, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.GetPipeline(ctx, project, pipelineID, settings)
+}
+
 // ListPipelineJobs lists the jobs of a CI/CD pipeline for the specified git server and project.
 func (s *PipelinesService) ListPipelineJobs(
 	ctx context.Context,
 	gitServerName string,
 	project string,
-	pipelineID int,
+	pipelineID string,
 ) ([]models.PipelineJob, error) {
 	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
 	if err != nil {
@@ -76,19 +90,322 @@ func (s *PipelinesService) ListPipelineJobs(
 	return s.pipelinesProvider.ListPipelineJobs(ctx, project, pipelineID, settings)
 }
 
-// GetJobTrace returns the raw trace (log) of a CI/CD job for the specified git server and project.
+// GetPipelineTestReport returns the test report of a CI/CD pipeline for the specified git server
+// and project.
+func (s *PipelinesService) GetPipelineTestReport(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	pipelineID string,
+) (*models.PipelineTestReport, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.GetPipelineTestReport(ctx, project, pipelineID, settings)
+}
+
+// ListPipelineArtifacts lists the artifacts of a CI/CD pipeline for the specified git server and
+// project.
+func (s *PipelinesService) ListPipelineArtifacts(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	pipelineID string,
+	opts models.PipelineArtifactListOptions,
+) ([]models.PipelineArtifact, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.ListPipelineArtifacts(ctx, project, pipelineID, settings, opts)
+}
+
+// DownloadArtifact opens a CI/CD pipeline artifact of the specified git server and project for
+// streaming. The caller must close the returned body.
+func (s *PipelinesService) DownloadArtifact(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	artifactID string,
+) (*models.ArtifactDownload, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.DownloadArtifact(ctx, project, artifactID, settings)
+}
+
+// ListPullRequestPipelines lists the pipelines of a pull request of the specified git server and
+// project.
+func (s *PipelinesService) ListPullRequestPipelines(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	pullRequest int,
+	opts models.PipelineListOptions,
+) (*models.PipelinesResponse, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.ListPullRequestPipelines(ctx, project, pullRequest, settings, opts)
+}
+
+// GetPipelineGraph returns the graph of a pipeline of the specified git server and project.
+func (s *PipelinesService) GetPipelineGraph(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	pipelineID string,
+	depth int,
+) (*models.PipelineGraphNode, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.GetPipelineGraph(ctx, project, pipelineID, settings, depth)
+}
+
+// ListPipelineSchedules lists the pipeline schedules of the specified git server and project.
+func (s *PipelinesService) ListPipelineSchedules(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+) ([]models.PipelineSchedule, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.ListPipelineSchedules(ctx, project, settings)
+}
+
+// GetPipelineSchedule returns a pipeline schedule of the specified git server and project.
+func (s *PipelinesService) GetPipelineSchedule(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	scheduleID string,
+) (*models.PipelineSchedule, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.GetPipelineSchedule(ctx, project, scheduleID, settings)
+}
+
+// CreatePipelineSchedule creates a pipeline schedule for the specified git server and project.
+func (s *PipelinesService) CreatePipelineSchedule(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	opts models.PipelineScheduleOptions,
+) (*models.PipelineSchedule, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.CreatePipelineSchedule(ctx, project, settings, opts)
+}
+
+// UpdatePipelineSchedule updates a pipeline schedule of the specified git server and project.
+func (s *PipelinesService) UpdatePipelineSchedule(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	scheduleID string,
+	opts models.PipelineScheduleOptions,
+) (*models.PipelineSchedule, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.UpdatePipelineSchedule(ctx, project, scheduleID, settings, opts)
+}
+
+// DeletePipelineSchedule deletes a pipeline schedule of the specified git server and project.
+func (s *PipelinesService) DeletePipelineSchedule(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	scheduleID string,
+) error {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return err
+	}
+
+	return s.pipelinesProvider.DeletePipelineSchedule(ctx, project, scheduleID, settings)
+}
+
+// RunPipelineSchedule starts a pipeline of a schedule of the specified git server and project now.
+func (s *PipelinesService) RunPipelineSchedule(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	scheduleID string,
+) error {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return err
+	}
+
+	return s.pipelinesProvider.RunPipelineSchedule(ctx, project, scheduleID, settings)
+}
+
+// SearchJobTraces searches the traces of every job of a CI/CD pipeline for the specified git server
+// and project.
+func (s *PipelinesService) SearchJobTraces(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	pipelineID string,
+	opts models.JobTraceSearchOptions,
+) (*models.PipelineJobTraceSearchResponse, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.SearchJobTraces(ctx, project, pipelineID, settings, opts)
+}
+
+// GetJobTrace returns a window of the raw trace (log) of a CI/CD job for the specified git server
+// and project.
 func (s *PipelinesService) GetJobTrace(
 	ctx context.Context,
 	gitServerName string,
 	project string,
-	jobID int,
-) (string, bool, error) {
+	jobID string,
+	w models.JobTraceWindow,
+) (*models.JobTrace, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.GetJobTraceWindow(ctx, project, jobID, settings, w)
+}
+
+// GetStructuredJobTrace returns a window of the trace (log) of a CI/CD job parsed into lines and
+// sections, for the specified git server and project.
+func (s *PipelinesService) GetStructuredJobTrace(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	jobID string,
+	w models.JobTraceWindow,
+	stripANSI bool,
+) (*models.JobTrace, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.GetStructuredJobTrace(ctx, project, jobID, settings, w, stripANSI)
+}
+
+// StreamJobTrace streams the trace (log) of a CI/CD job from offset on for the specified git server
+// and project.
+func (s *PipelinesService) StreamJobTrace(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	jobID string,
+	offset int64,
+) (<-chan models.PipelineJobTraceEvent, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.StreamJobTrace(ctx, project, jobID, settings, offset)
+}
+
+// CancelPipeline cancels a CI/CD pipeline for the specified git server and project.
+func (s *PipelinesService) CancelPipeline(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	pipelineID string,
+) (*models.PipelineActionResponse, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.CancelPipeline(ctx, project, pipelineID, settings)
+}
+
+// RetryPipeline retries a finished CI/CD pipeline for the specified git server and project.
+func (s *PipelinesService) RetryPipeline(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	pipelineID string,
+	opts models.PipelineRetryOptions,
+) (*models.PipelineActionResponse, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.RetryPipeline(ctx, project, pipelineID, settings, opts)
+}
+
+// PlayJob starts a manual CI/CD job for the specified git server and project.
+func (s *PipelinesService) PlayJob(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	jobID string,
+	opts models.PipelineJobPlayOptions,
+) (*models.PipelineJob, error) {
 	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
 	if err != nil {
-		return "", false, err
+		return nil, err
+	}
+
+	return s.pipelinesProvider.PlayJob(ctx, project, jobID, settings, opts)
+}
+
+// RetryJob retries a finished CI/CD job for the specified git server and project.
+func (s *PipelinesService) RetryJob(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	jobID string,
+) (*models.PipelineJob, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.pipelinesProvider.RetryJob(ctx, project, jobID, settings)
+}
+
+// CancelJob cancels a running CI/CD job for the specified git server and project.
+func (s *PipelinesService) CancelJob(
+	ctx context.Context,
+	gitServerName string,
+	project string,
+	jobID string,
+) (*models.PipelineJob, error) {
+	settings, err := s.gitServerService.GetGitProviderSettings(ctx, gitServerName)
+	if err != nil {
+		return nil, err
 	}
 
-	return s.pipelinesProvider.GetJobTrace(ctx, project, jobID, settings)
+	return s.pipelinesProvider.CancelJob(ctx, project, jobID, settings)
 }
 
 // GetProvider returns the underlying multi-provider service for direct access to its cache.
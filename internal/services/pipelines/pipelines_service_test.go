@@ -4,17 +4,19 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
 )
 
 func TestNewPipelinesService(t *testing.T) {
 	t.Run("creates service with valid dependencies", func(t *testing.T) {
-		multiProvider := NewMultiProviderPipelineService()
+		multiProvider := NewMultiProviderPipelineService(registry.NewDefault())
 		assert.NotNil(t, multiProvider)
 	})
 }
 
 func TestPipelinesService_GetProvider(t *testing.T) {
-	multiProvider := NewMultiProviderPipelineService()
+	multiProvider := NewMultiProviderPipelineService(registry.NewDefault())
 	service := NewPipelinesService(multiProvider, nil)
 
 	provider := service.GetProvider()
@@ -0,0 +1,94 @@
+package pipelines
+
+import (
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+// summarizeStages groups jobs by stage, in the order each stage first appears, and aggregates a
+// normalized status per stage. Jobs without a stage (Bitbucket steps) form a stage of their own,
+// named after the job.
+func summarizeStages(jobs []models.PipelineJob) []models.PipelineStage {
+	stages := make([]models.PipelineStage, 0)
+	statuses := make(map[string][]models.PipelineStatus)
+
+	for i := range jobs {
+		name := jobs[i].Stage
+		if name == "" {
+			name = jobs[i].Name
+		}
+
+		if _, ok := statuses[name]; !ok {
+			stages = append(stages, models.PipelineStage{Name: name})
+		}
+
+		statuses[name] = append(statuses[name], jobOutcome(&jobs[i]))
+	}
+
+	for i := range stages {
+		stages[i].Jobs = len(statuses[stages[i].Name])
+		stages[i].Status = string(aggregateStageStatus(statuses[stages[i].Name]))
+	}
+
+	return stages
+}
+
+// jobOutcome returns the normalized status a job contributes to its stage. A job allowed to fail
+// does not fail its stage, as in the GitLab UI.
+func jobOutcome(job *models.PipelineJob) models.PipelineStatus {
+	status := normalizeJobStatus(job.Status)
+	if status == models.PipelineStatusFailed && job.AllowFailure != nil && *job.AllowFailure {
+		return models.PipelineStatusSuccess
+	}
+
+	return status
+}
+
+// aggregateStageStatus reduces job statuses to one stage status. Activity wins over results: a
+// stage with a running job, or with pending jobs next to finished ones, is running. Otherwise the
+// worst result wins, and a stage counts as skipped only when every job was skipped.
+func aggregateStageStatus(statuses []models.PipelineStatus) models.PipelineStatus {
+	counts := make(map[models.PipelineStatus]int, len(statuses))
+	for _, s := range statuses {
+		counts[s]++
+	}
+
+	switch {
+	case counts[models.PipelineStatusRunning] > 0:
+		return models.PipelineStatusRunning
+	case counts[models.PipelineStatusPending] > 0 && counts[models.PipelineStatusPending] < len(statuses):
+		return models.PipelineStatusRunning
+	case counts[models.PipelineStatusPending] > 0:
+		return models.PipelineStatusPending
+	case counts[models.PipelineStatusFailed] > 0:
+		return models.PipelineStatusFailed
+	case counts[models.PipelineStatusManual] > 0:
+		return models.PipelineStatusManual
+	case counts[models.PipelineStatusCancelled] > 0:
+		return models.PipelineStatusCancelled
+	case counts[models.PipelineStatusSkipped] == len(statuses):
+		return models.PipelineStatusSkipped
+	default:
+		return models.PipelineStatusSuccess
+	}
+}
+
+// normalizeJobStatus maps a provider-native job status (see terminalJobStatuses) to the unified
+// pipeline status enum. Unknown statuses are treated as pending.
+func normalizeJobStatus(status string) models.PipelineStatus {
+	switch status {
+	case "running", "in_progress", "inProgress":
+		return models.PipelineStatusRunning
+	case "success", "successful", "succeeded", "succeededWithIssues", "neutral":
+		return models.PipelineStatusSuccess
+	case "failed", "failure", "timed_out", "startup_failure", "error":
+		return models.PipelineStatusFailed
+	case "canceled", "cancelled", "stopped", "abandoned", "stale":
+		return models.PipelineStatusCancelled
+	case "skipped", "not_run":
+		return models.PipelineStatusSkipped
+	case "manual", "scheduled", "waiting", "action_required", "halted", "paused":
+		return models.PipelineStatusManual
+	default:
+		return models.PipelineStatusPending
+	}
+}
@@ -0,0 +1,96 @@
+package pipelines
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+func TestSummarizeStages(t *testing.T) {
+	allowFailure := true
+
+	jobs := []models.PipelineJob{
+		{Id: "1", Name: "compile", Stage: "build", Status: "success"},
+		{Id: "2", Name: "unit", Stage: "test", Status: "failed"},
+		{Id: "3", Name: "lint", Stage: "test", Status: "failed", AllowFailure: &allowFailure},
+		{Id: "4", Name: "deploy", Stage: "deploy", Status: "manual"},
+		{Id: "5", Name: "Build and push", Status: "successful"},
+	}
+
+	assert.Equal(t, []models.PipelineStage{
+		{Name: "build", Status: "success", Jobs: 1},
+		{Name: "test", Status: "failed", Jobs: 2},
+		{Name: "deploy", Status: "manual", Jobs: 1},
+		{Name: "Build and push", Status: "success", Jobs: 1},
+	}, summarizeStages(jobs))
+}
+
+func TestSummarizeStagesEmpty(t *testing.T) {
+	assert.Empty(t, summarizeStages(nil))
+	assert.NotNil(t, summarizeStages(nil), "an empty summary must encode as [] rather than null")
+}
+
+func TestAggregateStageStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []models.PipelineStatus
+		want     models.PipelineStatus
+	}{
+		{
+			name:     "running job",
+			statuses: []models.PipelineStatus{models.PipelineStatusSuccess, models.PipelineStatusRunning},
+			want:     models.PipelineStatusRunning,
+		},
+		{
+			name:     "pending next to finished",
+			statuses: []models.PipelineStatus{models.PipelineStatusSuccess, models.PipelineStatusPending},
+			want:     models.PipelineStatusRunning,
+		},
+		{
+			name:     "all pending",
+			statuses: []models.PipelineStatus{models.PipelineStatusPending, models.PipelineStatusPending},
+			want:     models.PipelineStatusPending,
+		},
+		{
+			name:     "failure wins over cancel",
+			statuses: []models.PipelineStatus{models.PipelineStatusCancelled, models.PipelineStatusFailed},
+			want:     models.PipelineStatusFailed,
+		},
+		{
+			name:     "all skipped",
+			statuses: []models.PipelineStatus{models.PipelineStatusSkipped, models.PipelineStatusSkipped},
+			want:     models.PipelineStatusSkipped,
+		},
+		{
+			name:     "success with skipped",
+			statuses: []models.PipelineStatus{models.PipelineStatusSuccess, models.PipelineStatusSkipped},
+			want:     models.PipelineStatusSuccess,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, aggregateStageStatus(tt.statuses))
+		})
+	}
+}
+
+func TestNormalizeJobStatus(t *testing.T) {
+	tests := map[string]models.PipelineStatus{
+		"in_progress":     models.PipelineStatusRunning,
+		"successful":      models.PipelineStatusSuccess,
+		"timed_out":       models.PipelineStatusFailed,
+		"stopped":         models.PipelineStatusCancelled,
+		"not_run":         models.PipelineStatusSkipped,
+		"waiting":         models.PipelineStatusManual,
+		"created":         models.PipelineStatusPending,
+		"something-new":   models.PipelineStatusPending,
+		"action_required": models.PipelineStatusManual,
+	}
+
+	for status, want := range tests {
+		assert.Equal(t, want, normalizeJobStatus(status), status)
+	}
+}
@@ -0,0 +1,104 @@
+package pipelines
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/viccon/sturdyc"
+
+	"github.com/KubeRocketCI/gitfusion/internal/cache"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+)
+
+// fakeTestReportProvider implements PipelineTestReportProvider on top of fakeJobsProvider and
+// counts its reads.
+type fakeTestReportProvider struct {
+	fakeJobsProvider
+
+	status      models.PipelineStatus
+	reportCalls int
+}
+
+func (f *fakeTestReportProvider) GetPipelineTestReport(
+	_ context.Context, _ string, pipelineID string, _ krci.GitServerSettings,
+) (*models.PipelineTestReport, error) {
+	f.reportCalls++
+
+	return &models.PipelineTestReport{
+		PipelineId:     pipelineID,
+		PipelineStatus: string(f.status),
+		Suites:         []models.PipelineTestSuite{},
+	}, nil
+}
+
+// newTestReportTiers gives svc a test report cache whose tiers the test can inspect.
+func newTestReportTiers(svc *MultiProviderPipelineService) (live, done *sturdyc.Client[models.PipelineTestReport]) {
+	live = sturdyc.New[models.PipelineTestReport](10, 1, time.Minute, 10)
+	done = sturdyc.New[models.PipelineTestReport](10, 1, time.Minute, 10)
+	svc.testReportCache = cache.NewTerminalAwareCache(live, done)
+
+	return live, done
+}
+
+func TestMultiProviderPipelineService_GetPipelineTestReport_CachesByStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   models.PipelineStatus
+		wantDone bool
+	}{
+		{name: "finished pipeline is cached long", status: models.PipelineStatusFailed, wantDone: true},
+		{name: "running pipeline is cached briefly", status: models.PipelineStatusRunning},
+		{name: "manual pipeline is cached briefly", status: models.PipelineStatusManual},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := NewMultiProviderPipelineService(registry.NewDefault())
+			live, done := newTestReportTiers(svc)
+			fake := &fakeTestReportProvider{status: tt.status}
+			svc.providers["gitlab"] = fake
+
+			for range 2 {
+				report, err := svc.GetPipelineTestReport(context.Background(), "proj", "7", gitlabSettings())
+				require.NoError(t, err)
+				assert.Equal(t, "7", report.PipelineId)
+			}
+
+			assert.Equal(t, 1, fake.reportCalls)
+
+			_, inDone := done.Get("gs|proj|7")
+			_, inLive := live.Get("gs|proj|7")
+			assert.Equal(t, tt.wantDone, inDone)
+			assert.Equal(t, !tt.wantDone, inLive)
+		})
+	}
+}
+
+func TestMultiProviderPipelineService_GetPipelineTestReport_EvictedOnRetry(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+	fake := &fakeTestReportProvider{status: models.PipelineStatusFailed}
+	svc.providers["gitlab"] = fake
+
+	_, err := svc.GetPipelineTestReport(context.Background(), "proj", "7", gitlabSettings())
+	require.NoError(t, err)
+
+	_, err = svc.RetryPipeline(context.Background(), "proj", "7", gitlabSettings(), models.PipelineRetryOptions{})
+	require.NoError(t, err)
+
+	_, err = svc.GetPipelineTestReport(context.Background(), "proj", "7", gitlabSettings())
+	require.NoError(t, err)
+	assert.Equal(t, 2, fake.reportCalls, "a retried pipeline's report should be read again")
+}
+
+func TestPipelineTestReportCapabilityDeclarations(t *testing.T) {
+	reg := registry.NewDefault()
+
+	assert.NotPanics(t, func() {
+		registry.Providers[PipelineTestReportProvider](reg, registry.CapabilityPipelineTestReport)
+	}, "every provider declaring test reports must implement PipelineTestReportProvider")
+}
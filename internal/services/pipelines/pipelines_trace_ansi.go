@@ -0,0 +1,256 @@
+package pipelines
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+// ansiColorNames are the names of the 8 basic terminal colors, in SGR order.
+var ansiColorNames = [8]string{"black", "red", "green", "yellow", "blue", "magenta", "cyan", "white"}
+
+// ansiStyle is the SGR state of a terminal.
+type ansiStyle struct {
+	fg, bg                  string
+	bold, italic, underline bool
+}
+
+func (s ansiStyle) span(text string) models.PipelineJobTraceSpan {
+	span := models.PipelineJobTraceSpan{Text: text}
+
+	if s.fg != "" {
+		span.Fg = &s.fg
+	}
+
+	if s.bg != "" {
+		span.Bg = &s.bg
+	}
+
+	if s.bold {
+		span.Bold = &s.bold
+	}
+
+	if s.italic {
+		span.Italic = &s.italic
+	}
+
+	if s.underline {
+		span.Underline = &s.underline
+	}
+
+	return span
+}
+
+// ansiParser converts text with ANSI escape sequences to plain text and style spans. Its style
+// carries over from one line to the next, as in a terminal.
+type ansiParser struct {
+	style ansiStyle
+}
+
+// parse returns line without escape sequences, and its styled spans when any part of it is styled.
+func (p *ansiParser) parse(line string) (string, []models.PipelineJobTraceSpan) {
+	var (
+		text      strings.Builder
+		spans     []models.PipelineJobTraceSpan
+		lastStyle ansiStyle
+		styled    bool
+		run       strings.Builder
+	)
+
+	flush := func() {
+		if run.Len() == 0 {
+			return
+		}
+
+		if p.style != (ansiStyle{}) {
+			styled = true
+		}
+
+		if n := len(spans); n > 0 && lastStyle == p.style {
+			spans[n-1].Text += run.String()
+		} else {
+			spans = append(spans, p.style.span(run.String()))
+			lastStyle = p.style
+		}
+
+		text.WriteString(run.String())
+		run.Reset()
+	}
+
+	for i := 0; i < len(line); {
+		if line[i] != '\x1b' {
+			run.WriteByte(line[i])
+			i++
+
+			continue
+		}
+
+		flush()
+
+		i = p.escape(line, i)
+	}
+
+	flush()
+
+	if !styled {
+		return text.String(), nil
+	}
+
+	return text.String(), spans
+}
+
+// escape applies the escape sequence starting at line[i] and returns the index following it.
+// Only SGR sequences change the style; other CSI and OSC sequences are dropped.
+func (p *ansiParser) escape(line string, i int) int {
+	if i+1 >= len(line) {
+		return len(line)
+	}
+
+	switch line[i+1] {
+	case '[':
+		j := i + 2
+		for j < len(line) && (line[j] < 0x40 || line[j] > 0x7e) {
+			j++
+		}
+
+		if j == len(line) {
+			return j
+		}
+
+		if line[j] == 'm' {
+			p.applySGR(line[i+2 : j])
+		}
+
+		return j + 1
+	case ']':
+		// OSC, terminated by BEL or ST (ESC \).
+		for j := i + 2; j < len(line); j++ {
+			if line[j] == '\a' {
+				return j + 1
+			}
+
+			if line[j] == '\x1b' && j+1 < len(line) && line[j+1] == '\\' {
+				return j + 2
+			}
+		}
+
+		return len(line)
+	default:
+		return i + 2
+	}
+}
+
+func (p *ansiParser) applySGR(params string) {
+	codes := strings.Split(params, ";")
+
+	for i := 0; i < len(codes); i++ {
+		code, err := strconv.Atoi(codes[i])
+		if err != nil {
+			if codes[i] != "" {
+				continue
+			}
+
+			code = 0
+		}
+
+		switch {
+		case code == 0:
+			p.style = ansiStyle{}
+		case code == 1:
+			p.style.bold = true
+		case code == 3:
+			p.style.italic = true
+		case code == 4:
+			p.style.underline = true
+		case code == 22:
+			p.style.bold = false
+		case code == 23:
+			p.style.italic = false
+		case code == 24:
+			p.style.underline = false
+		case code >= 30 && code <= 37:
+			p.style.fg = ansiColorNames[code-30]
+		case code == 38:
+			var color string
+			color, i = extendedColor(codes, i)
+			p.style.fg = color
+		case code == 39:
+			p.style.fg = ""
+		case code >= 40 && code <= 47:
+			p.style.bg = ansiColorNames[code-40]
+		case code == 48:
+			var color string
+			color, i = extendedColor(codes, i)
+			p.style.bg = color
+		case code == 49:
+			p.style.bg = ""
+		case code >= 90 && code <= 97:
+			p.style.fg = "bright-" + ansiColorNames[code-90]
+		case code >= 100 && code <= 107:
+			p.style.bg = "bright-" + ansiColorNames[code-100]
+		}
+	}
+}
+
+// extendedColor parses the 256-color ("38;5;n") or true-color ("38;2;r;g;b") arguments following
+// codes[i] and returns the color and the index of its last argument.
+func extendedColor(codes []string, i int) (string, int) {
+	arg := func(k int) (int, bool) {
+		if k >= len(codes) {
+			return 0, false
+		}
+
+		n, err := strconv.Atoi(codes[k])
+
+		return n, err == nil && n >= 0 && n <= 255
+	}
+
+	mode, ok := arg(i + 1)
+	if !ok {
+		return "", len(codes)
+	}
+
+	switch mode {
+	case 5:
+		n, ok := arg(i + 2)
+		if !ok {
+			return "", len(codes)
+		}
+
+		return paletteColor(n), i + 2
+	case 2:
+		r, okR := arg(i + 2)
+		g, okG := arg(i + 3)
+		b, okB := arg(i + 4)
+
+		if !okR || !okG || !okB {
+			return "", len(codes)
+		}
+
+		return fmt.Sprintf("#%02x%02x%02x", r, g, b), i + 4
+	default:
+		return "", len(codes)
+	}
+}
+
+// paletteColor names a color of the 256-color palette: the 16 basic colors by name, the 6x6x6
+// color cube and the grayscale ramp as "#rrggbb".
+func paletteColor(n int) string {
+	switch {
+	case n < 8:
+		return ansiColorNames[n]
+	case n < 16:
+		return "bright-" + ansiColorNames[n-8]
+	case n < 232:
+		levels := [6]int{0, 95, 135, 175, 215, 255}
+		n -= 16
+
+		return fmt.Sprintf("#%02x%02x%02x", levels[n/36], levels[n/6%6], levels[n%6])
+	default:
+		gray := 8 + 10*(n-232)
+
+		return fmt.Sprintf("#%02x%02x%02x", gray, gray, gray)
+	}
+}
@@ -0,0 +1,262 @@
+package pipelines
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+var (
+	// gitlabSectionMarker matches a GitLab collapsible section marker, e.g.
+	// "section_start:1560896352:build_script[collapsed=true]\r\x1b[0K". The runner puts several
+	// markers on one line when a section ends where the next one starts.
+	gitlabSectionMarker = regexp.MustCompile(
+		`section_(start|end):(\d+):([A-Za-z0-9_.-]+)(?:\[([^\]]*)\])?\r?(?:\x1b\[0K)?`,
+	)
+
+	// lineTimestamp matches the RFC 3339 timestamp GitHub Actions and Azure Pipelines put before
+	// every log line.
+	lineTimestamp = regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(?:\.\d+)?Z) `)
+)
+
+const (
+	logGroupStart = "##[group]"
+	logGroupEnd   = "##[endgroup]"
+)
+
+// traceSection is a section being built; its children are kept as pointers until it is closed.
+type traceSection struct {
+	section  models.PipelineJobTraceSection
+	started  *time.Time
+	children []*traceSection
+}
+
+func (s *traceSection) build() models.PipelineJobTraceSection {
+	result := s.section
+
+	if len(s.children) > 0 {
+		children := buildSections(s.children)
+		result.Sections = &children
+	}
+
+	return result
+}
+
+func buildSections(sections []*traceSection) []models.PipelineJobTraceSection {
+	result := make([]models.PipelineJobTraceSection, 0, len(sections))
+	for _, s := range sections {
+		result = append(result, s.build())
+	}
+
+	return result
+}
+
+// traceParser turns a job trace into lines and a tree of sections, one line at a time.
+type traceParser struct {
+	ansi     ansiParser
+	lines    []models.PipelineJobTraceLine
+	roots    []*traceSection
+	open     []*traceSection
+	lastLine int // number of the last line emitted
+}
+
+// parseTrace parses a job trace into lines and sections. It understands GitLab section markers
+// and the "##[group]" log groups of GitHub Actions and Azure Pipelines, so it needs no provider:
+// the marker styles cannot be confused with each other.
+func parseTrace(content string) models.PipelineJobTraceStructure {
+	p := &traceParser{}
+
+	content = strings.TrimPrefix(content, "\ufeff")
+	content = strings.TrimSuffix(content, "\n")
+
+	if content != "" {
+		for i, line := range strings.Split(content, "\n") {
+			p.line(i+1, strings.TrimSuffix(line, "\r"))
+		}
+	}
+
+	for len(p.open) > 0 {
+		p.close(len(p.open)-1, p.lastLine, nil)
+	}
+
+	return models.PipelineJobTraceStructure{
+		Lines:    append([]models.PipelineJobTraceLine{}, p.lines...),
+		Sections: buildSections(p.roots),
+	}
+}
+
+func (p *traceParser) line(number int, raw string) {
+	var timestamp *time.Time
+
+	if m := lineTimestamp.FindStringSubmatch(raw); m != nil {
+		if t, err := time.Parse(time.RFC3339Nano, m[1]); err == nil {
+			timestamp = &t
+			raw = raw[len(m[0]):]
+		}
+	}
+
+	raw, marked, header := p.gitlabMarkers(number, raw)
+
+	switch {
+	case strings.HasPrefix(raw, logGroupStart):
+		raw = strings.TrimPrefix(raw, logGroupStart)
+		p.start(stripEscapes(raw), number, timestamp, false)
+
+		header = true
+	case strings.HasPrefix(raw, logGroupEnd):
+		if n := len(p.open); n > 0 {
+			p.close(n-1, p.lastLine, timestamp)
+		}
+
+		return
+	}
+
+	// A carriage return moves the cursor back to the line start, so the text after the last one
+	// is what a terminal would show (progress bars redraw this way).
+	if i := strings.LastIndexByte(raw, '\r'); i >= 0 && i < len(raw)-1 {
+		raw = raw[i+1:]
+	}
+
+	text, spans := p.ansi.parse(raw)
+	if text == "" && (marked || header) {
+		return
+	}
+
+	if header {
+		if n := len(p.open); n > 0 && p.open[n-1].section.StartLine == number {
+			p.open[n-1].section.Header = &text
+		}
+	}
+
+	line := models.PipelineJobTraceLine{Number: number, Text: text, Timestamp: timestamp}
+	if spans != nil {
+		line.Spans = &spans
+	}
+
+	p.lines = append(p.lines, line)
+	p.lastLine = number
+}
+
+// gitlabMarkers applies the GitLab section markers of a line and returns the line without them,
+// whether it had any, and whether a section started on it (its remaining text is then the
+// section header).
+func (p *traceParser) gitlabMarkers(number int, raw string) (rest string, marked, started bool) {
+	matches := gitlabSectionMarker.FindAllStringSubmatchIndex(raw, -1)
+	if matches == nil {
+		return raw, false, false
+	}
+
+	var (
+		text strings.Builder
+		prev int
+	)
+
+	for _, m := range matches {
+		text.WriteString(raw[prev:m[0]])
+		prev = m[1]
+
+		kind, name := raw[m[2]:m[3]], raw[m[6]:m[7]]
+
+		var at *time.Time
+		if sec, err := strconv.ParseInt(raw[m[4]:m[5]], 10, 64); err == nil {
+			t := time.Unix(sec, 0).UTC()
+			at = &t
+		}
+
+		if kind == "start" {
+			collapsed := m[8] >= 0 && strings.Contains(raw[m[8]:m[9]], "collapsed=true")
+			p.start(name, number, at, collapsed)
+			started = true
+
+			continue
+		}
+
+		// Text before the end marker on the same line still belongs to the section.
+		end := p.lastLine
+		if strings.TrimSpace(stripEscapes(text.String())) != "" {
+			end = number
+		}
+
+		p.closeNamed(name, end, at)
+	}
+
+	text.WriteString(raw[prev:])
+
+	return text.String(), true, started
+}
+
+func (p *traceParser) start(name string, number int, at *time.Time, collapsed bool) {
+	s := &traceSection{
+		section: models.PipelineJobTraceSection{Name: name, StartLine: number, EndLine: number},
+		started: at,
+	}
+
+	if collapsed {
+		s.section.Collapsed = &collapsed
+	}
+
+	if n := len(p.open); n > 0 {
+		parent := p.open[n-1]
+		parent.children = append(parent.children, s)
+	} else {
+		p.roots = append(p.roots, s)
+	}
+
+	p.open = append(p.open, s)
+}
+
+// closeNamed closes the innermost open section called name and every section opened inside it.
+// An end marker without a matching start is ignored.
+func (p *traceParser) closeNamed(name string, end int, at *time.Time) {
+	for i := len(p.open) - 1; i >= 0; i-- {
+		if p.open[i].section.Name == name {
+			for len(p.open) > i+1 {
+				p.close(len(p.open)-1, end, nil)
+			}
+
+			p.close(i, end, at)
+
+			return
+		}
+	}
+}
+
+// close closes the open section at index i, which must be the innermost one, at line end. A
+// section without lines of its own ends on its first line.
+func (p *traceParser) close(i int, end int, at *time.Time) {
+	s := p.open[i]
+	s.section.EndLine = max(end, s.section.StartLine)
+
+	if s.started != nil && at != nil {
+		duration := at.Sub(*s.started).Seconds()
+		s.section.Duration = &duration
+	}
+
+	p.open = p.open[:i]
+}
+
+// stripEscapes removes ANSI escape sequences from s without changing any parser's style.
+func stripEscapes(s string) string {
+	var p ansiParser
+
+	text, _ := p.parse(s)
+
+	return text
+}
+
+// withoutSpans returns structure with the style spans of its lines removed, leaving structure
+// itself, which may be cached, unchanged.
+func withoutSpans(structure models.PipelineJobTraceStructure) models.PipelineJobTraceStructure {
+	lines := make([]models.PipelineJobTraceLine, len(structure.Lines))
+	for i, line := range structure.Lines {
+		line.Spans = nil
+		lines[i] = line
+	}
+
+	structure.Lines = lines
+
+	return structure
+}
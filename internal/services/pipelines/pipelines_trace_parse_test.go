@@ -0,0 +1,230 @@
+package pipelines
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+)
+
+// lineTexts returns the numbers and texts of the parsed lines, which is what most tests check.
+func lineTexts(structure models.PipelineJobTraceStructure) map[int]string {
+	texts := make(map[int]string, len(structure.Lines))
+	for _, line := range structure.Lines {
+		texts[line.Number] = line.Text
+	}
+
+	return texts
+}
+
+func TestParseTrace_GitLabSections(t *testing.T) {
+	trace := "Running with gitlab-runner 17.0\n" +
+		"section_start:1700000000:prepare_script[collapsed=true]\r\x1b[0K\x1b[36;1mPreparing environment\x1b[0;m\n" +
+		"Running on runner-1\n" +
+		"section_end:1700000002:prepare_script\r\x1b[0Ksection_start:1700000002:build_script\r\x1b[0KExecuting step\n" +
+		"section_start:1700000003:npm_install\r\x1b[0Knpm install\n" +
+		"added 10 packages\n" +
+		"section_end:1700000010:npm_install\r\x1b[0K\n" +
+		"section_end:1700000012:build_script\r\x1b[0K\n" +
+		"Job succeeded\n"
+
+	structure := parseTrace(trace)
+
+	assert.Equal(t, map[int]string{
+		1: "Running with gitlab-runner 17.0",
+		2: "Preparing environment",
+		3: "Running on runner-1",
+		4: "Executing step",
+		5: "npm install",
+		6: "added 10 packages",
+		9: "Job succeeded",
+	}, lineTexts(structure))
+
+	require.Len(t, structure.Sections, 2)
+
+	prepare := structure.Sections[0]
+	assert.Equal(t, "prepare_script", prepare.Name)
+	assert.Equal(t, "Preparing environment", *prepare.Header)
+	assert.Equal(t, 2, prepare.StartLine)
+	assert.Equal(t, 3, prepare.EndLine)
+	assert.InDelta(t, 2, *prepare.Duration, 0)
+	assert.True(t, *prepare.Collapsed)
+	assert.Nil(t, prepare.Sections)
+
+	build := structure.Sections[1]
+	assert.Equal(t, "build_script", build.Name)
+	assert.Equal(t, "Executing step", *build.Header)
+	assert.Equal(t, 4, build.StartLine)
+	assert.Equal(t, 6, build.EndLine)
+	assert.InDelta(t, 10, *build.Duration, 0)
+	assert.Nil(t, build.Collapsed)
+	require.NotNil(t, build.Sections)
+	require.Len(t, *build.Sections, 1)
+
+	install := (*build.Sections)[0]
+	assert.Equal(t, "npm_install", install.Name)
+	assert.Equal(t, 5, install.StartLine)
+	assert.Equal(t, 6, install.EndLine)
+	assert.InDelta(t, 7, *install.Duration, 0)
+}
+
+func TestParseTrace_LogGroups(t *testing.T) {
+	trace := "\ufeff2024-05-01T10:00:00.0000000Z ##[group]Run actions/checkout@v4\n" +
+		"2024-05-01T10:00:00.1000000Z with:\n" +
+		"2024-05-01T10:00:00.2000000Z   fetch-depth: 1\n" +
+		"2024-05-01T10:00:01.5000000Z ##[endgroup]\n" +
+		"2024-05-01T10:00:02.0000000Z Syncing repository\n"
+
+	structure := parseTrace(trace)
+
+	assert.Equal(t, map[int]string{
+		1: "Run actions/checkout@v4",
+		2: "with:",
+		3: "  fetch-depth: 1",
+		5: "Syncing repository",
+	}, lineTexts(structure))
+
+	require.NotNil(t, structure.Lines[0].Timestamp)
+	assert.Equal(t, "2024-05-01T10:00:00Z", structure.Lines[0].Timestamp.Format("2006-01-02T15:04:05Z07:00"))
+
+	require.Len(t, structure.Sections, 1)
+
+	group := structure.Sections[0]
+	assert.Equal(t, "Run actions/checkout@v4", group.Name)
+	assert.Equal(t, "Run actions/checkout@v4", *group.Header)
+	assert.Equal(t, 1, group.StartLine)
+	assert.Equal(t, 3, group.EndLine)
+	assert.InDelta(t, 1.5, *group.Duration, 1e-9)
+}
+
+func TestParseTrace_CarriageReturnsAndUnclosedSections(t *testing.T) {
+	trace := "##[group]Download\r\n" +
+		"progress 10%\rprogress 50%\rprogress 100%\n" +
+		"section_end:1700000000:unknown\r\x1b[0Kdone\n"
+
+	structure := parseTrace(trace)
+
+	assert.Equal(t, map[int]string{1: "Download", 2: "progress 100%", 3: "done"}, lineTexts(structure))
+
+	require.Len(t, structure.Sections, 1)
+	assert.Equal(t, 1, structure.Sections[0].StartLine)
+	assert.Equal(t, 3, structure.Sections[0].EndLine, "an unclosed section ends at the last line")
+	assert.Nil(t, structure.Sections[0].Duration)
+}
+
+func TestParseTrace_Empty(t *testing.T) {
+	structure := parseTrace("")
+
+	assert.Empty(t, structure.Lines)
+	assert.NotNil(t, structure.Sections)
+	assert.Empty(t, structure.Sections)
+}
+
+func TestAnsiParser(t *testing.T) {
+	pointer := func(s string) *string { return &s }
+	yes := true
+
+	tests := []struct {
+		name      string
+		line      string
+		wantText  string
+		wantSpans []models.PipelineJobTraceSpan
+	}{
+		{
+			name:     "plain text has no spans",
+			line:     "hello \x1b[0Kworld",
+			wantText: "hello world",
+		},
+		{
+			name:     "basic colors and reset",
+			line:     "\x1b[1;31merror:\x1b[0m details",
+			wantText: "error: details",
+			wantSpans: []models.PipelineJobTraceSpan{
+				{Text: "error:", Fg: pointer("red"), Bold: &yes},
+				{Text: " details"},
+			},
+		},
+		{
+			name:     "256 colors and true color",
+			line:     "\x1b[38;5;196ma\x1b[48;2;1;2;3mb\x1b[39;49;92mc",
+			wantText: "abc",
+			wantSpans: []models.PipelineJobTraceSpan{
+				{Text: "a", Fg: pointer("#ff0000")},
+				{Text: "b", Fg: pointer("#ff0000"), Bg: pointer("#010203")},
+				{Text: "c", Fg: pointer("bright-green")},
+			},
+		},
+		{
+			name:     "runs with the same style are merged",
+			line:     "\x1b[32mok\x1b[32m ok\x1b]8;;https://example.com\x1b\\ link",
+			wantText: "ok ok link",
+			wantSpans: []models.PipelineJobTraceSpan{
+				{Text: "ok ok link", Fg: pointer("green")},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p ansiParser
+
+			text, spans := p.parse(tt.line)
+			assert.Equal(t, tt.wantText, text)
+			assert.Equal(t, tt.wantSpans, spans)
+		})
+	}
+}
+
+func TestAnsiParser_StyleCarriesOverLines(t *testing.T) {
+	var p ansiParser
+
+	_, _ = p.parse("\x1b[33mwarning")
+
+	text, spans := p.parse("still yellow")
+	assert.Equal(t, "still yellow", text)
+	require.Len(t, spans, 1)
+	assert.Equal(t, "yellow", *spans[0].Fg)
+}
+
+func TestMultiProviderPipelineService_GetStructuredJobTrace(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+	fake := &fakeJobsProvider{
+		jobs:      []models.PipelineJob{{Id: "7", Name: "build", Status: "success"}},
+		traceText: "\x1b[31mfailed\x1b[0m\n",
+	}
+	svc.providers["gitlab"] = fake
+
+	// Listing the jobs marks job 7 as finished, so its structure is cached with its trace.
+	_, err := svc.ListPipelineJobs(context.Background(), "proj", "1", gitlabSettings())
+	require.NoError(t, err)
+
+	stripped, err := svc.GetStructuredJobTrace(context.Background(), "proj", "7", gitlabSettings(),
+		models.JobTraceWindow{}, true)
+	require.NoError(t, err)
+	assert.Empty(t, stripped.Content)
+	require.NotNil(t, stripped.Structure)
+	require.Len(t, stripped.Structure.Lines, 1)
+	assert.Equal(t, "failed", stripped.Structure.Lines[0].Text)
+	assert.Nil(t, stripped.Structure.Lines[0].Spans)
+
+	cached, ok := svc.traceCache.Get("gs|proj|7")
+	require.True(t, ok)
+	require.NotNil(t, cached.Structure, "a finished job's structure should be cached")
+	assert.NotNil(t, cached.Structure.Lines[0].Spans, "stripping must not change the cached structure")
+
+	styled, err := svc.GetStructuredJobTrace(context.Background(), "proj", "7", gitlabSettings(),
+		models.JobTraceWindow{}, false)
+	require.NoError(t, err)
+	require.NotNil(t, styled.Structure.Lines[0].Spans)
+	assert.Equal(t, "red", *(*styled.Structure.Lines[0].Spans)[0].Fg)
+	assert.Equal(t, 1, fake.traceCalls)
+
+	// The raw trace still comes from the same cache entry.
+	raw, err := svc.GetJobTraceWindow(context.Background(), "proj", "7", gitlabSettings(), models.JobTraceWindow{})
+	require.NoError(t, err)
+	assert.Equal(t, "\x1b[31mfailed\x1b[0m\n", raw.Content)
+}
@@ -0,0 +1,175 @@
+package pipelines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	"golang.org/x/sync/errgroup"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+)
+
+const (
+	// traceSearchConcurrency bounds the job traces a search reads from the provider at once.
+	traceSearchConcurrency = 4
+
+	// maxTraceSearchMatches caps the matches a search returns.
+	maxTraceSearchMatches = 1000
+)
+
+// SearchJobTraces searches the trace of every job of a pipeline for the lines matching opts.Query.
+// Traces are read through GetJobTrace, so they come from the trace cache and concurrent reads of
+// one trace are de-duplicated, and lines are matched on the structured trace, whose parse is cached
+// with a finished job's trace. Jobs without a trace are counted as searched.
+func (m *MultiProviderPipelineService) SearchJobTraces(
+	ctx context.Context,
+	project string,
+	pipelineID string,
+	settings krci.GitServerSettings,
+	opts models.JobTraceSearchOptions,
+) (*models.PipelineJobTraceSearchResponse, error) {
+	re, err := compileTraceQuery(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	jobs, err := m.ListPipelineJobs(ctx, project, pipelineID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	type jobMatches struct {
+		matches   []models.PipelineJobTraceSearchMatch
+		truncated bool
+	}
+
+	results := make([]jobMatches, len(jobs))
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	eg.SetLimit(traceSearchConcurrency)
+
+	for i := range jobs {
+		job := jobs[i]
+
+		eg.Go(func() error {
+			content, truncated, err := m.GetJobTrace(egCtx, project, job.Id, settings)
+			if err != nil {
+				if errors.Is(err, gferrors.ErrNotFound) {
+					// Jobs that never ran (skipped, manual) may have no trace at all.
+					return nil
+				}
+
+				return fmt.Errorf("failed to read trace of job %s: %w", job.Id, err)
+			}
+
+			structure := m.traceStructure(settings.GitServerName, project, job.Id, models.JobTraceWindow{},
+				&models.JobTrace{Content: content, Truncated: truncated})
+
+			// One match past the cap tells the merge below that the result is truncated.
+			results[i] = jobMatches{
+				matches:   searchTraceLines(structure.Lines, re, opts.Context, job, maxTraceSearchMatches+1),
+				truncated: truncated,
+			}
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	resp := &models.PipelineJobTraceSearchResponse{
+		Matches:      []models.PipelineJobTraceSearchMatch{},
+		JobsSearched: len(jobs),
+	}
+
+	var truncatedJobs []string
+
+	for i, result := range results {
+		if result.truncated {
+			truncatedJobs = append(truncatedJobs, jobs[i].Id)
+		}
+
+		for _, match := range result.matches {
+			if len(resp.Matches) == maxTraceSearchMatches {
+				resp.Truncated = true
+
+				break
+			}
+
+			resp.Matches = append(resp.Matches, match)
+		}
+	}
+
+	if truncatedJobs != nil {
+		resp.TruncatedJobs = &truncatedJobs
+	}
+
+	return resp, nil
+}
+
+// compileTraceQuery compiles the search query of opts; an invalid regular expression is a bad
+// request.
+func compileTraceQuery(opts models.JobTraceSearchOptions) (*regexp.Regexp, error) {
+	expr := opts.Query
+	if !opts.Regex {
+		expr = regexp.QuoteMeta(expr)
+	}
+
+	if opts.IgnoreCase {
+		expr = "(?i)" + expr
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search query: %v: %w", err, gferrors.ErrBadRequest)
+	}
+
+	return re, nil
+}
+
+// searchTraceLines returns up to limit lines matching re, each with contextLines lines around it.
+func searchTraceLines(
+	lines []models.PipelineJobTraceLine,
+	re *regexp.Regexp,
+	contextLines int,
+	job models.PipelineJob,
+	limit int,
+) []models.PipelineJobTraceSearchMatch {
+	var matches []models.PipelineJobTraceSearchMatch
+
+	texts := func(lines []models.PipelineJobTraceLine) []string {
+		result := make([]string, len(lines))
+		for i := range lines {
+			result[i] = lines[i].Text
+		}
+
+		return result
+	}
+
+	for i := range lines {
+		if len(matches) == limit {
+			break
+		}
+
+		if !re.MatchString(lines[i].Text) {
+			continue
+		}
+
+		matches = append(matches, models.PipelineJobTraceSearchMatch{
+			JobId:      job.Id,
+			JobName:    job.Name,
+			LineNumber: lines[i].Number,
+			Line:       lines[i].Text,
+			Before:     texts(lines[max(0, i-contextLines):i]),
+			After:      texts(lines[i+1 : min(len(lines), i+1+contextLines)]),
+		})
+	}
+
+	return matches
+}
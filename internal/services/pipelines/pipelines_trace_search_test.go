@@ -0,0 +1,147 @@
+package pipelines
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+)
+
+// fakeSearchProvider serves a trace per job and records how many traces are read at once.
+type fakeSearchProvider struct {
+	fakeJobsProvider
+
+	traces map[string]string // Jobs without a trace answer ErrNotFound
+	errs   map[string]error
+
+	mu          sync.Mutex
+	inFlight    int
+	maxInFlight int
+}
+
+func (f *fakeSearchProvider) GetJobTrace(
+	_ context.Context, _ string, jobID string, _ krci.GitServerSettings,
+) (string, bool, error) {
+	f.mu.Lock()
+	f.inFlight++
+	f.maxInFlight = max(f.maxInFlight, f.inFlight)
+	f.mu.Unlock()
+
+	time.Sleep(time.Millisecond)
+
+	f.mu.Lock()
+	f.inFlight--
+	f.mu.Unlock()
+
+	if err := f.errs[jobID]; err != nil {
+		return "", false, err
+	}
+
+	trace, ok := f.traces[jobID]
+	if !ok {
+		return "", false, fmt.Errorf("job %s has no trace: %w", jobID, gferrors.ErrNotFound)
+	}
+
+	return trace, false, nil
+}
+
+func TestMultiProviderPipelineService_SearchJobTraces(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+	fake := &fakeSearchProvider{
+		fakeJobsProvider: fakeJobsProvider{jobs: []models.PipelineJob{
+			{Id: "1", Name: "build", Status: "success"},
+			{Id: "2", Name: "test", Status: "failed"},
+			{Id: "3", Name: "deploy", Status: "skipped"},
+		}},
+		traces: map[string]string{
+			"1": "compiling\nok\n",
+			"2": "section_start:1700000000:tests\r\x1b[0Krunning tests\n" +
+				"TestA ok\n\x1b[31mERROR: TestB failed\x1b[0m\nTestC ok\nsummary\n" +
+				"section_end:1700000001:tests\r\x1b[0K\n",
+		},
+	}
+	svc.providers["gitlab"] = fake
+
+	got, err := svc.SearchJobTraces(context.Background(), "proj", "5", gitlabSettings(),
+		models.JobTraceSearchOptions{Query: "error:", IgnoreCase: true, Context: 1})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, got.JobsSearched)
+	assert.False(t, got.Truncated)
+	assert.Nil(t, got.TruncatedJobs)
+	assert.Equal(t, []models.PipelineJobTraceSearchMatch{{
+		JobId:      "2",
+		JobName:    "test",
+		LineNumber: 3,
+		Line:       "ERROR: TestB failed",
+		Before:     []string{"TestA ok"},
+		After:      []string{"TestC ok"},
+	}}, got.Matches)
+}
+
+func TestMultiProviderPipelineService_SearchJobTraces_Regex(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+	svc.providers["gitlab"] = &fakeSearchProvider{
+		fakeJobsProvider: fakeJobsProvider{jobs: []models.PipelineJob{{Id: "1", Name: "build", Status: "success"}}},
+		traces:           map[string]string{"1": "exit code 0\nexit code 137\n"},
+	}
+
+	got, err := svc.SearchJobTraces(context.Background(), "proj", "5", gitlabSettings(),
+		models.JobTraceSearchOptions{Query: `exit code [1-9]\d*`, Regex: true})
+	require.NoError(t, err)
+	require.Len(t, got.Matches, 1)
+	assert.Equal(t, 2, got.Matches[0].LineNumber)
+	assert.Empty(t, got.Matches[0].Before)
+	assert.Empty(t, got.Matches[0].After)
+
+	_, err = svc.SearchJobTraces(context.Background(), "proj", "5", gitlabSettings(),
+		models.JobTraceSearchOptions{Query: "(", Regex: true})
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
+
+func TestMultiProviderPipelineService_SearchJobTraces_BoundsConcurrencyAndMatches(t *testing.T) {
+	jobs := make([]models.PipelineJob, 20)
+	traces := make(map[string]string, len(jobs))
+
+	for i := range jobs {
+		id := fmt.Sprint(i + 1)
+		jobs[i] = models.PipelineJob{Id: id, Name: "job-" + id, Status: "success"}
+		traces[id] = strings.Repeat("match\n", 60)
+	}
+
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+	fake := &fakeSearchProvider{fakeJobsProvider: fakeJobsProvider{jobs: jobs}, traces: traces}
+	svc.providers["gitlab"] = fake
+
+	got, err := svc.SearchJobTraces(context.Background(), "proj", "5", gitlabSettings(),
+		models.JobTraceSearchOptions{Query: "match"})
+	require.NoError(t, err)
+
+	assert.LessOrEqual(t, fake.maxInFlight, traceSearchConcurrency)
+	assert.Len(t, got.Matches, maxTraceSearchMatches)
+	assert.True(t, got.Truncated)
+	assert.Equal(t, "1", got.Matches[0].JobId, "matches keep the job list order")
+	assert.Equal(t, "17", got.Matches[maxTraceSearchMatches-1].JobId)
+}
+
+func TestMultiProviderPipelineService_SearchJobTraces_ProviderError(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+	svc.providers["gitlab"] = &fakeSearchProvider{
+		fakeJobsProvider: fakeJobsProvider{jobs: []models.PipelineJob{{Id: "1", Name: "build", Status: "success"}}},
+		errs:             map[string]error{"1": fmt.Errorf("token expired: %w", gferrors.ErrUnauthorized)},
+	}
+
+	_, err := svc.SearchJobTraces(context.Background(), "proj", "5", gitlabSettings(),
+		models.JobTraceSearchOptions{Query: "x"})
+	assert.ErrorIs(t, err, gferrors.ErrUnauthorized)
+}
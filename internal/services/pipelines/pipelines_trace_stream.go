@@ -0,0 +1,369 @@
+package pipelines
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+)
+
+const (
+	// traceStreamPollInterval is how often a stream polls the provider for new trace bytes.
+	traceStreamPollInterval = 2 * time.Second
+
+	// traceStreamBufferBytes caps the trace bytes a stream retains for its viewers; older bytes
+	// are dropped, so a viewer far behind reads them from the provider itself.
+	traceStreamBufferBytes = 4 * 1024 * 1024
+
+	// traceStreamReadRetries bounds the consecutive failed reads a poller retries before it ends
+	// its stream with the last error.
+	traceStreamReadRetries = 5
+
+	// traceStreamMaxRetryDelay caps the exponential backoff between those retries.
+	traceStreamMaxRetryDelay = 30 * time.Second
+)
+
+// traceRangeFunc reads a job trace from offset on.
+type traceRangeFunc func(ctx context.Context, offset int64) (*models.JobTraceRange, error)
+
+// traceStreamHub runs one upstream poller per job, shared by every viewer of that job. A poller
+// stops when its job reaches a terminal status, when it fails, or when its last viewer leaves.
+// Failed reads are retried with backoff unless the job is missing or inaccessible.
+type traceStreamHub struct {
+	mu           sync.Mutex
+	streams      map[string]*traceStream
+	pollInterval time.Duration
+}
+
+func newTraceStreamHub() *traceStreamHub {
+	return &traceStreamHub{
+		streams:      make(map[string]*traceStream),
+		pollInterval: traceStreamPollInterval,
+	}
+}
+
+// traceStream holds the trace bytes read so far by one poller. changed is closed and replaced
+// after every poll, waking the viewers waiting on it.
+type traceStream struct {
+	read traceRangeFunc
+
+	mu      sync.Mutex
+	base    int64 // trace offset of buf[0]
+	buf     []byte
+	status  string
+	polled  bool
+	done    bool
+	err     error
+	changed chan struct{}
+
+	viewers int
+	cancel  context.CancelFunc
+}
+
+// subscribe joins the stream of key, starting a poller from offset if there is none. A viewer
+// joining a poller that started after its offset backfills the gap itself; see follow.
+// Every subscribe must be paired with an unsubscribe.
+func (h *traceStreamHub) subscribe(key string, offset int64, read traceRangeFunc) *traceStream {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if s, ok := h.streams[key]; ok {
+		s.viewers++
+
+		return s
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &traceStream{
+		read:    read,
+		base:    offset,
+		changed: make(chan struct{}),
+		viewers: 1,
+		cancel:  cancel,
+	}
+	h.streams[key] = s
+
+	go h.poll(ctx, key, s)
+
+	return s
+}
+
+// unsubscribe leaves the stream of key and stops its poller when no viewer is left.
+func (h *traceStreamHub) unsubscribe(key string, s *traceStream) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	s.viewers--
+	if s.viewers > 0 {
+		return
+	}
+
+	s.cancel()
+
+	if h.streams[key] == s {
+		delete(h.streams, key)
+	}
+}
+
+// remove forgets a finished stream so that later viewers start a fresh poller; viewers still
+// attached keep reading its buffer.
+func (h *traceStreamHub) remove(key string, s *traceStream) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.streams[key] == s {
+		delete(h.streams, key)
+	}
+}
+
+func (h *traceStreamHub) poll(ctx context.Context, key string, s *traceStream) {
+	failures := 0
+
+	for {
+		s.mu.Lock()
+		next := s.base + int64(len(s.buf))
+		s.mu.Unlock()
+
+		r, err := s.read(ctx, next)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil && !isPermanentTraceError(err) && failures < traceStreamReadRetries {
+			failures++
+			delay := min(h.pollInterval<<(failures-1), traceStreamMaxRetryDelay)
+
+			slog.Warn("Failed to poll job trace, retrying", "stream", key, "attempt", failures, "error", err)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+
+			continue
+		}
+
+		failures = 0
+
+		s.update(r, err)
+
+		if s.isDone() {
+			h.remove(key, s)
+
+			return
+		}
+
+		// A truncated read has more bytes waiting; fetch them without waiting.
+		if r.Truncated {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(h.pollInterval):
+		}
+	}
+}
+
+// isPermanentTraceError reports whether a failed trace read would fail again: the job is gone,
+// the credentials are rejected or the request is invalid.
+func isPermanentTraceError(err error) bool {
+	return errors.Is(err, gferrors.ErrNotFound) ||
+		errors.Is(err, gferrors.ErrUnauthorized) ||
+		errors.Is(err, gferrors.ErrBadRequest)
+}
+
+func (s *traceStream) update(r *models.JobTraceRange, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.polled = true
+
+	if err != nil {
+		s.err = err
+		s.done = true
+	} else {
+		s.buf = append(s.buf, r.Content...)
+		if over := len(s.buf) - traceStreamBufferBytes; over > 0 {
+			s.buf = append([]byte(nil), s.buf[over:]...)
+			s.base += int64(over)
+		}
+
+		s.status = r.Status
+		s.done = isTerminalJobStatus(r.Status) && !r.Truncated
+	}
+
+	close(s.changed)
+	s.changed = make(chan struct{})
+}
+
+func (s *traceStream) isDone() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.done
+}
+
+// waitFirstPoll blocks until the stream has polled the provider once and returns the error of a
+// stream that failed, so a request for a missing job is rejected before streaming starts.
+func (s *traceStream) waitFirstPoll(ctx context.Context) error {
+	for {
+		s.mu.Lock()
+		polled, err, changed := s.polled, s.err, s.changed
+		s.mu.Unlock()
+
+		if polled {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// follow sends the stream's bytes from offset on to events until the job is done, the stream
+// fails or ctx is cancelled, then closes events. Bytes before the stream's buffer (the poller
+// started after offset, or dropped them) are read from the provider first. A character split by
+// a poll is held back until its remaining bytes arrive, since a chunk is sent as JSON text.
+func (s *traceStream) follow(ctx context.Context, offset int64, events chan<- models.PipelineJobTraceEvent) {
+	defer close(events)
+
+	send := func(ev models.PipelineJobTraceEvent) bool {
+		select {
+		case events <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for {
+		s.mu.Lock()
+
+		if base := s.base; offset < base {
+			s.mu.Unlock()
+
+			var ok bool
+			if offset, ok = s.backfill(ctx, offset, base, send); !ok {
+				return
+			}
+
+			continue
+		}
+
+		var chunk *models.PipelineJobTraceChunk
+
+		done, err, status, changed := s.done, s.err, s.status, s.changed
+
+		end := s.base + int64(len(s.buf))
+		if offset < end {
+			content := string(s.buf[offset-s.base:])
+			if !done {
+				content = content[:completeRunes(content)]
+			}
+
+			if content != "" {
+				chunk = &models.PipelineJobTraceChunk{Offset: offset, Content: content}
+				offset += int64(len(content))
+			}
+		}
+
+		s.mu.Unlock()
+
+		if chunk != nil && !send(models.PipelineJobTraceEvent{Chunk: chunk}) {
+			return
+		}
+
+		if done {
+			if err != nil {
+				send(models.PipelineJobTraceEvent{Err: err})
+			} else {
+				send(models.PipelineJobTraceEvent{End: &models.PipelineJobTraceEnd{Status: status, Offset: end}})
+			}
+
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-changed:
+		}
+	}
+}
+
+// backfill sends the trace bytes from offset up to base, which precede the stream's buffer, with
+// ranged reads of the provider, and returns the offset it reached. If a read fails the viewer
+// skips ahead to base; the chunk offset tells where the data resumes. ok is false once the
+// viewer has left.
+func (s *traceStream) backfill(
+	ctx context.Context,
+	offset, base int64,
+	send func(models.PipelineJobTraceEvent) bool,
+) (next int64, ok bool) {
+	for offset < base {
+		r, err := s.read(ctx, offset)
+		if err != nil {
+			if ctx.Err() != nil {
+				return offset, false
+			}
+
+			slog.Warn("Failed to backfill job trace", "offset", offset, "error", err)
+
+			return base, true
+		}
+
+		// Stop at base, or just past it when a character straddles it, and never inside a
+		// character cut by the provider's read limit.
+		content := r.Content
+		if n := int(base - offset); len(content) > n {
+			for n < len(content) && !utf8.RuneStart(content[n]) {
+				n++
+			}
+
+			content = content[:n]
+		}
+
+		content = content[:completeRunes(content)]
+
+		if content == "" {
+			return base, true
+		}
+
+		if !send(models.PipelineJobTraceEvent{Chunk: &models.PipelineJobTraceChunk{Offset: offset, Content: content}}) {
+			return offset, false
+		}
+
+		offset += int64(len(content))
+	}
+
+	return offset, true
+}
+
+// completeRunes returns the length of the longest prefix of s that does not end inside a UTF-8
+// encoded character. Invalid bytes count as complete, so only a cut character is held back.
+func completeRunes(s string) int {
+	for i := len(s) - 1; i >= 0 && i >= len(s)-utf8.UTFMax; i-- {
+		if !utf8.RuneStart(s[i]) {
+			continue
+		}
+
+		if utf8.FullRuneInString(s[i:]) {
+			return len(s)
+		}
+
+		return i
+	}
+
+	return len(s)
+}
@@ -0,0 +1,386 @@
+package pipelines
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+)
+
+// scriptedTrace serves a growing job trace: each read reveals the next step of trace and status.
+// Reads are counted so tests can prove that viewers share one poller.
+type scriptedTrace struct {
+	mu       sync.Mutex
+	reads    int
+	steps    []models.JobTraceRange // cumulative trace and status after each read
+	err      error
+	failures int // reads failing with a transient error before the script starts
+}
+
+func (f *scriptedTrace) read(_ context.Context, offset int64) (*models.JobTraceRange, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	if f.failures > 0 {
+		f.failures--
+
+		return nil, errors.New("connection reset by peer")
+	}
+
+	step := f.steps[min(f.reads, len(f.steps)-1)]
+	f.reads++
+
+	content := ""
+	if offset < int64(len(step.Content)) {
+		content = step.Content[offset:]
+	}
+
+	return &models.JobTraceRange{Content: content, Status: step.Status}, nil
+}
+
+func (f *scriptedTrace) readCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return f.reads
+}
+
+func newTestTraceStreamHub() *traceStreamHub {
+	hub := newTraceStreamHub()
+	hub.pollInterval = 5 * time.Millisecond
+
+	return hub
+}
+
+// collect follows a stream to its end and returns the concatenated trace and the end event.
+func collect(t *testing.T, events <-chan models.PipelineJobTraceEvent) (string, *models.PipelineJobTraceEnd, error) {
+	t.Helper()
+
+	var (
+		trace string
+		end   *models.PipelineJobTraceEnd
+		err   error
+	)
+
+	timeout := time.After(5 * time.Second)
+
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return trace, end, err
+			}
+
+			switch {
+			case ev.Chunk != nil:
+				assert.Equal(t, int64(len(trace)), ev.Chunk.Offset, "chunks must be contiguous")
+				trace += ev.Chunk.Content
+			case ev.End != nil:
+				end = ev.End
+			default:
+				err = ev.Err
+			}
+		case <-timeout:
+			t.Fatal("stream did not end")
+		}
+	}
+}
+
+func follow(ctx context.Context, hub *traceStreamHub, key string, offset int64, read traceRangeFunc) (
+	<-chan models.PipelineJobTraceEvent,
+	*traceStream,
+) {
+	s := hub.subscribe(key, offset, read)
+	events := make(chan models.PipelineJobTraceEvent)
+
+	go func() {
+		defer hub.unsubscribe(key, s)
+
+		s.follow(ctx, offset, events)
+	}()
+
+	return events, s
+}
+
+func TestTraceStreamHub_StreamsUntilTerminal(t *testing.T) {
+	hub := newTestTraceStreamHub()
+	trace := &scriptedTrace{steps: []models.JobTraceRange{
+		{Content: "line 1\n", Status: "running"},
+		{Content: "line 1\nline 2\n", Status: "running"},
+		{Content: "line 1\nline 2\ndone\n", Status: "success"},
+	}}
+
+	events, _ := follow(context.Background(), hub, "k", 0, trace.read)
+
+	content, end, err := collect(t, events)
+	require.NoError(t, err)
+	assert.Equal(t, "line 1\nline 2\ndone\n", content)
+	require.NotNil(t, end)
+	assert.Equal(t, "success", end.Status)
+	assert.Equal(t, int64(len(content)), end.Offset)
+}
+
+func TestTraceStreamHub_ViewersShareOnePoller(t *testing.T) {
+	hub := newTestTraceStreamHub()
+	trace := &scriptedTrace{steps: []models.JobTraceRange{
+		{Content: "a", Status: "running"},
+		{Content: "ab", Status: "running"},
+		{Content: "abc", Status: "running"},
+		{Content: "abcd", Status: "success"},
+	}}
+
+	first, _ := follow(context.Background(), hub, "k", 0, trace.read)
+	second, _ := follow(context.Background(), hub, "k", 0, trace.read)
+
+	var wg sync.WaitGroup
+
+	for _, events := range []<-chan models.PipelineJobTraceEvent{first, second} {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			content, end, err := collect(t, events)
+			assert.NoError(t, err)
+			assert.Equal(t, "abcd", content)
+			assert.NotNil(t, end)
+		}()
+	}
+
+	wg.Wait()
+
+	assert.Equal(t, 4, trace.readCount(), "both viewers should be served by a single poller")
+}
+
+func TestTraceStreamHub_ResumesFromOffset(t *testing.T) {
+	hub := newTestTraceStreamHub()
+	trace := &scriptedTrace{steps: []models.JobTraceRange{{Content: "0123456789", Status: "success"}}}
+
+	s := hub.subscribe("k", 4, trace.read)
+	events := make(chan models.PipelineJobTraceEvent)
+
+	go func() {
+		defer hub.unsubscribe("k", s)
+
+		s.follow(context.Background(), 4, events)
+	}()
+
+	ev := <-events
+	require.NotNil(t, ev.Chunk)
+	assert.Equal(t, int64(4), ev.Chunk.Offset)
+	assert.Equal(t, "456789", ev.Chunk.Content)
+
+	ev = <-events
+	require.NotNil(t, ev.End)
+	assert.Equal(t, int64(10), ev.End.Offset)
+}
+
+func TestTraceStreamHub_LateViewerBackfillsEarlierBytes(t *testing.T) {
+	hub := newTestTraceStreamHub()
+	trace := &scriptedTrace{steps: []models.JobTraceRange{
+		{Content: "0123456789", Status: "running"},
+		{Content: "0123456789abc", Status: "success"},
+	}}
+
+	// The first viewer resumes at 6, so the shared poller starts there.
+	first, s := follow(context.Background(), hub, "k", 6, trace.read)
+	require.NoError(t, s.waitFirstPoll(context.Background()))
+
+	second, _ := follow(context.Background(), hub, "k", 0, trace.read)
+
+	content, end, err := collect(t, second)
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789abc", content, "bytes before the poller's start must be backfilled")
+	require.NotNil(t, end)
+
+	for range first { //nolint:revive // drain the first viewer
+	}
+}
+
+func TestTraceStreamHub_KeepsCharactersSplitByAPollWhole(t *testing.T) {
+	hub := newTestTraceStreamHub()
+	trace := &scriptedTrace{steps: []models.JobTraceRange{
+		{Content: "caf\xc3", Status: "running"},
+		{Content: "café ✓\n", Status: "success"},
+	}}
+
+	events, _ := follow(context.Background(), hub, "k", 0, trace.read)
+
+	var chunks []models.PipelineJobTraceChunk
+
+	for ev := range events {
+		if ev.Chunk != nil {
+			chunks = append(chunks, *ev.Chunk)
+		}
+	}
+
+	require.Len(t, chunks, 2)
+	assert.Equal(t, models.PipelineJobTraceChunk{Offset: 0, Content: "caf"}, chunks[0])
+	assert.Equal(t, models.PipelineJobTraceChunk{Offset: 3, Content: "é ✓\n"}, chunks[1])
+}
+
+func TestTraceStreamHub_BackfillKeepsCharactersWhole(t *testing.T) {
+	hub := newTestTraceStreamHub()
+	trace := &scriptedTrace{steps: []models.JobTraceRange{
+		{Content: "añb", Status: "running"},
+		{Content: "añbc", Status: "success"},
+	}}
+
+	// The poller starts inside ñ, so the backfill of the second viewer ends past it.
+	first, s := follow(context.Background(), hub, "k", 2, trace.read)
+	require.NoError(t, s.waitFirstPoll(context.Background()))
+
+	second, _ := follow(context.Background(), hub, "k", 0, trace.read)
+
+	var chunks []string
+
+	for ev := range second {
+		if ev.Chunk != nil {
+			assert.True(t, utf8.ValidString(ev.Chunk.Content), "chunk %q splits a character", ev.Chunk.Content)
+			chunks = append(chunks, ev.Chunk.Content)
+		}
+	}
+
+	assert.Equal(t, "añbc", strings.Join(chunks, ""))
+
+	for range first { //nolint:revive // drain the first viewer
+	}
+}
+
+func TestTraceStreamHub_RetriesTransientErrors(t *testing.T) {
+	hub := newTestTraceStreamHub()
+	trace := &scriptedTrace{failures: 2, steps: []models.JobTraceRange{{Content: "done\n", Status: "success"}}}
+
+	events, _ := follow(context.Background(), hub, "k", 0, trace.read)
+
+	content, end, err := collect(t, events)
+	require.NoError(t, err)
+	assert.Equal(t, "done\n", content)
+	require.NotNil(t, end)
+}
+
+func TestTraceStreamHub_PersistentErrorEndsStream(t *testing.T) {
+	hub := newTestTraceStreamHub()
+	trace := &scriptedTrace{
+		failures: traceStreamReadRetries + 1,
+		steps:    []models.JobTraceRange{{Content: "never", Status: "success"}},
+	}
+
+	events, _ := follow(context.Background(), hub, "k", 0, trace.read)
+
+	content, end, err := collect(t, events)
+	require.Error(t, err)
+	assert.Empty(t, content)
+	assert.Nil(t, end)
+}
+
+func TestTraceStreamHub_LastViewerStopsPoller(t *testing.T) {
+	hub := newTestTraceStreamHub()
+	trace := &scriptedTrace{steps: []models.JobTraceRange{{Content: "", Status: "running"}}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, s := follow(ctx, hub, "k", 0, trace.read)
+
+	require.NoError(t, s.waitFirstPoll(context.Background()))
+	cancel()
+
+	for range events { //nolint:revive // drain until the viewer has left
+	}
+
+	assert.Eventually(t, func() bool {
+		hub.mu.Lock()
+		defer hub.mu.Unlock()
+
+		return len(hub.streams) == 0
+	}, time.Second, 5*time.Millisecond)
+
+	reads := trace.readCount()
+
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, reads, trace.readCount(), "the poller should stop once no viewer is left")
+}
+
+func TestTraceStreamHub_ErrorEndsStream(t *testing.T) {
+	hub := newTestTraceStreamHub()
+	trace := &scriptedTrace{err: fmt.Errorf("job: %w", gferrors.ErrNotFound)}
+
+	s := hub.subscribe("k", 0, trace.read)
+	defer hub.unsubscribe("k", s)
+
+	err := s.waitFirstPoll(context.Background())
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+// fakeTraceStreamProvider implements PipelineProvider and PipelineJobTraceStreamProvider.
+type fakeTraceStreamProvider struct {
+	fakeJobsProvider
+
+	trace *scriptedTrace
+}
+
+func (f *fakeTraceStreamProvider) GetJobTraceRange(
+	ctx context.Context, _ string, _ string, _ krci.GitServerSettings, offset int64,
+) (*models.JobTraceRange, error) {
+	return f.trace.read(ctx, offset)
+}
+
+func TestMultiProviderPipelineService_StreamJobTrace(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+	svc.traceStreams.pollInterval = 5 * time.Millisecond
+	svc.providers["gitlab"] = &fakeTraceStreamProvider{trace: &scriptedTrace{steps: []models.JobTraceRange{
+		{Content: "hello", Status: "running"},
+		{Content: "hello world", Status: "failed"},
+	}}}
+
+	events, err := svc.StreamJobTrace(context.Background(), "proj", "42", gitlabSettings(), 0)
+	require.NoError(t, err)
+
+	content, end, err := collect(t, events)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", content)
+	require.NotNil(t, end)
+	assert.Equal(t, "failed", end.Status)
+}
+
+func TestMultiProviderPipelineService_StreamJobTrace_FirstReadErrorIsReturned(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+	svc.providers["gitlab"] = &fakeTraceStreamProvider{
+		trace: &scriptedTrace{err: fmt.Errorf("job: %w", gferrors.ErrNotFound)},
+	}
+
+	events, err := svc.StreamJobTrace(context.Background(), "proj", "42", gitlabSettings(), 0)
+	assert.Nil(t, events)
+	assert.ErrorIs(t, err, gferrors.ErrNotFound)
+}
+
+func TestMultiProviderPipelineService_StreamJobTrace_UnsupportedProviderReturnsBadRequest(t *testing.T) {
+	svc := NewMultiProviderPipelineService(registry.NewDefault())
+
+	_, err := svc.StreamJobTrace(context.Background(), "proj", "42",
+		krci.GitServerSettings{GitProvider: "bitbucket"}, 0)
+	assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+}
+
+func TestPipelineJobTraceStreamCapabilityDeclarations(t *testing.T) {
+	reg := registry.NewDefault()
+
+	assert.NotPanics(t, func() {
+		registry.Providers[PipelineJobTraceStreamProvider](reg, registry.CapabilityPipelineJobTraceStream)
+	}, "every provider declaring trace streaming must implement PipelineJobTraceStreamProvider")
+}
@@ -0,0 +1,52 @@
+package pipelines
+
+import (
+	"fmt"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/common"
+)
+
+// cutTraceWindow cuts window w from content, a trace read from its start. complete tells whether
+// content is the whole trace; when it is not, windows reaching past it cannot be served.
+func cutTraceWindow(content string, complete bool, w models.JobTraceWindow) (*models.JobTrace, error) {
+	size := int64(len(content))
+
+	var total *int64
+	if complete {
+		total = &size
+	}
+
+	if w.TailLines > 0 {
+		if !complete {
+			return nil, fmt.Errorf("the trace exceeds what this provider can read, so its tail is unavailable: %w",
+				gferrors.ErrBadRequest)
+		}
+
+		tail, skipped := common.TailLines(content, w.TailLines)
+
+		return &models.JobTrace{Content: tail, Offset: int64(skipped), TotalSize: total}, nil
+	}
+
+	if w.Offset >= size && !complete {
+		return nil, fmt.Errorf("offset %d is beyond the first %d trace bytes this provider can read: %w",
+			w.Offset, size, gferrors.ErrBadRequest)
+	}
+
+	if w.Offset > size {
+		return &models.JobTrace{Offset: w.Offset, TotalSize: total}, nil
+	}
+
+	end := size
+	if w.Limit > 0 && w.Offset+w.Limit < end {
+		end = w.Offset + w.Limit
+	}
+
+	return &models.JobTrace{
+		Content:   content[w.Offset:end],
+		Offset:    w.Offset,
+		TotalSize: total,
+		Truncated: end < size || !complete,
+	}, nil
+}
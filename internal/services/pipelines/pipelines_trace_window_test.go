@@ -0,0 +1,155 @@
+package pipelines
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	gferrors "github.com/KubeRocketCI/gitfusion/internal/errors"
+	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
+)
+
+func TestCutTraceWindow(t *testing.T) {
+	trace := "line 1\nline 2\nline 3\n"
+	size := int64(len(trace))
+
+	tests := []struct {
+		name     string
+		complete bool
+		w        models.JobTraceWindow
+		want     models.JobTrace
+		wantErr  bool
+	}{
+		{
+			name:     "whole trace",
+			complete: true,
+			want:     models.JobTrace{Content: trace, TotalSize: &size},
+		},
+		{
+			name:     "offset and limit",
+			complete: true,
+			w:        models.JobTraceWindow{Offset: 7, Limit: 7},
+			want:     models.JobTrace{Content: "line 2\n", Offset: 7, TotalSize: &size, Truncated: true},
+		},
+		{
+			name:     "offset past the end",
+			complete: true,
+			w:        models.JobTraceWindow{Offset: 100},
+			want:     models.JobTrace{Offset: 100, TotalSize: &size},
+		},
+		{
+			name:     "tail",
+			complete: true,
+			w:        models.JobTraceWindow{TailLines: 1},
+			want:     models.JobTrace{Content: "line 3\n", Offset: 14, TotalSize: &size},
+		},
+		{
+			name: "capped trace has no known size",
+			w:    models.JobTraceWindow{Offset: 14},
+			want: models.JobTrace{Content: "line 3\n", Offset: 14, Truncated: true},
+		},
+		{
+			name:    "offset past a capped trace",
+			w:       models.JobTraceWindow{Offset: size},
+			wantErr: true,
+		},
+		{
+			name:    "tail of a capped trace",
+			w:       models.JobTraceWindow{TailLines: 1},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cutTraceWindow(trace, tt.complete, tt.w)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, *got)
+		})
+	}
+}
+
+// fakeTraceWindowProvider implements PipelineJobTraceWindowProvider on top of fakeJobsProvider and
+// records the windows it is asked for.
+type fakeTraceWindowProvider struct {
+	fakeJobsProvider
+
+	windows []models.JobTraceWindow
+}
+
+func (f *fakeTraceWindowProvider) GetJobTraceWindow(
+	_ context.Context, _ string, _ string, _ krci.GitServerSettings, w models.JobTraceWindow,
+) (*models.JobTrace, error) {
+	f.windows = append(f.windows, w)
+
+	return &models.JobTrace{Content: "window", Offset: w.Offset}, nil
+}
+
+func TestMultiProviderPipelineService_GetJobTraceWindow(t *testing.T) {
+	t.Run("window provider reads windows past the cap", func(t *testing.T) {
+		svc := NewMultiProviderPipelineService(registry.NewDefault())
+		fake := &fakeTraceWindowProvider{fakeJobsProvider: fakeJobsProvider{traceText: "head", truncated: true}}
+		svc.providers["gitlab"] = fake
+
+		got, err := svc.GetJobTraceWindow(context.Background(), "proj", "7", gitlabSettings(),
+			models.JobTraceWindow{Offset: 8 << 20})
+		require.NoError(t, err)
+		assert.Equal(t, "window", got.Content)
+		assert.Equal(t, []models.JobTraceWindow{{Offset: 8 << 20}}, fake.windows)
+		assert.Equal(t, 0, fake.traceCalls)
+	})
+
+	t.Run("whole trace goes through the trace cache", func(t *testing.T) {
+		svc := NewMultiProviderPipelineService(registry.NewDefault())
+		fake := &fakeTraceWindowProvider{fakeJobsProvider: fakeJobsProvider{traceText: "line 1\nline 2\n"}}
+		svc.providers["gitlab"] = fake
+
+		got, err := svc.GetJobTraceWindow(context.Background(), "proj", "7", gitlabSettings(), models.JobTraceWindow{})
+		require.NoError(t, err)
+		assert.Equal(t, "line 1\nline 2\n", got.Content)
+		require.NotNil(t, got.TotalSize)
+		assert.Equal(t, int64(14), *got.TotalSize)
+
+		// The complete trace is now cached and serves later windows without the provider.
+		got, err = svc.GetJobTraceWindow(context.Background(), "proj", "7", gitlabSettings(),
+			models.JobTraceWindow{TailLines: 1})
+		require.NoError(t, err)
+		assert.Equal(t, "line 2\n", got.Content)
+		assert.Equal(t, 1, fake.traceCalls)
+		assert.Empty(t, fake.windows)
+	})
+
+	t.Run("other providers have windows cut from the capped trace", func(t *testing.T) {
+		svc := NewMultiProviderPipelineService(registry.NewDefault())
+		svc.providers["gitlab"] = &fakeJobsProvider{traceText: "line 1\nline 2\n", truncated: true}
+
+		got, err := svc.GetJobTraceWindow(context.Background(), "proj", "7", gitlabSettings(),
+			models.JobTraceWindow{Offset: 7})
+		require.NoError(t, err)
+		assert.Equal(t, "line 2\n", got.Content)
+		assert.True(t, got.Truncated)
+		assert.Nil(t, got.TotalSize)
+
+		_, err = svc.GetJobTraceWindow(context.Background(), "proj", "7", gitlabSettings(),
+			models.JobTraceWindow{TailLines: 1})
+		assert.ErrorIs(t, err, gferrors.ErrBadRequest)
+	})
+}
+
+func TestPipelineJobTraceWindowCapabilityDeclarations(t *testing.T) {
+	reg := registry.NewDefault()
+
+	assert.NotPanics(t, func() {
+		registry.Providers[PipelineJobTraceWindowProvider](reg, registry.CapabilityPipelineJobTraceWindow)
+	}, "every provider declaring trace windows must implement PipelineJobTraceWindowProvider")
+}
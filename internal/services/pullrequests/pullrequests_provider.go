@@ -8,10 +8,8 @@ import (
 
 	"github.com/KubeRocketCI/gitfusion/internal/cache"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
-	"github.com/KubeRocketCI/gitfusion/internal/services/bitbucket"
-	"github.com/KubeRocketCI/gitfusion/internal/services/github"
-	"github.com/KubeRocketCI/gitfusion/internal/services/gitlab"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
 )
 
 type PullRequestsProvider interface {
@@ -28,14 +26,10 @@ type MultiProviderPullRequestsService struct {
 	cache     *sturdyc.Client[models.PullRequestsResponse]
 }
 
-func NewMultiProviderPullRequestsService() *MultiProviderPullRequestsService {
+func NewMultiProviderPullRequestsService(reg *registry.Registry) *MultiProviderPullRequestsService {
 	return &MultiProviderPullRequestsService{
-		providers: map[string]PullRequestsProvider{
-			"github":    github.NewGitHubProvider(),
-			"gitlab":    gitlab.NewGitlabProvider(),
-			"bitbucket": bitbucket.NewBitbucketProvider(),
-		},
-		cache: cache.NewPullRequestCache(),
+		providers: registry.Providers[PullRequestsProvider](reg, registry.CapabilityPullRequests),
+		cache:     cache.NewPullRequestCache(),
 	}
 }
 
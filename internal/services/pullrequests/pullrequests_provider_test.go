@@ -7,16 +7,17 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
 )
 
 func TestNewMultiProviderPullRequestsService(t *testing.T) {
-	service := NewMultiProviderPullRequestsService()
+	service := NewMultiProviderPullRequestsService(registry.NewDefault())
 
 	assert.NotNil(t, service)
 	assert.NotNil(t, service.providers)
 	assert.NotNil(t, service.cache)
 
-	// Verify all three providers are registered
+	// Verify all providers are registered
 	_, githubOK := service.providers["github"]
 	assert.True(t, githubOK, "github provider should be registered")
 
@@ -26,11 +27,23 @@ func TestNewMultiProviderPullRequestsService(t *testing.T) {
 	_, bitbucketOK := service.providers["bitbucket"]
 	assert.True(t, bitbucketOK, "bitbucket provider should be registered")
 
-	assert.Equal(t, 3, len(service.providers), "should have exactly 3 providers registered")
+	_, bitbucketDCOK := service.providers["bitbucketdc"]
+	assert.True(t, bitbucketDCOK, "bitbucketdc provider should be registered")
+
+	_, giteaOK := service.providers["gitea"]
+	assert.True(t, giteaOK, "gitea provider should be registered")
+
+	_, azureDevOpsOK := service.providers["azuredevops"]
+	assert.True(t, azureDevOpsOK, "azuredevops provider should be registered")
+
+	_, gerritOK := service.providers["gerrit"]
+	assert.True(t, gerritOK, "gerrit provider should be registered")
+
+	assert.Equal(t, 7, len(service.providers), "should have exactly 7 providers registered")
 }
 
 func TestMultiProviderPullRequestsService_GetCache(t *testing.T) {
-	service := NewMultiProviderPullRequestsService()
+	service := NewMultiProviderPullRequestsService(registry.NewDefault())
 
 	cache := service.GetCache()
 	assert.NotNil(t, cache, "cache should not be nil")
@@ -61,7 +74,7 @@ func TestMultiProviderPullRequestsService_UnsupportedProvider(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			service := NewMultiProviderPullRequestsService()
+			service := NewMultiProviderPullRequestsService(registry.NewDefault())
 
 			result, err := service.ListPullRequests(
 				context.Background(),
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/KubeRocketCI/gitfusion/internal/models"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
 )
 
 func defaultOpts() models.PullRequestListOptions {
@@ -18,7 +19,7 @@ func defaultOpts() models.PullRequestListOptions {
 
 func TestNewPullRequestsService(t *testing.T) {
 	t.Run("creates service with valid dependencies", func(t *testing.T) {
-		multiProvider := NewMultiProviderPullRequestsService()
+		multiProvider := NewMultiProviderPullRequestsService(registry.NewDefault())
 		assert.NotNil(t, multiProvider)
 
 		service := NewPullRequestsService(multiProvider, nil)
@@ -28,7 +29,7 @@ func TestNewPullRequestsService(t *testing.T) {
 }
 
 func TestPullRequestsService_GetProvider(t *testing.T) {
-	multiProvider := NewMultiProviderPullRequestsService()
+	multiProvider := NewMultiProviderPullRequestsService(registry.NewDefault())
 	service := NewPullRequestsService(multiProvider, nil)
 
 	provider := service.GetProvider()
@@ -0,0 +1,81 @@
+package registry
+
+import (
+	"slices"
+
+	"github.com/KubeRocketCI/gitfusion/internal/services/azuredevops"
+	"github.com/KubeRocketCI/gitfusion/internal/services/bitbucket"
+	"github.com/KubeRocketCI/gitfusion/internal/services/bitbucketdc"
+	"github.com/KubeRocketCI/gitfusion/internal/services/gerrit"
+	"github.com/KubeRocketCI/gitfusion/internal/services/gitea"
+	"github.com/KubeRocketCI/gitfusion/internal/services/github"
+	"github.com/KubeRocketCI/gitfusion/internal/services/gitlab"
+)
+
+// scmCapabilities are the capabilities every git provider implements.
+var scmCapabilities = []Capability{
+	CapabilityRepositories,
+	CapabilityOrganizations,
+	CapabilityBranches,
+	CapabilityPullRequests,
+}
+
+// ciCapabilities are the capabilities of providers with a built-in CI system.
+var ciCapabilities = []Capability{
+	CapabilityPipelines,
+	CapabilityPipelineJobs,
+}
+
+// NewDefault returns a registry with every built-in provider, keyed by GitServer provider name.
+func NewDefault() *Registry {
+	r := New()
+
+	r.Register("github", github.NewGitHubProvider(), slices.Concat(scmCapabilities, ciCapabilities, []Capability{
+		CapabilityPipelineDetail,
+		CapabilityPipelineCancel,
+		CapabilityPipelineRetry,
+		CapabilityPipelineJobPlay,
+		CapabilityPipelineJobRetry,
+		CapabilityPipelineJobTraceWindow,
+		CapabilityPipelineTestReport,
+		CapabilityPipelineArtifacts,
+		CapabilityPipelineSchedules,
+		CapabilityPipelineGraph,
+		CapabilityPullRequestPipelines,
+		CapabilityCommitStatuses,
+		CapabilityCommitStatusCreate,
+	})...)
+	r.Register("gitlab", gitlab.NewGitlabProvider(), slices.Concat(scmCapabilities, ciCapabilities, []Capability{
+		CapabilityPipelineDetail,
+		CapabilityPipelineCancel,
+		CapabilityPipelineRetry,
+		CapabilityPipelineJobPlay,
+		CapabilityPipelineJobRetry,
+		CapabilityPipelineJobCancel,
+		CapabilityPipelineJobTraceStream,
+		CapabilityPipelineJobTraceWindow,
+		CapabilityPipelineTestReport,
+		CapabilityPipelineArtifacts,
+		CapabilityPipelineSchedules,
+		CapabilityPipelineScheduleActions,
+		CapabilityPipelineGraph,
+		CapabilityPullRequestPipelines,
+		CapabilityCommitStatuses,
+		CapabilityCommitStatusCreate,
+	})...)
+	r.Register("bitbucket", bitbucket.NewBitbucketProvider(), slices.Concat(scmCapabilities, ciCapabilities, []Capability{
+		CapabilityPipelineDetail,
+		CapabilityPipelineCancel,
+		CapabilityPipelineJobTraceWindow,
+		CapabilityPipelineArtifacts,
+		CapabilityPullRequestPipelines,
+		CapabilityCommitStatuses,
+		CapabilityCommitStatusCreate,
+	})...)
+	r.Register("bitbucketdc", bitbucketdc.NewBitbucketDataCenterProvider(), scmCapabilities...)
+	r.Register("gitea", gitea.NewGiteaProvider(), slices.Concat(scmCapabilities, ciCapabilities)...)
+	r.Register("azuredevops", azuredevops.NewAzureDevOpsProvider(), slices.Concat(scmCapabilities, ciCapabilities)...)
+	r.Register("gerrit", gerrit.NewGerritProvider(), scmCapabilities...)
+
+	return r
+}
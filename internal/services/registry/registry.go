@@ -0,0 +1,144 @@
+// Package registry holds the git provider implementations shared by the multi-provider services.
+//
+// A provider is registered once under its GitServer provider name together with the capabilities
+// it declares. Each multi-provider service takes the providers declaring its capability, so adding
+// a provider only touches NewDefault.
+package registry
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+)
+
+// Capability names a provider interface that a git provider implements.
+type Capability string
+
+const (
+	// CapabilityRepositories is repositories.RepositoriesProvider.
+	CapabilityRepositories Capability = "repositories"
+	// CapabilityOrganizations is organizations.OrganizationsProvider.
+	CapabilityOrganizations Capability = "organizations"
+	// CapabilityBranches is branches.BranchesProvider.
+	CapabilityBranches Capability = "branches"
+	// CapabilityPullRequests is pullrequests.PullRequestsProvider.
+	CapabilityPullRequests Capability = "pullRequests"
+	// CapabilityPipelines is pipelines.PipelineProvider.
+	CapabilityPipelines Capability = "pipelines"
+	// CapabilityPipelineJobs is pipelines.PipelineJobsProvider.
+	CapabilityPipelineJobs Capability = "pipelineJobs"
+	// CapabilityPipelineCancel is pipelines.PipelineCancelProvider.
+	CapabilityPipelineCancel Capability = "pipelineCancel"
+	// CapabilityPipelineRetry is pipelines.PipelineRetryProvider.
+	CapabilityPipelineRetry Capability = "pipelineRetry"
+	// CapabilityPipelineJobPlay is pipelines.PipelineJobPlayProvider.
+	CapabilityPipelineJobPlay Capability = "pipelineJobPlay"
+	// CapabilityPipelineJobRetry is pipelines.PipelineJobRetryProvider.
+	CapabilityPipelineJobRetry Capability = "pipelineJobRetry"
+	// CapabilityPipelineJobCancel is pipelines.PipelineJobCancelProvider.
+	CapabilityPipelineJobCancel Capability = "pipelineJobCancel"
+	// CapabilityPipelineDetail is pipelines.PipelineDetailProvider.
+	CapabilityPipelineDetail Capability = "pipelineDetail"
+	// CapabilityPipelineJobTraceStream is pipelines.PipelineJobTraceStreamProvider.
+	CapabilityPipelineJobTraceStream Capability = "pipelineJobTraceStream"
+	// CapabilityPipelineJobTraceWindow is pipelines.PipelineJobTraceWindowProvider.
+	CapabilityPipelineJobTraceWindow Capability = "pipelineJobTraceWindow"
+	// CapabilityPipelineTestReport is pipelines.PipelineTestReportProvider.
+	CapabilityPipelineTestReport Capability = "pipelineTestReport"
+	// CapabilityPipelineArtifacts is pipelines.PipelineArtifactsProvider.
+	CapabilityPipelineArtifacts Capability = "pipelineArtifacts"
+	// CapabilityPipelineGraph is pipelines.PipelineGraphProvider.
+	CapabilityPipelineGraph Capability = "pipelineGraph"
+	// CapabilityPipelineSchedules is pipelines.PipelineSchedulesProvider.
+	CapabilityPipelineSchedules Capability = "pipelineSchedules"
+	// CapabilityPipelineScheduleActions is pipelines.PipelineScheduleActionsProvider.
+	CapabilityPipelineScheduleActions Capability = "pipelineScheduleActions"
+	// CapabilityPullRequestPipelines is pipelines.PullRequestPipelinesProvider.
+	CapabilityPullRequestPipelines Capability = "pullRequestPipelines"
+	// CapabilityCommitStatuses is commitstatuses.CommitStatusesProvider.
+	CapabilityCommitStatuses Capability = "commitStatuses"
+	// CapabilityCommitStatusCreate is commitstatuses.CommitStatusCreateProvider.
+	CapabilityCommitStatusCreate Capability = "commitStatusCreate"
+)
+
+type entry struct {
+	provider     any
+	capabilities []Capability
+}
+
+// Registry maps provider names to provider implementations and their declared capabilities.
+// It is populated at startup and read-only afterwards.
+type Registry struct {
+	entries map[string]entry
+}
+
+func New() *Registry {
+	return &Registry{
+		entries: make(map[string]entry),
+	}
+}
+
+// Register adds a provider under name. It panics if name is already registered, since that is a
+// programming error in the registry setup.
+func (r *Registry) Register(name string, provider any, capabilities ...Capability) {
+	if _, ok := r.entries[name]; ok {
+		panic(fmt.Sprintf("registry: provider %q registered twice", name))
+	}
+
+	r.entries[name] = entry{
+		provider:     provider,
+		capabilities: slices.Clone(capabilities),
+	}
+}
+
+// Names returns the registered provider names in alphabetical order.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.entries))
+	for name := range r.entries {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// Capabilities returns the capabilities declared by the named provider, or nil if it is not
+// registered.
+func (r *Registry) Capabilities(name string) []Capability {
+	e, ok := r.entries[name]
+	if !ok {
+		return nil
+	}
+
+	return slices.Clone(e.capabilities)
+}
+
+// Supports reports whether the named provider declares capability.
+func (r *Registry) Supports(name string, capability Capability) bool {
+	e, ok := r.entries[name]
+
+	return ok && slices.Contains(e.capabilities, capability)
+}
+
+// Providers returns the providers declaring capability as T, keyed by name. It panics if a
+// provider declares capability without implementing T, so a wrong declaration fails at startup
+// rather than on the first request.
+func Providers[T any](r *Registry, capability Capability) map[string]T {
+	result := make(map[string]T)
+
+	for name, e := range r.entries {
+		if !slices.Contains(e.capabilities, capability) {
+			continue
+		}
+
+		provider, ok := e.provider.(T)
+		if !ok {
+			panic(fmt.Sprintf("registry: provider %q declares %s but is %T", name, capability, e.provider))
+		}
+
+		result[name] = provider
+	}
+
+	return result
+}
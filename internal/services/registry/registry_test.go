@@ -0,0 +1,88 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type lister interface {
+	List() []string
+}
+
+type fakeLister struct{}
+
+func (fakeLister) List() []string { return nil }
+
+func TestRegistryProviders(t *testing.T) {
+	r := New()
+	r.Register("a", fakeLister{}, CapabilityRepositories)
+	r.Register("b", fakeLister{}, CapabilityBranches)
+	r.Register("c", struct{}{})
+
+	got := Providers[lister](r, CapabilityRepositories)
+
+	assert.Len(t, got, 1)
+	assert.Contains(t, got, "a")
+	assert.Equal(t, []string{"a", "b", "c"}, r.Names())
+	assert.True(t, r.Supports("b", CapabilityBranches))
+	assert.False(t, r.Supports("b", CapabilityRepositories))
+	assert.False(t, r.Supports("missing", CapabilityRepositories))
+	assert.Nil(t, r.Capabilities("missing"))
+}
+
+func TestRegistryProvidersPanicsOnWrongDeclaration(t *testing.T) {
+	r := New()
+	r.Register("a", struct{}{}, CapabilityRepositories)
+
+	assert.Panics(t, func() { Providers[lister](r, CapabilityRepositories) })
+}
+
+func TestRegistryRegisterTwicePanics(t *testing.T) {
+	r := New()
+	r.Register("a", fakeLister{})
+
+	assert.Panics(t, func() { r.Register("a", fakeLister{}) })
+}
+
+func TestNewDefault(t *testing.T) {
+	r := NewDefault()
+
+	assert.Equal(t, []string{"azuredevops", "bitbucket", "bitbucketdc", "gerrit", "gitea", "github", "gitlab"}, r.Names())
+
+	for _, name := range r.Names() {
+		assert.Subset(t, r.Capabilities(name), scmCapabilities, name)
+	}
+
+	assert.False(t, r.Supports("gerrit", CapabilityPipelines))
+	assert.False(t, r.Supports("bitbucketdc", CapabilityPipelines))
+	assert.True(t, r.Supports("github", CapabilityPipelineJobs))
+	assert.True(t, r.Supports("bitbucket", CapabilityPipelineCancel))
+	assert.False(t, r.Supports("bitbucket", CapabilityPipelineRetry))
+	assert.False(t, r.Supports("gitea", CapabilityPipelineCancel))
+	assert.True(t, r.Supports("gitlab", CapabilityPipelineJobCancel))
+	assert.True(t, r.Supports("github", CapabilityPipelineJobRetry))
+	assert.False(t, r.Supports("github", CapabilityPipelineJobCancel))
+	assert.False(t, r.Supports("bitbucket", CapabilityPipelineJobPlay))
+	assert.True(t, r.Supports("bitbucket", CapabilityPipelineDetail))
+	assert.False(t, r.Supports("azuredevops", CapabilityPipelineDetail))
+	assert.True(t, r.Supports("gitlab", CapabilityPipelineJobTraceStream))
+	assert.False(t, r.Supports("github", CapabilityPipelineJobTraceStream))
+	assert.True(t, r.Supports("bitbucket", CapabilityPipelineJobTraceWindow))
+	assert.False(t, r.Supports("gitea", CapabilityPipelineJobTraceWindow))
+	assert.True(t, r.Supports("github", CapabilityPipelineTestReport))
+	assert.False(t, r.Supports("bitbucket", CapabilityPipelineTestReport))
+	assert.True(t, r.Supports("bitbucket", CapabilityPipelineArtifacts))
+	assert.False(t, r.Supports("gitea", CapabilityPipelineArtifacts))
+	assert.True(t, r.Supports("github", CapabilityPipelineSchedules))
+	assert.False(t, r.Supports("github", CapabilityPipelineScheduleActions))
+	assert.True(t, r.Supports("gitlab", CapabilityPipelineScheduleActions))
+	assert.True(t, r.Supports("github", CapabilityPipelineGraph))
+	assert.False(t, r.Supports("bitbucket", CapabilityPipelineGraph))
+	assert.True(t, r.Supports("bitbucket", CapabilityPullRequestPipelines))
+	assert.False(t, r.Supports("gitea", CapabilityPullRequestPipelines))
+	assert.True(t, r.Supports("gitlab", CapabilityCommitStatuses))
+	assert.False(t, r.Supports("bitbucketdc", CapabilityCommitStatuses))
+	assert.True(t, r.Supports("bitbucket", CapabilityCommitStatusCreate))
+	assert.False(t, r.Supports("gitea", CapabilityCommitStatusCreate))
+}
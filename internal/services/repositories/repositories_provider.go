@@ -8,10 +8,8 @@ import (
 
 	"github.com/KubeRocketCI/gitfusion/internal/cache"
 	"github.com/KubeRocketCI/gitfusion/internal/models"
-	"github.com/KubeRocketCI/gitfusion/internal/services/bitbucket"
-	"github.com/KubeRocketCI/gitfusion/internal/services/github"
-	"github.com/KubeRocketCI/gitfusion/internal/services/gitlab"
 	"github.com/KubeRocketCI/gitfusion/internal/services/krci"
+	"github.com/KubeRocketCI/gitfusion/internal/services/registry"
 	"github.com/KubeRocketCI/gitfusion/pkg/pointer"
 )
 
@@ -35,14 +33,10 @@ type MultiProviderRepositoryService struct {
 	cache     *sturdyc.Client[[]models.Repository]
 }
 
-func NewMultiProviderRepositoryService() *MultiProviderRepositoryService {
+func NewMultiProviderRepositoryService(reg *registry.Registry) *MultiProviderRepositoryService {
 	return &MultiProviderRepositoryService{
-		providers: map[string]RepositoriesProvider{
-			"github":    github.NewGitHubProvider(),
-			"gitlab":    gitlab.NewGitlabProvider(),
-			"bitbucket": bitbucket.NewBitbucketProvider(),
-		},
-		cache: cache.NewRepositoryCache(),
+		providers: registry.Providers[RepositoriesProvider](reg, registry.CapabilityRepositories),
+		cache:     cache.NewRepositoryCache(),
 	}
 }
 